@@ -18,7 +18,7 @@ import (
 // ParseToString function takes a name-string, desired format, a withDetails
 // flag as 0|1 integer. It parses the name-string to either JSON, or a CSV
 // string, depending on the desired format. Format argument can take values of
-// 'csv', 'compact', 'pretty'. If withDetails argument is 0, additional
+// 'csv', 'tsv', 'compact', 'pretty', 'dwc'. If withDetails argument is 0, additional
 // parsed details are ommited, if it is 1 -- they are included.
 // true.
 //export ParseToString
@@ -38,7 +38,7 @@ func ParseToString(
 	}
 	cfg := gnparser.NewConfig(opts...)
 	gnp := gnparser.New(cfg)
-	parsed := gnp.ParseName(goname).Output(gnp.Format())
+	parsed := gnp.Output(gnp.ParseName(goname))
 
 	return C.CString(parsed)
 }
@@ -51,9 +51,9 @@ func FreeMemory(p *C.char) {
 
 // ParseAryToString function takes an array of names, parsing format, and a
 // withDetails flag as 0|1 integer.  Parsed outputs are sent as a string in
-// either CSV or JSON format.  Format argument can take values of 'csv',
-// 'compact', or 'pretty'. For withDetails argument 0 means false, 1 means
-// true.
+// either CSV, TSV or JSON format.  Format argument can take values of 'csv',
+// 'tsv', 'compact', 'pretty', or 'dwc'. For withDetails argument 0 means false,
+// 1 means true.
 //export ParseAryToString
 func ParseAryToString(
 	in **C.char,
@@ -85,15 +85,15 @@ func ParseAryToString(
 	gnp := gnparser.New(cfg)
 
 	var res string
-	parsed := gnp.ParseNames(names)
-	if gnp.Format() == gnfmt.CSV {
+	ps := gnp.ParseNames(names)
+	if f := gnp.Format(); f == gnfmt.CSV || f == gnfmt.TSV {
 		csv := make([]string, length)
-		for i := range parsed {
-			csv[i] = parsed[i].Output(gnfmt.CSV)
+		for i := range ps {
+			csv[i] = gnp.Output(ps[i])
 		}
 		res = strings.Join(csv, "\n")
 	} else {
-		json, _ := gnfmt.GNjson{}.Encode(parsed)
+		json, _ := gnfmt.GNjson{}.Encode(ps)
 		res = string(json)
 	}
 	return C.CString(res)
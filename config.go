@@ -5,13 +5,15 @@ import (
 	"runtime"
 
 	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/io/dict"
 )
 
 // Config keeps settings that might affect how parsing is done,
 // of change the parsing output.
 type Config struct {
 	// Format sets the output format for CLI and Web interfaces.
-	// There are 3 formats available: 'CSV', 'CompactJSON' and
+	// There are 4 formats available: 'CSV', 'TSV', 'CompactJSON' and
 	// 'PrettyJSON'.
 	Format gnfmt.Format
 
@@ -49,6 +51,32 @@ type Config struct {
 	// modify cardinality, normalized and canonical output.
 	WithCultivars bool
 
+	// WithPublishedIn flag, when true, a bibliographic reference after
+	// the authorship (e.g. "Pinus strobus L., Sp. Pl. 2: 1001. 1753") is
+	// parsed into PublishedIn field instead of being an unparsed tail.
+	WithPublishedIn bool
+
+	// WithPhonetic flag, when true, canonical forms contain a Phonetic
+	// version with Taxamatch-style phonetic keys of words.
+	WithPhonetic bool
+
+	// WithDwC flag, when true, CSV and TSV outputs contain Darwin Core
+	// terms instead of the default fields. It also forces parsing with
+	// details.
+	WithDwC bool
+
+	// Code is a hint about the nomenclatural code of names. If it is set,
+	// it overrides the inferred code of parsed names and helps to resolve
+	// ambiguous parsing decisions, for example if a word in parentheses
+	// after a genus is an author or a subgenus.
+	Code parsed.Code
+
+	// Dictionary provides bacterial genera, ICN authors and exceptions
+	// for viruses, unparseable and ambiguous names. It allows to extend or
+	// replace the embedded dictionaries for a particular GNparser instance
+	// (see dict.Load). If it is nil, the embedded dictionaries are used.
+	Dictionary *dict.Dictionary
+
 	// Port to run wer-service.
 	Port int
 
@@ -84,12 +112,38 @@ func OptDebug(b bool) Option {
 	}
 }
 
-// OptFormat takes a string (one of 'csv', 'compact', 'pretty') to set
-// the formatting option for the CLI or Web presentation. If some other
-// string is entered, the default, 'CSV' format is set, accompanied by a
-// warning.
+// OptDictionary sets a custom dictionary for parsing.
+func OptDictionary(d *dict.Dictionary) Option {
+	return func(cfg *Config) {
+		cfg.Dictionary = d
+	}
+}
+
+// OptDictionaryDir loads custom dictionary files from a directory (see
+// dict.Load). If replace is true, the files replace embedded dictionaries,
+// otherwise they are merged with them. If loading fails, embedded
+// dictionaries are used, accompanied by a warning.
+func OptDictionaryDir(dir string, replace bool) Option {
+	return func(cfg *Config) {
+		d, err := dict.Load(dir, replace)
+		if err != nil {
+			log.Printf("Cannot load dictionaries from '%s': %s.", dir, err)
+			return
+		}
+		cfg.Dictionary = d
+	}
+}
+
+// OptFormat takes a string (one of 'csv', 'tsv', 'compact', 'pretty', 'dwc')
+// to set the formatting option for the CLI or Web presentation. The 'dwc'
+// string sets 'CSV' format with WithDwC field. If some other string is
+// entered, the default, 'CSV' format is set, accompanied by a warning.
 func OptFormat(s string) Option {
 	return func(cfg *Config) {
+		cfg.WithDwC = s == "dwc"
+		if cfg.WithDwC {
+			s = "csv"
+		}
 		f, err := gnfmt.NewFormat(s)
 		if err != nil {
 			f = gnfmt.CSV
@@ -108,6 +162,18 @@ func OptIgnoreHTMLTags(b bool) Option {
 	}
 }
 
+// OptCode sets a nomenclatural code hint. It takes a string like 'ICZN',
+// 'ICN', 'ICNP', 'ICVCN', 'ICNCP' or informal names like 'zoo', 'bot',
+// 'bact', 'virus', 'cultivar'. Unknown values remove the hint.
+func OptCode(s string) Option {
+	return func(cfg *Config) {
+		cfg.Code = parsed.NewCode(s)
+		if s != "" && cfg.Code == parsed.UnknownCode {
+			log.Printf("Cannot recognize nomenclatural code '%s', ignoring.", s)
+		}
+	}
+}
+
 // OptIsTest sets a test flag.
 func OptIsTest(b bool) Option {
 	return func(cfg *Config) {
@@ -150,6 +216,27 @@ func OptWithCultivars(b bool) Option {
 	}
 }
 
+// OptWithPublishedIn sets the WithPublishedIn field.
+func OptWithPublishedIn(b bool) Option {
+	return func(cfg *Config) {
+		cfg.WithPublishedIn = b
+	}
+}
+
+// OptWithPhonetic sets the WithPhonetic field.
+func OptWithPhonetic(b bool) Option {
+	return func(cfg *Config) {
+		cfg.WithPhonetic = b
+	}
+}
+
+// OptWithDwC sets the WithDwC field.
+func OptWithDwC(b bool) Option {
+	return func(cfg *Config) {
+		cfg.WithDwC = b
+	}
+}
+
 // OptWithDetails sets the WithDetails field.
 func OptWithDetails(b bool) Option {
 	return func(cfg *Config) {
@@ -0,0 +1,171 @@
+package author
+
+import (
+	"strings"
+	"unicode"
+)
+
+// token is a part of an author's name.
+type token struct {
+	// val is a lower-case value of the token without diacritics.
+	val string
+	// abbr is true if the token is abbreviated, for example "Linn.".
+	abbr bool
+	// initial is true for single capital letters like "A." in "A. Gray".
+	initial bool
+}
+
+// particles are words that can be omitted from a surname.
+var particles = map[string]struct{}{
+	"d": {}, "da": {}, "de": {}, "del": {}, "della": {}, "den": {}, "der": {},
+	"des": {}, "di": {}, "do": {}, "dos": {}, "du": {}, "l": {}, "la": {},
+	"le": {}, "t": {}, "ten": {}, "van": {}, "von": {}, "zu": {},
+}
+
+// suffixes are normalized forms of words that distinguish relatives with
+// the same name.
+var suffixes = map[string]string{
+	"f": "fil", "fil": "fil", "filius": "fil", "bis": "bis", "ter": "ter",
+}
+
+// isAbbrev decides if two names of authors might refer to the same person
+// because one of them is an abbreviation of another. Surnames are compared
+// starting from the end. Every abbreviated word must start with the same
+// letter as the corresponding full word and its letters must be found
+// in the full word in the same order (e.g. "Rchb." and "Reichenbach").
+// Initials and particles can be omitted. Suffixes like "f." must be the same.
+func isAbbrev(au1, au2 string) bool {
+	return compareTokens(au1, au2, matchTokens)
+}
+
+// abbreviates is a one-sided version of isAbbrev. It decides if abbr is
+// the same name as full, or its abbreviation, but not the other way around.
+// For example "Mill." abbreviates "Miller", but "Miller" does not
+// abbreviate "Mill.".
+func abbreviates(abbr, full string) bool {
+	return compareTokens(abbr, full, matchAbbrToken)
+}
+
+func compareTokens(au1, au2 string, match func(t1, t2 token) bool) bool {
+	ts1, suf1 := tokenize(au1)
+	ts2, suf2 := tokenize(au2)
+	if suf1 != suf2 || len(ts1) == 0 || len(ts2) == 0 {
+		return false
+	}
+
+	i, j := len(ts1)-1, len(ts2)-1
+	if !match(ts1[i], ts2[j]) {
+		return false
+	}
+	i, j = i-1, j-1
+	for i >= 0 && j >= 0 {
+		switch {
+		case match(ts1[i], ts2[j]):
+			i, j = i-1, j-1
+		case ts1[i].skippable():
+			i--
+		case ts2[j].skippable():
+			j--
+		default:
+			return false
+		}
+	}
+	for ; i >= 0; i-- {
+		if !ts1[i].skippable() {
+			return false
+		}
+	}
+	for ; j >= 0; j-- {
+		if !ts2[j].skippable() {
+			return false
+		}
+	}
+	return true
+}
+
+func (t token) skippable() bool {
+	if t.initial {
+		return true
+	}
+	_, ok := particles[t.val]
+	return ok
+}
+
+func matchTokens(t1, t2 token) bool {
+	if t1.val == t2.val {
+		return true
+	}
+	return (t1.abbr && isContraction(t1.val, t2.val)) ||
+		(t2.abbr && isContraction(t2.val, t1.val))
+}
+
+// matchAbbrToken checks if t1 is the same word as t2, or its abbreviation.
+func matchAbbrToken(t1, t2 token) bool {
+	return t1.val == t2.val || (t1.abbr && isContraction(t1.val, t2.val))
+}
+
+// isContraction checks if an abbreviation starts with the same letter as
+// a word, and the rest of its letters can be found in the word in the same
+// order.
+func isContraction(abbr, word string) bool {
+	if abbr == "" || word == "" || abbr[0] != word[0] {
+		return false
+	}
+	i := 0
+	for j := 0; j < len(word) && i < len(abbr); j++ {
+		if abbr[i] == word[j] {
+			i++
+		}
+	}
+	return i == len(abbr)
+}
+
+// tokenize breaks an author's name into words and detects a suffix like
+// "f.", "fil.", "bis". Acronyms like "DC." are split into abbreviated
+// letters.
+func tokenize(au string) ([]token, string) {
+	au = toASCII(au)
+	var res []token
+	var suffix string
+	rs := []rune(au)
+	for i := 0; i < len(rs); {
+		if !unicode.IsLetter(rs[i]) {
+			i++
+			continue
+		}
+		start := i
+		for i < len(rs) && unicode.IsLetter(rs[i]) {
+			i++
+		}
+		w := string(rs[start:i])
+		abbr := i < len(rs) && rs[i] == '.'
+		lw := strings.ToLower(w)
+
+		if suf, ok := suffixes[lw]; ok && len(res) > 0 && w == lw &&
+			!hasLetters(rs[i:]) {
+			suffix = suf
+			continue
+		}
+		if len(w) > 1 && len(w) < 4 && strings.ToUpper(w) == w {
+			for _, r := range lw {
+				res = append(res, token{val: string(r), abbr: true})
+			}
+			continue
+		}
+		res = append(res, token{
+			val:     lw,
+			abbr:    abbr,
+			initial: len(w) == 1 && unicode.IsUpper(rs[start]),
+		})
+	}
+	return res, suffix
+}
+
+func hasLetters(rs []rune) bool {
+	for _, r := range rs {
+		if unicode.IsLetter(r) {
+			return true
+		}
+	}
+	return false
+}
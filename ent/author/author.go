@@ -0,0 +1,300 @@
+// Package author compares names of authors of scientific names. It decides
+// if two strings like "L." and "Linnaeus", "DC." and "de Candolle",
+// "Müll.Arg." and "Mull. Arg." refer to the same person. The comparison
+// uses a list of standard forms of authors' names and an algorithm that
+// detects if one name is an abbreviation of another.
+package author
+
+import (
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/ent/str"
+	"github.com/gnames/gnparser/io/dict"
+)
+
+// Match describes how well two authors, teams of authors or authorships
+// correspond to each other. Larger values mean better matches.
+type Match int
+
+const (
+	// NoMatch means that authors are different.
+	NoMatch Match = iota
+	// AbbrevMatch means that one author is an abbreviation of another,
+	// for example "Linn." and "Linnaeus".
+	AbbrevMatch
+	// StandardFormMatch means that both authors are variants of the same
+	// standard form, for example "DC." and "de Candolle".
+	StandardFormMatch
+	// ExactMatch means that authors are the same, ignoring case, spaces
+	// and diacritics.
+	ExactMatch
+)
+
+var matchMap = map[Match]string{
+	NoMatch:           "NoMatch",
+	AbbrevMatch:       "Abbreviation",
+	StandardFormMatch: "StandardForm",
+	ExactMatch:        "Exact",
+}
+
+// String is an implementation of fmt.Stringer interface.
+func (m Match) String() string {
+	return matchMap[m]
+}
+
+// Matcher compares authors using standard forms of their names.
+type Matcher struct {
+	// forms maps normalized variants of names to their standard forms.
+	forms map[string]string
+	// variants maps standard forms to all their known variants.
+	variants map[string][]string
+}
+
+var matcher = New()
+
+// New creates a Matcher with standard forms of authors' names embedded
+// into gnparser.
+func New() *Matcher {
+	m := &Matcher{
+		forms:    make(map[string]string),
+		variants: make(map[string][]string),
+	}
+	m.AddForms(dict.Dict.AuthorForms)
+	return m
+}
+
+// AddForms adds standard forms of authors' names to the Matcher. Keys of
+// the map are standard forms, values are their variants. If a variant
+// already exists, it is reassigned to the new standard form.
+func (m *Matcher) AddForms(forms map[string][]string) {
+	for k, vs := range forms {
+		m.forms[key(k)] = k
+		m.variants[k] = append(m.variants[k], k)
+		for _, v := range vs {
+			m.forms[key(v)] = k
+			m.variants[k] = append(m.variants[k], v)
+		}
+	}
+}
+
+// Load reads standard forms of authors' names in the format of
+// dict.ReadAuthorForms and adds them to the Matcher.
+func (m *Matcher) Load(r io.Reader) error {
+	forms, err := dict.ReadAuthorForms(r)
+	if err != nil {
+		return err
+	}
+	m.AddForms(forms)
+	return nil
+}
+
+// StandardForm returns a standard form of an author's name, if the name is
+// a known variant.
+func (m *Matcher) StandardForm(au string) (string, bool) {
+	res, ok := m.forms[key(au)]
+	return res, ok
+}
+
+// Compare decides if two strings refer to the same author.
+func (m *Matcher) Compare(au1, au2 string) Match {
+	k1, k2 := key(au1), key(au2)
+	if k1 == "" || k2 == "" {
+		return NoMatch
+	}
+	if k1 == k2 {
+		return ExactMatch
+	}
+
+	f1, ok1 := m.forms[k1]
+	f2, ok2 := m.forms[k2]
+	switch {
+	case ok1 && ok2:
+		if f1 == f2 {
+			return StandardFormMatch
+		}
+		return NoMatch
+	case ok1:
+		return m.compareVariants(f1, au2)
+	case ok2:
+		return m.compareVariants(f2, au1)
+	}
+	if isAbbrev(au1, au2) {
+		return AbbrevMatch
+	}
+	return NoMatch
+}
+
+// compareVariants checks if an author is an abbreviation of any variant
+// of a standard form. Variants themselves are not used as abbreviations,
+// otherwise "Mill." (Miller) would match "Millspaugh". Single initials
+// like "L." are skipped as well.
+func (m *Matcher) compareVariants(form, au string) Match {
+	for _, v := range m.variants[form] {
+		if isInitial(v) {
+			continue
+		}
+		if abbreviates(au, v) {
+			return AbbrevMatch
+		}
+	}
+	return NoMatch
+}
+
+// CompareTeams compares two teams of authors. Teams match if they have
+// the same number of authors, and authors match each other in the same
+// order. The result is the worst match of all authors.
+func (m *Matcher) CompareTeams(t1, t2 []string) Match {
+	if len(t1) != len(t2) {
+		return NoMatch
+	}
+	res := ExactMatch
+	for i := range t1 {
+		if mt := m.Compare(t1[i], t2[i]); mt < res {
+			res = mt
+		}
+	}
+	return res
+}
+
+// CompareAuthorship compares authors and years of two parsed authorships.
+// Original and combination authors are compared separately if they are
+// given, otherwise lists of all authors are compared. Years are taken
+// into account only if both authorships have them. Ex-authors are often
+// omitted, so if only one authorship has them, both its authors and
+// ex-authors are compared to the other one, and the match is never exact.
+func (m *Matcher) CompareAuthorship(a1, a2 parsed.Authorship) Match {
+	if a1.Original == nil || a2.Original == nil {
+		if !sameYear(a1.Year, a2.Year) {
+			return NoMatch
+		}
+		return m.CompareTeams(a1.Authors, a2.Authors)
+	}
+	res := m.compareGroups(a1.Original, a2.Original)
+	if mt := m.compareGroups(a1.Combination, a2.Combination); mt < res {
+		res = mt
+	}
+	return res
+}
+
+func (m *Matcher) compareGroups(g1, g2 *parsed.AuthGroup) Match {
+	if g1 == nil || g2 == nil {
+		if g1 == g2 {
+			return ExactMatch
+		}
+		return NoMatch
+	}
+	res := m.compareAuthors(
+		parsed.Authors{Authors: g1.Authors, Year: g1.Year},
+		parsed.Authors{Authors: g2.Authors, Year: g2.Year},
+	)
+	ex1, ex2 := g1.ExAuthors, g2.ExAuthors
+	switch {
+	case ex1 != nil && ex2 != nil:
+		if mt := m.compareAuthors(*ex1, *ex2); mt < res {
+			res = mt
+		}
+		return res
+	case ex1 != nil:
+		return m.compareExAuthors(res, *ex1, g2)
+	case ex2 != nil:
+		return m.compareExAuthors(res, *ex2, g1)
+	}
+	return res
+}
+
+// compareExAuthors compares ex-authors of one group with authors of
+// another group that has no ex-authors. Under ICN the author after "ex"
+// is the author of the name, so "Hook. ex Benth." might be cited as
+// "Benth." or as "Hook.". The best of both matches is returned, but it is
+// never an ExactMatch.
+func (m *Matcher) compareExAuthors(
+	res Match,
+	ex parsed.Authors,
+	g *parsed.AuthGroup,
+) Match {
+	au := parsed.Authors{Authors: g.Authors, Year: g.Year}
+	if mt := m.compareAuthors(ex, au); mt > res {
+		res = mt
+	}
+	if res == ExactMatch {
+		res = StandardFormMatch
+	}
+	return res
+}
+
+func (m *Matcher) compareAuthors(a1, a2 parsed.Authors) Match {
+	var y1, y2 string
+	if a1.Year != nil {
+		y1 = a1.Year.Value
+	}
+	if a2.Year != nil {
+		y2 = a2.Year.Value
+	}
+	if !sameYear(y1, y2) {
+		return NoMatch
+	}
+	return m.CompareTeams(a1.Authors, a2.Authors)
+}
+
+func sameYear(y1, y2 string) bool {
+	y1 = strings.Trim(y1, "()")
+	y2 = strings.Trim(y2, "()")
+	return y1 == "" || y2 == "" || y1 == y2
+}
+
+// Compare decides if two strings refer to the same author using standard
+// forms embedded into gnparser.
+func Compare(au1, au2 string) Match {
+	return matcher.Compare(au1, au2)
+}
+
+// CompareAuthorship compares two parsed authorships using standard forms
+// embedded into gnparser.
+func CompareAuthorship(a1, a2 parsed.Authorship) Match {
+	return matcher.CompareAuthorship(a1, a2)
+}
+
+// translit converts diacritics to ASCII, but unlike str.Transliterations
+// it keeps periods and apostrophes, and converts diaereses to plain vowels,
+// so "Müll." and "Mull." are the same.
+var translit = func() map[rune]string {
+	res := make(map[rune]string)
+	for k, v := range str.Transliterations {
+		res[k] = v
+	}
+	for k, v := range str.DiaeresesTransliterations {
+		res[k] = v
+		res[unicode.ToUpper(k)] = strings.ToUpper(v)
+	}
+	for _, r := range []rune{'.', '\'', '‘', '’'} {
+		delete(res, r)
+	}
+	return res
+}()
+
+func toASCII(s string) string {
+	return str.ToASCII(s, translit)
+}
+
+// isInitial checks if an author's name consists of a single letter,
+// like "L.".
+func isInitial(au string) bool {
+	var letters int
+	for _, r := range au {
+		if unicode.IsLetter(r) {
+			letters++
+		}
+	}
+	return letters == 1
+}
+
+// key normalizes an author's name for lookups: it removes diacritics,
+// spaces and converts the name to lower case.
+func key(au string) string {
+	au = toASCII(au)
+	au = strings.Join(strings.Fields(au), "")
+	return strings.ToLower(au)
+}
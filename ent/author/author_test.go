@@ -0,0 +1,84 @@
+package author_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/author"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		msg, au1, au2 string
+		match         author.Match
+	}{
+		{"same", "Linnaeus", "Linnaeus", author.ExactMatch},
+		{"spaces", "Müll.Arg.", "Mull. Arg.", author.ExactMatch},
+		{"form", "L.", "Linnaeus", author.StandardFormMatch},
+		{"form2", "Linn.", "L.", author.StandardFormMatch},
+		{"acronym", "DC.", "de Candolle", author.StandardFormMatch},
+		{"diff forms", "L.", "Lamarck", author.NoMatch},
+		{"initial form", "L.", "Lindley", author.NoMatch},
+		{"initial form variant", "Linnaeus", "Linn", author.AbbrevMatch},
+		{"filius", "L.", "L.f.", author.NoMatch},
+		{"variant", "Lamarck", "Lem.", author.NoMatch},
+		{"variant abbr", "Lamarck", "La.", author.AbbrevMatch},
+		{"prefix", "Mill.", "Millerson", author.NoMatch},
+		{"form prefix", "Mill.", "Millspaugh", author.NoMatch},
+		{"form abbr", "Miller", "Mil.", author.AbbrevMatch},
+		{"unknown prefix", "Smi.", "Smithers", author.AbbrevMatch},
+		{"contraction", "Rchbch.", "Reichenbachius", author.AbbrevMatch},
+		{"initials", "A.H.Sm.", "Smithson", author.AbbrevMatch},
+		{"particle", "van Wyk", "B.-E. Wyk", author.AbbrevMatch},
+		{"unknown acronym", "AB.", "Anton Bergson", author.AbbrevMatch},
+		{"suffix", "Bergs. f.", "Bergson fil.", author.AbbrevMatch},
+		{"suffix mismatch", "Bergs. f.", "Bergson", author.NoMatch},
+		{"not abbr", "Smith", "Smithson", author.NoMatch},
+		{"different", "Smith", "Jones", author.NoMatch},
+		{"empty", "", "Jones", author.NoMatch},
+	}
+	for _, v := range tests {
+		assert.Equal(t, v.match, author.Compare(v.au1, v.au2), v.msg)
+		assert.Equal(t, v.match, author.Compare(v.au2, v.au1), v.msg)
+	}
+}
+
+func TestCompareAuthorship(t *testing.T) {
+	tests := []struct {
+		msg, au1, au2 string
+		match         author.Match
+	}{
+		{"same", "(L.) Mill.", "(L.) Mill.", author.ExactMatch},
+		{"forms", "(L.) Mill. 1768", "(Linnaeus) Miller", author.StandardFormMatch},
+		{"years", "Smith, 1880", "Sm. 1890", author.NoMatch},
+		{"teams", "Smith & Jones", "Sm. & J.", author.AbbrevMatch},
+		{"team size", "Smith & Jones", "Smith", author.NoMatch},
+		{"no combination", "(L.) Mill.", "L.", author.NoMatch},
+		{"ex authors", "Hook. ex Benth.", "Hook.", author.StandardFormMatch},
+		{"ex authors2", "Benth.", "Hook. ex Benth.", author.StandardFormMatch},
+		{"both ex", "Hook. ex Benth.", "Hook. ex Benth.", author.ExactMatch},
+		{"ex mismatch", "Hook. ex Benth.", "Smith", author.NoMatch},
+	}
+	gnp := gnparser.New(gnparser.NewConfig())
+	for _, v := range tests {
+		a1 := gnp.ParseAuthorship(v.au1)
+		a2 := gnp.ParseAuthorship(v.au2)
+		assert.Equal(t, v.match, author.CompareAuthorship(a1, a2), v.msg)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	m := author.New()
+	assert.Equal(t, author.NoMatch, m.Compare("Bergs.", "Smith"))
+	err := m.Load(strings.NewReader("Bergs.\tSmith\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, author.StandardFormMatch, m.Compare("Bergs.", "Smith"))
+	form, ok := m.StandardForm("Smith")
+	assert.True(t, ok)
+	assert.Equal(t, "Bergs.", form)
+
+	// default matcher is not affected
+	assert.Equal(t, author.NoMatch, author.Compare("Bergs.", "Smith"))
+}
@@ -0,0 +1,229 @@
+// Package compare finds how two parsed scientific names relate to each
+// other. It is useful for deduplication of names that come from different
+// sources.
+package compare
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/gnames/gnparser/ent/author"
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// Level is a graded degree of similarity of two names. Larger values mean
+// closer names.
+type Level int
+
+const (
+	// NoMatch means that canonical forms of names are different.
+	NoMatch Level = iota
+	// StemmedMatch means that names have the same stemmed canonical form,
+	// for example "Aus alba" and "Aus albus".
+	StemmedMatch
+	// SimpleMatch means that names have the same simple canonical form,
+	// but differ in other ways, for example in hybrid signs.
+	SimpleMatch
+	// RankMarkerMatch means that names differ only by rank markers,
+	// for example "Aus bus var. cus" and "Aus bus f. cus".
+	RankMarkerMatch
+	// FullMatch means that names have the same full canonical form.
+	FullMatch
+	// IdenticalMatch means that verbatim name-strings are the same.
+	IdenticalMatch
+)
+
+var levelMap = map[Level]string{
+	NoMatch:         "NoMatch",
+	StemmedMatch:    "StemmedCanonical",
+	SimpleMatch:     "SimpleCanonical",
+	RankMarkerMatch: "RankMarkerOnly",
+	FullMatch:       "FullCanonical",
+	IdenticalMatch:  "IdenticalVerbatim",
+}
+
+var levelStrMap = func() map[string]Level {
+	res := make(map[string]Level)
+	for k, v := range levelMap {
+		res[v] = k
+	}
+	return res
+}()
+
+// String is an implementation of fmt.Stringer interface.
+func (l Level) String() string {
+	return levelMap[l]
+}
+
+// MarshalJSON implements json.Marshaler.
+func (l Level) MarshalJSON() ([]byte, error) {
+	return []byte("\"" + l.String() + "\""), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaller.
+func (l *Level) UnmarshalJSON(bs []byte) error {
+	var ok bool
+	*l, ok = levelStrMap[strings.Trim(string(bs), `"`)]
+	if !ok {
+		return errors.New("cannot decode Level")
+	}
+	return nil
+}
+
+// Authorship describes if authorships of two names are compatible.
+type Authorship int
+
+const (
+	// UnknownAuthorship means that at least one of the names has no
+	// authorship, so authorships cannot be compared.
+	UnknownAuthorship Authorship = iota
+	// CompatibleAuthorship means that authors of the names match each
+	// other, and years, if given, are the same.
+	CompatibleAuthorship
+	// IncompatibleAuthorship means that authors or years are different.
+	IncompatibleAuthorship
+)
+
+var authorshipMap = map[Authorship]string{
+	UnknownAuthorship:      "unknown",
+	CompatibleAuthorship:   "compatible",
+	IncompatibleAuthorship: "incompatible",
+}
+
+var authorshipStrMap = func() map[string]Authorship {
+	res := make(map[string]Authorship)
+	for k, v := range authorshipMap {
+		res[v] = k
+	}
+	return res
+}()
+
+// String is an implementation of fmt.Stringer interface.
+func (a Authorship) String() string {
+	return authorshipMap[a]
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a Authorship) MarshalJSON() ([]byte, error) {
+	return []byte("\"" + a.String() + "\""), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaller.
+func (a *Authorship) UnmarshalJSON(bs []byte) error {
+	var ok bool
+	*a, ok = authorshipStrMap[strings.Trim(string(bs), `"`)]
+	if !ok {
+		return errors.New("cannot decode Authorship")
+	}
+	return nil
+}
+
+// Match is the result of a comparison of two names.
+type Match struct {
+	// VerbatimA is the first compared name-string.
+	VerbatimA string `json:"verbatimA"`
+	// VerbatimB is the second compared name-string.
+	VerbatimB string `json:"verbatimB"`
+	// Level shows how close the names are.
+	Level Level `json:"level"`
+	// Authorship shows if authorships of the names are compatible.
+	Authorship Authorship `json:"authorship"`
+	// Differences contains types of words that differ between the names.
+	// It is only calculated if names were parsed with details.
+	Differences []parsed.WordType `json:"differences,omitempty"`
+}
+
+// Compare finds how two parsed names relate to each other.
+func Compare(a, b parsed.Parsed) Match {
+	res := Match{
+		VerbatimA:   a.Verbatim,
+		VerbatimB:   b.Verbatim,
+		Authorship:  compareAuthorship(a.Authorship, b.Authorship),
+		Differences: differences(a.Words, b.Words),
+	}
+
+	ca, cb := a.Canonical, b.Canonical
+	switch {
+	case a.Verbatim == b.Verbatim:
+		res.Level = IdenticalMatch
+	case ca == nil || cb == nil:
+		res.Level = NoMatch
+	case ca.Full == cb.Full:
+		res.Level = FullMatch
+	case ca.Simple == cb.Simple && onlyRankMarkers(ca, cb):
+		res.Level = RankMarkerMatch
+	case ca.Simple == cb.Simple:
+		res.Level = SimpleMatch
+	case ca.Stemmed == cb.Stemmed:
+		res.Level = StemmedMatch
+	}
+	return res
+}
+
+func compareAuthorship(a, b *parsed.Authorship) Authorship {
+	if a == nil || b == nil {
+		return UnknownAuthorship
+	}
+	if author.CompareAuthorship(*a, *b) == author.NoMatch {
+		return IncompatibleAuthorship
+	}
+	return CompatibleAuthorship
+}
+
+// onlyRankMarkers checks that full canonical forms of names with the same
+// simple canonical forms differ only by rank markers, and not by hybrid
+// signs.
+func onlyRankMarkers(a, b *parsed.Canonical) bool {
+	ma := markers(a)
+	mb := markers(b)
+	return strings.Count(ma, "×") == strings.Count(mb, "×")
+}
+
+// markers returns words of a full canonical form that are absent in
+// the simple canonical form.
+func markers(c *parsed.Canonical) string {
+	full := strings.Fields(c.Full)
+	simple := strings.Fields(c.Simple)
+	var res []string
+	var i int
+	for _, w := range full {
+		if i < len(simple) && w == simple[i] {
+			i++
+			continue
+		}
+		res = append(res, w)
+	}
+	return strings.Join(res, " ")
+}
+
+// differences returns types of words that have different normalized values
+// in two names.
+func differences(a, b []parsed.Word) []parsed.WordType {
+	wa := wordsByType(a)
+	wb := wordsByType(b)
+	var res []parsed.WordType
+	for k, v := range wa {
+		if wb[k] != v {
+			res = append(res, k)
+		}
+	}
+	for k := range wb {
+		if _, ok := wa[k]; !ok {
+			res = append(res, k)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	return res
+}
+
+func wordsByType(ws []parsed.Word) map[parsed.WordType]string {
+	res := make(map[parsed.WordType]string)
+	for _, w := range ws {
+		if res[w.Type] != "" {
+			res[w.Type] += " "
+		}
+		res[w.Type] += w.Normalized
+	}
+	return res
+}
@@ -0,0 +1,66 @@
+package compare_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/compare"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		msg, name1, name2 string
+		level             compare.Level
+		auth              compare.Authorship
+		diffs             []parsed.WordType
+	}{
+		{"identical", "Aus bus L.", "Aus bus L.", compare.IdenticalMatch,
+			compare.CompatibleAuthorship, nil},
+		{"full", "Aus bus L.", "Aus  bus Linnaeus", compare.FullMatch,
+			compare.CompatibleAuthorship, []parsed.WordType{parsed.AuthorWordType}},
+		{"rank", "Aus bus var. cus", "Aus bus f. cus", compare.RankMarkerMatch,
+			compare.UnknownAuthorship, []parsed.WordType{parsed.RankType}},
+		{"hybrid", "Aus × bus", "Aus bus", compare.SimpleMatch,
+			compare.UnknownAuthorship, []parsed.WordType{parsed.HybridCharType}},
+		{"stem", "Aus alba Smith", "Aus albus Sm.", compare.StemmedMatch,
+			compare.CompatibleAuthorship,
+			[]parsed.WordType{parsed.AuthorWordType, parsed.SpEpithetType}},
+		{"years", "Aus bus Smith 1880", "Aus bus Smith 1890", compare.FullMatch,
+			compare.IncompatibleAuthorship, []parsed.WordType{parsed.YearType}},
+		{"authors", "Aus bus L.", "Aus bus Lam.", compare.FullMatch,
+			compare.IncompatibleAuthorship,
+			[]parsed.WordType{parsed.AuthorWordType}},
+		{"no match", "Aus bus", "Aus cus", compare.NoMatch,
+			compare.UnknownAuthorship, []parsed.WordType{parsed.SpEpithetType}},
+		{"not parsed", "Aus bus", "", compare.NoMatch,
+			compare.UnknownAuthorship,
+			[]parsed.WordType{parsed.GenusType, parsed.SpEpithetType}},
+	}
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptWithDetails(true)))
+	for _, v := range tests {
+		res := compare.Compare(gnp.ParseName(v.name1), gnp.ParseName(v.name2))
+		assert.Equal(t, v.level, res.Level, v.msg)
+		assert.Equal(t, v.auth, res.Authorship, v.msg)
+		assert.Equal(t, v.diffs, res.Differences, v.msg)
+	}
+}
+
+func TestOutput(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig())
+	res := gnp.CompareNames("Aus bus var. cus L.", "Aus bus f. cus Linnaeus")
+	assert.Equal(t, "VerbatimA,VerbatimB,Level,Authorship,Differences",
+		compare.HeaderCSV(gnfmt.CSV))
+	assert.Equal(t,
+		"Aus bus var. cus L.,Aus bus f. cus Linnaeus,RankMarkerOnly,"+
+			"compatible,AUTHOR_WORD|RANK",
+		res.Output(gnfmt.CSV),
+	)
+
+	var m compare.Match
+	err := gnfmt.GNjson{}.Decode([]byte(res.Output(gnfmt.CompactJSON)), &m)
+	assert.Nil(t, err)
+	assert.Equal(t, res, m)
+}
@@ -0,0 +1,58 @@
+package compare
+
+import (
+	"strings"
+
+	"github.com/gnames/gnfmt"
+)
+
+// Output creates a JSON or CSV representation of a Match.
+func (m Match) Output(f gnfmt.Format) string {
+	switch f {
+	case gnfmt.CSV:
+		return m.csvOutput(',')
+	case gnfmt.TSV:
+		return m.csvOutput('\t')
+	case gnfmt.CompactJSON:
+		return m.jsonOutput(false)
+	case gnfmt.PrettyJSON:
+		return m.jsonOutput(true)
+	default:
+		return "N/A"
+	}
+}
+
+// HeaderCSV returns the CSV header for comparison output.
+func HeaderCSV(f gnfmt.Format) string {
+	header := []string{"VerbatimA", "VerbatimB", "Level", "Authorship",
+		"Differences"}
+	switch f {
+	case gnfmt.CSV:
+		return gnfmt.ToCSV(header, ',')
+	case gnfmt.TSV:
+		return gnfmt.ToCSV(header, '\t')
+	default:
+		return ""
+	}
+}
+
+func (m Match) csvOutput(sep rune) string {
+	diffs := make([]string, len(m.Differences))
+	for i, v := range m.Differences {
+		diffs[i] = v.String()
+	}
+	res := []string{
+		m.VerbatimA,
+		m.VerbatimB,
+		m.Level.String(),
+		m.Authorship.String(),
+		strings.Join(diffs, "|"),
+	}
+	return gnfmt.ToCSV(res, sep)
+}
+
+func (m Match) jsonOutput(pretty bool) string {
+	enc := gnfmt.GNjson{Pretty: pretty}
+	res, _ := enc.Encode(m)
+	return string(res)
+}
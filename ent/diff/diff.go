@@ -0,0 +1,269 @@
+// Package diff finds differences between two sets of parsing results, for
+// example between outputs of two versions of GNparser. Results are aligned
+// by their VerbatimID, so the order of names in the sets does not matter.
+package diff
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// Change is a type of a difference between old and new parsing results
+// of the same name-string.
+type Change int
+
+const (
+	// Added means that a name-string exists only in the new results.
+	Added Change = iota
+	// Removed means that a name-string exists only in the old results.
+	Removed
+	// CanonicalChange means that canonical forms are different.
+	CanonicalChange
+	// CardinalityChange means that cardinalities are different.
+	CardinalityChange
+	// QualityChange means that parsing qualities are different.
+	QualityChange
+	// WarningsChange means that quality warnings are different.
+	WarningsChange
+	// AuthorshipChange means that authorships are different.
+	AuthorshipChange
+	// DetailsChange means that details or words are different.
+	DetailsChange
+	// OtherChange means that other fields (normalized, tail, annotations
+	// etc.) are different. The version of the parser is ignored.
+	OtherChange
+)
+
+var changeMap = map[Change]string{
+	Added:             "Added",
+	Removed:           "Removed",
+	CanonicalChange:   "Canonical",
+	CardinalityChange: "Cardinality",
+	QualityChange:     "Quality",
+	WarningsChange:    "Warnings",
+	AuthorshipChange:  "Authorship",
+	DetailsChange:     "Details",
+	OtherChange:       "Other",
+}
+
+var changeStrMap = func() map[string]Change {
+	res := make(map[string]Change)
+	for k, v := range changeMap {
+		res[v] = k
+	}
+	return res
+}()
+
+// String is an implementation of fmt.Stringer interface.
+func (c Change) String() string {
+	return changeMap[c]
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c Change) MarshalJSON() ([]byte, error) {
+	return []byte("\"" + c.String() + "\""), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaller.
+func (c *Change) UnmarshalJSON(bs []byte) error {
+	var ok bool
+	*c, ok = changeStrMap[strings.Trim(string(bs), `"`)]
+	if !ok {
+		return errors.New("cannot decode Change")
+	}
+	return nil
+}
+
+// Record describes differences between old and new parsing results of
+// a name-string.
+type Record struct {
+	// VerbatimID is the UUID v5 of the name-string.
+	VerbatimID string `json:"id"`
+	// Verbatim is the name-string.
+	Verbatim string `json:"verbatim"`
+	// Changes are the types of found differences.
+	Changes []Change `json:"changes"`
+	// Old is the old parsing result, it is nil for added names.
+	Old *parsed.Parsed `json:"old,omitempty"`
+	// New is the new parsing result, it is nil for removed names.
+	New *parsed.Parsed `json:"new,omitempty"`
+}
+
+// Count is the number of records with a particular type of change.
+type Count struct {
+	Change Change `json:"change"`
+	Count  int    `json:"count"`
+}
+
+// Report contains results of a comparison of two sets of parsing results.
+type Report struct {
+	// Records contain only name-strings that changed. Records of names from
+	// new results go in their order, removed names are at the end.
+	Records []Record `json:"records,omitempty"`
+	// Summary contains counts of records for every type of change that
+	// occurred. A record with several changes is counted several times.
+	Summary []Count `json:"summary"`
+	// Total is the number of unique name-strings in both sets.
+	Total int `json:"total"`
+	// Unchanged is the number of name-strings without differences.
+	Unchanged int `json:"unchanged"`
+}
+
+// Compare aligns old and new parsing results by VerbatimID and reports
+// which of them changed. If a name-string is repeated in a set, only its
+// first result is used.
+func Compare(oldRes, newRes []parsed.Parsed) Report {
+	res := Report{Records: make([]Record, 0)}
+	oldIdx := make(map[string]int)
+	for i := range oldRes {
+		if _, ok := oldIdx[oldRes[i].VerbatimID]; !ok {
+			oldIdx[oldRes[i].VerbatimID] = i
+		}
+	}
+
+	seen := make(map[string]struct{})
+	counts := make(map[Change]int)
+	add := func(r Record) {
+		for _, v := range r.Changes {
+			counts[v]++
+		}
+		res.Records = append(res.Records, r)
+	}
+
+	for i := range newRes {
+		id := newRes[i].VerbatimID
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		res.Total++
+
+		j, ok := oldIdx[id]
+		if !ok {
+			add(Record{
+				VerbatimID: id,
+				Verbatim:   newRes[i].Verbatim,
+				Changes:    []Change{Added},
+				New:        &newRes[i],
+			})
+			continue
+		}
+
+		changes := Changes(oldRes[j], newRes[i])
+		if len(changes) == 0 {
+			res.Unchanged++
+			continue
+		}
+		add(Record{
+			VerbatimID: id,
+			Verbatim:   newRes[i].Verbatim,
+			Changes:    changes,
+			Old:        &oldRes[j],
+			New:        &newRes[i],
+		})
+	}
+
+	for i := range oldRes {
+		id := oldRes[i].VerbatimID
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		res.Total++
+		add(Record{
+			VerbatimID: id,
+			Verbatim:   oldRes[i].Verbatim,
+			Changes:    []Change{Removed},
+			Old:        &oldRes[i],
+		})
+	}
+
+	for c := Added; c <= OtherChange; c++ {
+		if counts[c] > 0 {
+			res.Summary = append(res.Summary, Count{Change: c, Count: counts[c]})
+		}
+	}
+	return res
+}
+
+// Changes returns types of differences between two parsing results of
+// the same name-string. It returns nil if results are the same.
+func Changes(oldRes, newRes parsed.Parsed) []Change {
+	var res []Change
+	if !same(oldRes.Canonical, newRes.Canonical) {
+		res = append(res, CanonicalChange)
+	}
+	if oldRes.Cardinality != newRes.Cardinality {
+		res = append(res, CardinalityChange)
+	}
+	if oldRes.ParseQuality != newRes.ParseQuality {
+		res = append(res, QualityChange)
+	}
+	if !same(oldRes.QualityWarnings, newRes.QualityWarnings) {
+		res = append(res, WarningsChange)
+	}
+	if !same(oldRes.Authorship, newRes.Authorship) {
+		res = append(res, AuthorshipChange)
+	}
+	if !same(oldRes.Details, newRes.Details) || !same(oldRes.Words, newRes.Words) {
+		res = append(res, DetailsChange)
+	}
+	if !same(rest(oldRes), rest(newRes)) {
+		res = append(res, OtherChange)
+	}
+	return res
+}
+
+// rest removes fields that are compared separately, as well as the
+// version of the parser.
+func rest(p parsed.Parsed) parsed.Parsed {
+	p.Canonical = nil
+	p.Cardinality = 0
+	p.ParseQuality = 0
+	p.QualityWarnings = nil
+	p.Authorship = nil
+	p.Details = nil
+	p.Words = nil
+	p.ParserVersion = ""
+	return p
+}
+
+// same compares JSON representations of values, so nil and empty slices,
+// or details decoded from JSON and created by the parser, are the same.
+func same(a, b interface{}) bool {
+	aj, errA := json.Marshal(a)
+	bj, errB := json.Marshal(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	return empty(aj) == empty(bj)
+}
+
+func empty(bs []byte) string {
+	if string(bs) == "[]" {
+		return "null"
+	}
+	return string(bs)
+}
+
+// Decode reads parsing results from a stream of JSON objects, for
+// example from the output of GNparser in compact or pretty JSON format.
+func Decode(r io.Reader) ([]parsed.Parsed, error) {
+	var res []parsed.Parsed
+	dec := json.NewDecoder(r)
+	for {
+		var p parsed.Parsed
+		err := dec.Decode(&p)
+		if err == io.EOF {
+			return res, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, p)
+	}
+}
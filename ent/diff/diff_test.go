@@ -0,0 +1,79 @@
+package diff_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/diff"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptWithDetails(true)))
+	oldRes := gnp.ParseNames([]string{"Aus bus L.", "Bus cus", "Cus dus"})
+	newRes := gnp.ParseNames([]string{"Dus eus", "Cus dus", "Aus bus L."})
+
+	// simulate changes introduced by a new version of the parser.
+	newRes[1].ParserVersion = "v100.0.0"
+	newRes[2].Canonical = &parsed.Canonical{Simple: "Aus bus"}
+	newRes[2].ParseQuality = 2
+	newRes[2].Tail = " L."
+
+	res := diff.Compare(oldRes, newRes)
+	assert.Equal(t, 4, res.Total)
+	assert.Equal(t, 1, res.Unchanged)
+	assert.Equal(t, 3, len(res.Records))
+
+	assert.Equal(t, "Dus eus", res.Records[0].Verbatim)
+	assert.Equal(t, []diff.Change{diff.Added}, res.Records[0].Changes)
+	assert.Nil(t, res.Records[0].Old)
+
+	assert.Equal(t, "Aus bus L.", res.Records[1].Verbatim)
+	assert.Equal(t, []diff.Change{diff.CanonicalChange, diff.QualityChange,
+		diff.OtherChange}, res.Records[1].Changes)
+
+	assert.Equal(t, "Bus cus", res.Records[2].Verbatim)
+	assert.Equal(t, []diff.Change{diff.Removed}, res.Records[2].Changes)
+	assert.Nil(t, res.Records[2].New)
+
+	assert.Equal(t, []diff.Count{
+		{Change: diff.Added, Count: 1},
+		{Change: diff.Removed, Count: 1},
+		{Change: diff.CanonicalChange, Count: 1},
+		{Change: diff.QualityChange, Count: 1},
+		{Change: diff.OtherChange, Count: 1},
+	}, res.Summary)
+
+	assert.Equal(t,
+		"Aus bus L.,Canonical|Quality|Other,Aus bus,Aus bus,1,2",
+		strings.SplitN(res.Records[1].Output(gnfmt.CSV), ",", 2)[1])
+}
+
+func TestChangesDecoded(t *testing.T) {
+	names := []string{
+		"Aus bus (L.) Smith 1888", "Aus × bus", "Aus bus var. cus",
+		"Salmonella enterica subsp. enterica", "Tobacco mosaic virus",
+		"Aus bus L. foo",
+	}
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptWithDetails(true)))
+	res := gnp.ParseNames(names)
+
+	var buf bytes.Buffer
+	for _, v := range res {
+		buf.WriteString(v.Output(gnfmt.CompactJSON) + "\n")
+	}
+	decoded, err := diff.Decode(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, len(names), len(decoded))
+	for i := range res {
+		assert.Nil(t, diff.Changes(res[i], decoded[i]), names[i])
+	}
+
+	noDetails := gnparser.New(gnparser.NewConfig()).ParseNames(names[:1])
+	assert.Equal(t, []diff.Change{diff.AuthorshipChange, diff.DetailsChange},
+		diff.Changes(res[0], noDetails[0]))
+}
@@ -0,0 +1,114 @@
+package diff
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// Output creates a JSON or CSV representation of a Record. CSV output
+// contains only canonical forms and qualities of old and new results,
+// JSON output contains both results completely.
+func (r Record) Output(f gnfmt.Format) string {
+	switch f {
+	case gnfmt.CSV:
+		return r.csvOutput(',')
+	case gnfmt.TSV:
+		return r.csvOutput('\t')
+	case gnfmt.CompactJSON:
+		return jsonOutput(r, false)
+	case gnfmt.PrettyJSON:
+		return jsonOutput(r, true)
+	default:
+		return "N/A"
+	}
+}
+
+// HeaderCSV returns the CSV header for records output.
+func HeaderCSV(f gnfmt.Format) string {
+	header := []string{"Id", "Verbatim", "Changes", "OldCanonicalSimple",
+		"NewCanonicalSimple", "OldQuality", "NewQuality"}
+	switch f {
+	case gnfmt.CSV:
+		return gnfmt.ToCSV(header, ',')
+	case gnfmt.TSV:
+		return gnfmt.ToCSV(header, '\t')
+	default:
+		return ""
+	}
+}
+
+// SummaryOutput creates a JSON or CSV representation of the summary of
+// a Report.
+func (r Report) SummaryOutput(f gnfmt.Format) string {
+	switch f {
+	case gnfmt.CSV:
+		return r.csvSummary(',')
+	case gnfmt.TSV:
+		return r.csvSummary('\t')
+	case gnfmt.CompactJSON:
+		return jsonOutput(r.summary(), false)
+	case gnfmt.PrettyJSON:
+		return jsonOutput(r.summary(), true)
+	default:
+		return "N/A"
+	}
+}
+
+func (r Record) csvOutput(sep rune) string {
+	changes := make([]string, len(r.Changes))
+	for i, v := range r.Changes {
+		changes[i] = v.String()
+	}
+	oldCan, oldQual := csvFields(r.Old)
+	newCan, newQual := csvFields(r.New)
+	res := []string{
+		r.VerbatimID,
+		r.Verbatim,
+		strings.Join(changes, "|"),
+		oldCan,
+		newCan,
+		oldQual,
+		newQual,
+	}
+	return gnfmt.ToCSV(res, sep)
+}
+
+func csvFields(p *parsed.Parsed) (string, string) {
+	if p == nil {
+		return "", ""
+	}
+	var can string
+	if p.Canonical != nil {
+		can = p.Canonical.Simple
+	}
+	return can, strconv.Itoa(p.ParseQuality)
+}
+
+func (r Report) csvSummary(sep rune) string {
+	res := make([]string, 0, len(r.Summary)+3)
+	res = append(res, gnfmt.ToCSV([]string{"Change", "Count"}, sep))
+	for _, v := range r.Summary {
+		res = append(res,
+			gnfmt.ToCSV([]string{v.Change.String(), strconv.Itoa(v.Count)}, sep))
+	}
+	res = append(res,
+		gnfmt.ToCSV([]string{"Unchanged", strconv.Itoa(r.Unchanged)}, sep),
+		gnfmt.ToCSV([]string{"Total", strconv.Itoa(r.Total)}, sep),
+	)
+	return strings.Join(res, "\n")
+}
+
+// summary is a Report without records.
+func (r Report) summary() Report {
+	r.Records = nil
+	return r
+}
+
+func jsonOutput(v interface{}, pretty bool) string {
+	enc := gnfmt.GNjson{Pretty: pretty}
+	res, _ := enc.Encode(v)
+	return string(res)
+}
@@ -0,0 +1,261 @@
+// Package fuzzy provides an in-memory index of reference names for fuzzy
+// matching of scientific names. Names are matched by their canonical forms.
+// Genus and epithets are compared separately, and every element can differ
+// by a limited number of edits, so matching works without a remote
+// name-matching service.
+package fuzzy
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/gnames/gnparser/ent/compare"
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// Index keeps parsed reference names and allows to find the closest
+// of them to a given name.
+type Index struct {
+	// refs are parsed reference names.
+	refs []parsed.Parsed
+	// elements are genus and stemmed epithets of every reference name.
+	elements [][]string
+	// genera maps genus (or uninomial) to references that contain it.
+	genera map[string][]int
+	// lengths groups genera by their length in runes. A genus can match
+	// only genera that differ in length by no more than maxDist, so
+	// a lookup does not have to scan all genera.
+	lengths map[int][]string
+	// maxDist is the largest allowed edit distance for one element.
+	maxDist int
+	// size is the number of indexed names.
+	size int
+}
+
+// Candidate is a reference name that matches an input name.
+type Candidate struct {
+	// Reference is the parsed reference name.
+	Reference parsed.Parsed `json:"reference"`
+	// EditDistance is the sum of edit distances of all elements of names.
+	EditDistance int `json:"editDistance"`
+	// Score is the similarity of names from 0 to 1, where 1 means that
+	// stemmed canonical forms are identical.
+	Score float64 `json:"score"`
+	// Authorship shows if authorships of names are compatible.
+	Authorship compare.Authorship `json:"authorship"`
+}
+
+// Result contains an input name and its candidate matches ordered from
+// the best to the worst.
+type Result struct {
+	// Input is the parsed input name.
+	Input parsed.Parsed `json:"input"`
+	// Candidates are the reference names that match the input.
+	Candidates []Candidate `json:"candidates"`
+}
+
+// New creates an Index from parsed reference names. Names without
+// canonical forms and virus names are not indexed. The maxDist argument
+// sets the largest allowed edit distance for one element of a name;
+// smaller distances are used for short words.
+func New(refs []parsed.Parsed, maxDist int) *Index {
+	idx := &Index{
+		refs:     refs,
+		elements: make([][]string, len(refs)),
+		genera:   make(map[string][]int),
+		lengths:  make(map[int][]string),
+		maxDist:  maxDist,
+	}
+	for i := range refs {
+		els := elements(refs[i])
+		if len(els) == 0 {
+			continue
+		}
+		idx.elements[i] = els
+		if _, ok := idx.genera[els[0]]; !ok {
+			l := len([]rune(els[0]))
+			idx.lengths[l] = append(idx.lengths[l], els[0])
+		}
+		idx.genera[els[0]] = append(idx.genera[els[0]], i)
+		idx.size++
+	}
+	return idx
+}
+
+// Len returns the number of indexed reference names.
+func (idx *Index) Len() int {
+	return idx.size
+}
+
+// Match finds reference names that are close to the given parsed name.
+// Only names with the same number of elements are compared. At most limit
+// best candidates are returned, if limit is less than 1 all candidates are
+// returned.
+func (idx *Index) Match(p parsed.Parsed, limit int) Result {
+	res := Result{Input: p, Candidates: []Candidate{}}
+	els := elements(p)
+	if len(els) == 0 {
+		return res
+	}
+
+	l := len([]rune(els[0]))
+	for gl := l - idx.maxDist; gl <= l+idx.maxDist; gl++ {
+		for _, genus := range idx.lengths[gl] {
+			gDist, ok := distance(els[0], genus, idx.maxDist)
+			if !ok {
+				continue
+			}
+			for _, id := range idx.genera[genus] {
+				refEls := idx.elements[id]
+				if len(refEls) != len(els) {
+					continue
+				}
+				if cand, ok := idx.candidate(p, els, id, gDist); ok {
+					res.Candidates = append(res.Candidates, cand)
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(res.Candidates, func(i, j int) bool {
+		ci, cj := res.Candidates[i], res.Candidates[j]
+		if ci.Score != cj.Score {
+			return ci.Score > cj.Score
+		}
+		if ci.Authorship != cj.Authorship {
+			return ci.Authorship == compare.CompatibleAuthorship
+		}
+		return ci.Reference.Verbatim < cj.Reference.Verbatim
+	})
+	if limit > 0 && len(res.Candidates) > limit {
+		res.Candidates = res.Candidates[:limit]
+	}
+	return res
+}
+
+func (idx *Index) candidate(
+	p parsed.Parsed,
+	els []string,
+	id, gDist int,
+) (Candidate, bool) {
+	refEls := idx.elements[id]
+	dist := gDist
+	sim := similarity(els[0], refEls[0], gDist)
+	for i := 1; i < len(els); i++ {
+		d, ok := distance(els[i], refEls[i], idx.maxDist)
+		if !ok {
+			return Candidate{}, false
+		}
+		dist += d
+		sim += similarity(els[i], refEls[i], d)
+	}
+
+	ref := idx.refs[id]
+	m := compare.Compare(p, ref)
+	return Candidate{
+		Reference:    ref,
+		EditDistance: dist,
+		Score:        sim / float64(len(els)),
+		Authorship:   m.Authorship,
+	}, true
+}
+
+// elements returns the genus (or uninomial) and stemmed epithets of
+// a name.
+func elements(p parsed.Parsed) []string {
+	if !p.Parsed || p.Canonical == nil || p.Virus {
+		return nil
+	}
+	stem := strings.Fields(p.Canonical.Stemmed)
+	simple := strings.Fields(p.Canonical.Simple)
+	if len(stem) == 0 || len(stem) != len(simple) {
+		return nil
+	}
+	stem[0] = simple[0]
+	for i := range stem {
+		stem[i] = strings.ToLower(stem[i])
+	}
+	return stem
+}
+
+func similarity(a, b string, dist int) float64 {
+	l := len([]rune(a))
+	if lb := len([]rune(b)); lb > l {
+		l = lb
+	}
+	if l == 0 {
+		return 1
+	}
+	return 1 - float64(dist)/float64(l)
+}
+
+// maxDistance returns the largest edit distance allowed for a word.
+// Short words have to match exactly, or with only one edit. Two words are
+// compared using the limit of the longer one.
+func maxDistance(word string, maxDist int) int {
+	l := len([]rune(word))
+	switch {
+	case l < 4:
+		return 0
+	case l < 7 && maxDist > 1:
+		return 1
+	default:
+		return maxDist
+	}
+}
+
+// distance calculates Levenshtein edit distance between two words. It
+// returns false if the distance is larger than allowed for the words.
+func distance(a, b string, maxDist int) (int, bool) {
+	if a == b {
+		return 0, true
+	}
+	max := maxDistance(a, maxDist)
+	if m := maxDistance(b, maxDist); m > max {
+		max = m
+	}
+	ra, rb := []rune(a), []rune(b)
+	if abs(len(ra)-len(rb)) > max {
+		return 0, false
+	}
+
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(min(prev[j]+1, cur[j-1]+1), prev[j-1]+cost)
+			if cur[j] < rowMin {
+				rowMin = cur[j]
+			}
+		}
+		if rowMin > max {
+			return 0, false
+		}
+		prev, cur = cur, prev
+	}
+	d := prev[len(rb)]
+	return d, d <= max
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
@@ -0,0 +1,81 @@
+package fuzzy_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/compare"
+	"github.com/gnames/gnparser/ent/fuzzy"
+	"github.com/stretchr/testify/assert"
+)
+
+var refs = []string{
+	"Pomatomus saltatrix (Linnaeus, 1766)",
+	"Pseudophyllum alba",
+	"Aus bus L.",
+	"Aus bus Lam.",
+	"Aus bus var. cus",
+	"Abies",
+	"Influenza A virus",
+	"",
+}
+
+func TestMatch(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptWithDetails(true)))
+	idx := fuzzy.New(gnp.ParseNames(refs), 2)
+	assert.Equal(t, 6, idx.Len())
+
+	tests := []struct {
+		msg, in string
+		refs    []string
+		dist    int
+	}{
+		{"exact", "Pomatomus saltatrix", []string{refs[0]}, 0},
+		{"genus and epithet", "Pomatomas saltatrics", []string{refs[0]}, 3},
+		{"longer genus", "Pomattomus saltatrix", []string{refs[0]}, 1},
+		{"suffix", "Pseudophylum albus", []string{refs[1]}, 1},
+		{"authors", "Aus buss Linn.", []string{refs[2], refs[3]}, 1},
+		{"rank", "Aus bus f. cus", []string{refs[4]}, 0},
+		{"uninomial", "Abis", []string{refs[5]}, 1},
+		{"cardinality", "Abies alba", []string{}, 0},
+		{"short", "Bus bus", []string{}, 0},
+		{"too far", "Pomatomus sallator", []string{}, 0},
+		{"virus", "Influenza A virus", []string{}, 0},
+	}
+	for _, v := range tests {
+		res := idx.Match(gnp.ParseName(v.in), 0)
+		assert.Equal(t, v.in, res.Input.Verbatim, v.msg)
+		vs := make([]string, len(res.Candidates))
+		for i := range res.Candidates {
+			vs[i] = res.Candidates[i].Reference.Verbatim
+		}
+		assert.Equal(t, v.refs, vs, v.msg)
+		if len(vs) > 0 {
+			assert.Equal(t, v.dist, res.Candidates[0].EditDistance, v.msg)
+		}
+	}
+
+	res := idx.Match(gnp.ParseName("Aus bus Lamarck"), 1)
+	assert.Equal(t, 1, len(res.Candidates))
+	assert.Equal(t, "Aus bus Lam.", res.Candidates[0].Reference.Verbatim)
+	assert.Equal(t, 1.0, res.Candidates[0].Score)
+	assert.Equal(t, compare.CompatibleAuthorship, res.Candidates[0].Authorship)
+}
+
+func TestOutput(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig())
+	idx := fuzzy.New(gnp.ParseNames(refs), 2)
+	res := idx.Match(gnp.ParseName("Pseudophylum albus"), 1)
+	assert.Equal(t,
+		"Verbatim,CanonicalSimple,ReferenceVerbatim,ReferenceCanonicalSimple,"+
+			"EditDistance,Score,Authorship",
+		fuzzy.HeaderCSV(gnfmt.CSV))
+	assert.Equal(t,
+		"Pseudophylum albus,Pseudophylum albus,Pseudophyllum alba,"+
+			"Pseudophyllum alba,1,0.962,unknown",
+		res.Output(gnfmt.CSV))
+
+	res = idx.Match(gnp.ParseName("Zzz"), 1)
+	assert.Equal(t, "Zzz,Zzz,,,,,", res.Output(gnfmt.CSV))
+}
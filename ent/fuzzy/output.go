@@ -0,0 +1,72 @@
+package fuzzy
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gnames/gnfmt"
+)
+
+// Output creates a JSON or CSV representation of a Result. CSV output
+// contains one line per candidate, or one line with empty fields if there
+// are no candidates.
+func (r Result) Output(f gnfmt.Format) string {
+	switch f {
+	case gnfmt.CSV:
+		return r.csvOutput(',')
+	case gnfmt.TSV:
+		return r.csvOutput('\t')
+	case gnfmt.CompactJSON:
+		return r.jsonOutput(false)
+	case gnfmt.PrettyJSON:
+		return r.jsonOutput(true)
+	default:
+		return "N/A"
+	}
+}
+
+// HeaderCSV returns the CSV header for matching output.
+func HeaderCSV(f gnfmt.Format) string {
+	header := []string{"Verbatim", "CanonicalSimple", "ReferenceVerbatim",
+		"ReferenceCanonicalSimple", "EditDistance", "Score", "Authorship"}
+	switch f {
+	case gnfmt.CSV:
+		return gnfmt.ToCSV(header, ',')
+	case gnfmt.TSV:
+		return gnfmt.ToCSV(header, '\t')
+	default:
+		return ""
+	}
+}
+
+func (r Result) csvOutput(sep rune) string {
+	var canonical string
+	if r.Input.Canonical != nil {
+		canonical = r.Input.Canonical.Simple
+	}
+	if len(r.Candidates) == 0 {
+		res := []string{r.Input.Verbatim, canonical, "", "", "", "", ""}
+		return gnfmt.ToCSV(res, sep)
+	}
+
+	lines := make([]string, len(r.Candidates))
+	for i, v := range r.Candidates {
+		res := []string{
+			r.Input.Verbatim,
+			canonical,
+			v.Reference.Verbatim,
+			v.Reference.Canonical.Simple,
+			strconv.Itoa(v.EditDistance),
+			strconv.FormatFloat(v.Score, 'f', 3, 64),
+			v.Authorship.String(),
+		}
+		lines[i] = gnfmt.ToCSV(res, sep)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (r Result) jsonOutput(pretty bool) string {
+	enc := gnfmt.GNjson{Pretty: pretty}
+	res, _ := enc.Encode(r)
+	return string(res)
+}
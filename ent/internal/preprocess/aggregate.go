@@ -0,0 +1,47 @@
+package preprocess
+
+import (
+	"regexp"
+	"strings"
+)
+
+// aggregateRe finds qualifiers of aggregates, species groups, species
+// complexes and clades. Group and clade qualifiers are case-sensitive,
+// otherwise an abbreviated author like 'Gr.' would be taken for a group.
+var aggregateRe = regexp.MustCompile(
+	`(,\s*|\s+)\(?((?i:s\.\s?agg\.?|aggr?\.?|aggregate)` +
+		`|(species\s+|sp\.\s*|spp\.\s*)?(group|gr\.|grp\.?)` +
+		`|(?i:(species\s+)?(complex|cplx\.?))|clade)\)?\s*$`,
+)
+
+// aggregate finds a qualifier of an aggregate, a species group,
+// a species complex or a clade like "agg.", "species group", "complex",
+// "clade" at the end of a name-string. It returns the start of the
+// qualifier, its normalized value ("agg.", "group", "complex" or "clade")
+// and the qualifier as it appears in
+// the name-string with normalized spaces ("s. agg.", "species group").
+// If qualifier is not found, the start is -1.
+func aggregate(bs []byte) (int, string, string) {
+	loc := aggregateRe.FindSubmatchIndex(bs)
+	if loc == nil {
+		return -1, "", ""
+	}
+
+	// a qualifier requires at least a binomial, 'Aus group' is left intact.
+	if len(strings.Fields(string(bs[:loc[0]]))) < 2 {
+		return -1, "", ""
+	}
+
+	verbatim := strings.Join(strings.Fields(string(bs[loc[4]:loc[5]])), " ")
+	qual := strings.ToLower(verbatim)
+	switch {
+	case strings.Contains(qual, "agg"):
+		return loc[0], "agg.", verbatim
+	case strings.Contains(qual, "gr"):
+		return loc[0], "group", verbatim
+	case qual == "clade":
+		return loc[0], "clade", verbatim
+	default:
+		return loc[0], "complex", verbatim
+	}
+}
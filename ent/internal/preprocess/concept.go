@@ -0,0 +1,99 @@
+package preprocess
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var taxonConceptRe = regexp.MustCompile(
+	`(,\s*|\s+)\(?((?i:sensu|auctorum|auctt?|secundum|sec)` +
+		`|s\.\s?s\.|s\.\s?l\.|s\.\s?str\.|s\.\s?lat\.)`,
+)
+
+var proParteRe = regexp.MustCompile(
+	`(?i)(,\s*|\s+)\(?(pro parte|p\.\s?p\.)\)?\s*$`,
+)
+
+// taxonConcept finds a taxon concept qualifier like "sensu lato", "s. str.",
+// "sensu Smith 1990", "auct. non L.", "sec. Jones", "pro parte". Usually
+// the qualifier continues to the end of the name-string, in this case its
+// start is returned. Short qualifiers like "s.l." might be followed by
+// authors of the name, in such cases they are replaced by spaces in the
+// slice and the returned start is -1. Verbatim is empty if
+// no qualifier is found.
+func taxonConcept(bs []byte) (int, string) {
+	start := -1
+	for _, loc := range taxonConceptRe.FindAllSubmatchIndex(bs, -1) {
+		if loc[1] < len(bs) && bs[loc[1]-1] != '.' {
+			r, _ := utf8.DecodeRune(bs[loc[1]:])
+			if unicode.IsLetter(r) {
+				continue
+			}
+		}
+		if len(strings.Fields(string(bs[:loc[0]]))) == 0 {
+			continue
+		}
+		qual := strings.ToLower(string(bs[loc[4]:loc[5]]))
+		rest := strings.TrimSpace(string(bs[loc[1]:]))
+		paren := bs[loc[4]-1] == '('
+		switch qual {
+		case "sensu", "sec", "secundum", "auctorum", "auct", "auctt":
+			// 'sensu' or 'sec' without anything after them are junk
+			if qual[0] == 's' && strings.Trim(rest, ".") == "" {
+				continue
+			}
+			// '(auct.) Baker' is an authorship
+			if paren && !strings.HasSuffix(rest, ")") {
+				continue
+			}
+		default:
+			rest = strings.TrimSpace(strings.TrimPrefix(rest, ")"))
+			if rest == "" || strings.HasPrefix(rest, ",") {
+				break
+			}
+			// a short qualifier in the middle of a name, for example
+			// 'Aus bus s.l. (L.) Mill.', other cases are left to the tail.
+			r, _ := utf8.DecodeRuneInString(rest)
+			if unicode.IsLetter(r) || r == '(' {
+				return -1, blankConcept(bs, loc[4], loc[1])
+			}
+			continue
+		}
+		start = loc[0]
+		break
+	}
+
+	if loc := proParteRe.FindIndex(bs); loc != nil &&
+		(start == -1 || loc[0] < start) &&
+		len(strings.Fields(string(bs[:loc[0]]))) > 0 {
+		start = loc[0]
+	}
+	if start == -1 {
+		return start, ""
+	}
+
+	verbatim := strings.TrimLeft(string(bs[start:]), ", \t\n")
+	verbatim = strings.TrimSpace(verbatim)
+	if strings.HasPrefix(verbatim, "(") && strings.HasSuffix(verbatim, ")") {
+		verbatim = verbatim[1 : len(verbatim)-1]
+	}
+	return start, verbatim
+}
+
+// blankConcept replaces a qualifier in the middle of a name with spaces,
+// and returns the verbatim value of the qualifier.
+func blankConcept(bs []byte, start, end int) string {
+	if start > 0 && bs[start-1] == '(' {
+		start--
+	}
+	if end < len(bs) && bs[end] == ')' {
+		end++
+	}
+	res := string(bs[start:end])
+	for i := start; i < end; i++ {
+		bs[i] = ' '
+	}
+	return strings.Trim(res, "()")
+}
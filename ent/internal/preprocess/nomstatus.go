@@ -0,0 +1,102 @@
+package preprocess
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var nomStatusRe = regexp.MustCompile(
+	`(?i)(,\s*|\s+)\(?` +
+		`(nomen|nom\.?|combinatio|comb\.?|status|stat\.?|species|spec\.?|sp\.?` +
+		`|genus|gen\.?|nov\.?|n\.)\s*` +
+		`(nudum|nud\.?|illegitimum|illeg\.?|conservandum|cons\.?` +
+		`|rejiciendum|rej\.?|novum|nova|novus|nov\.?|dubium|dub\.?` +
+		`|invalidum|inval\.?|superfluum|superfl\.?|ambiguum|ambig\.?` +
+		`|provisorium|prov\.?|n\.|spec\.?|sp\.?)\)?`,
+)
+
+// nomStatusStems normalize words of a nomenclatural status to their
+// standard abbreviations.
+var nomStatusStems = []struct{ prefix, stem string }{
+	{"nom", "nom."}, {"comb", "comb."}, {"stat", "stat."}, {"sp", "sp."},
+	{"gen", "gen."}, {"nud", "nud."}, {"illeg", "illeg."}, {"cons", "cons."},
+	{"rej", "rej."}, {"nov", "nov."}, {"dub", "dub."}, {"inval", "inval."},
+	{"superfl", "superfl."}, {"ambig", "ambig."}, {"prov", "prov."},
+}
+
+// nomStatuses contains allowed combinations of normalized words and
+// the resulting nomenclatural status.
+var nomStatuses = map[string]string{
+	"nom. nud.":     "nom. nud.",
+	"nom. illeg.":   "nom. illeg.",
+	"nom. cons.":    "nom. cons.",
+	"nom. rej.":     "nom. rej.",
+	"nom. nov.":     "nom. nov.",
+	"nom. dub.":     "nom. dub.",
+	"nom. inval.":   "nom. inval.",
+	"nom. superfl.": "nom. superfl.",
+	"nom. ambig.":   "nom. ambig.",
+	"nom. prov.":    "nom. prov.",
+	"comb. nov.":    "comb. nov.",
+	"stat. nov.":    "stat. nov.",
+	"sp. nov.":      "sp. nov.",
+	"nov. sp.":      "sp. nov.",
+	"gen. nov.":     "gen. nov.",
+	"nov. gen.":     "gen. nov.",
+}
+
+// nomStatus finds a nomenclatural status like "nom. nud.", "comb. nov.",
+// "sp. nov." in a name-string. It returns the start and the end of the
+// status, its verbatim and normalized values. If status is not found, the
+// start is -1.
+func nomStatus(bs []byte) (int, int, string, string) {
+	for _, loc := range nomStatusRe.FindAllSubmatchIndex(bs, -1) {
+		start, end := loc[0], loc[1]
+		if end < len(bs) {
+			r, _ := utf8.DecodeRune(bs[end:])
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				continue
+			}
+		}
+		w1 := nomStatusStem(string(bs[loc[4]:loc[5]]))
+		w2 := nomStatusStem(string(bs[loc[6]:loc[7]]))
+		norm := nomStatuses[w1+" "+w2]
+		if norm == "" {
+			continue
+		}
+
+		// 'Aus sp. nov.' is an approximation of an undescribed species,
+		// the status requires at least a binomial.
+		words := strings.Fields(string(bs[:start]))
+		if len(words) == 0 || (norm == "sp. nov." && len(words) < 2) {
+			continue
+		}
+
+		// 'Aus bus vel sp. nov.' is an open nomenclature qualifier.
+		if l := len(words); norm == "sp. nov." && strings.TrimRight(words[l-1], ".") == "vel" {
+			continue
+		}
+
+		verbatim := strings.TrimLeft(string(bs[start:end]), ", \t\n")
+		if strings.HasPrefix(verbatim, "(") && strings.HasSuffix(verbatim, ")") {
+			verbatim = verbatim[1 : len(verbatim)-1]
+		}
+		return start, end, verbatim, norm
+	}
+	return -1, -1, "", ""
+}
+
+func nomStatusStem(w string) string {
+	w = strings.ToLower(strings.TrimRight(w, "."))
+	if w == "n" {
+		return "nov."
+	}
+	for _, v := range nomStatusStems {
+		if strings.HasPrefix(w, v.prefix) {
+			return v.stem
+		}
+	}
+	return w
+}
@@ -8,61 +8,9 @@ import (
 	"regexp"
 	"strings"
 	"unicode"
-)
-
-var VirusException = map[string]string{
-	"Aspilota":      "vector",
-	"Bembidion":     "satellites",
-	"Bolivina":      "prion",
-	"Ceylonesmus":   "vector",
-	"Cryptops":      "vector",
-	"Culex":         "vector",
-	"Dasyproctus":   "cevirus",
-	"Desmoxytes":    "vector",
-	"Dicathais":     "vector",
-	"Erateina":      "satellites",
-	"Euragallia":    "prion",
-	"Exochus":       "virus",
-	"Hilara":        "vector",
-	"Ithomeis":      "satellites",
-	"Microgoneplax": "prion",
-	"Neoaemula":     "vector",
-	"Nephodia":      "satellites",
-	"Ophion":        "virus",
-	"Psenulus":      "trevirus",
-	"Tidabius":      "vector",
-}
-
-var AmbiguousException = map[string][]string{
-	"Agnetina":       {"den"},
-	"Antaplaga":      {"dela"},
-	"Baeolidia":      {"dela"},
-	"Bolitoglossa":   {"la"},
-	"Campylosphaera": {"dela"},
-	"Desmoxytes":     {"des"},
-	"Dicentria":      {"dela"},
-	"Eulaira":        {"dela"},
-	"Gnathopleustes": {"den"},
-	"Helophorus":     {"ser"},
-	"Leptonetela":    {"la"},
-	"Malamatidia":    {"zu"},
-	"Meteorus":       {"dos"},
-	"Nocaracris":     {"van"},
-	"Paralvinella":   {"dela"},
-	"Ruteloryctes":   {"bis"},
-	"Scoparia":       {"dela"},
-	"Selenops":       {"ab"},
-	"Semiothisa":     {"da"},
-	"Serina":         {"ser", "subser"},
-	"Stenoecia":      {"dos"},
-	"Sympycnus":      {"du"},
-	"Tortolena":      {"dela"},
-	"Zodarion":       {"van"},
-}
 
-var NoParseException = map[string]string{
-	"Navicula": "bacterium",
-}
+	"github.com/gnames/gnparser/io/dict"
+)
 
 var notesRe = regexp.MustCompile(
 	`(?i)\s+((environmental|samples|species\s+group|species\s+complex|clade|group|author|nec|vide|fide)\b|non[^a-zA-Z-]).*$`,
@@ -98,6 +46,10 @@ var ofWordRe = regexp.MustCompile(
 	`\s+(of[\W_]).*$`,
 )
 
+var plasmidRe = regexp.MustCompile(
+	`(?i)(^|\W)\w*plasmids?(\W|$)`,
+)
+
 var dagger = []byte("†")
 
 // Preprocessor structure keeps state of the preprocessor results.
@@ -111,6 +63,21 @@ type Preprocessor struct {
 	Body        []byte
 	Tail        []byte
 	Ambiguous   ambiguous
+	// NomStatus is a normalized nomenclatural status, for example
+	// "nom. nud.", "comb. nov.".
+	NomStatus string
+	// NomStatusVerbatim is the nomenclatural status as it appears in
+	// the name-string.
+	NomStatusVerbatim string
+	// TaxonConcept is a verbatim taxon concept qualifier, for example
+	// "sensu lato", "auct. non L.".
+	TaxonConcept string
+	// Aggregate is a normalized qualifier of an aggregate, a species group,
+	// a species complex or a clade ("agg.", "group", "complex", "clade").
+	Aggregate string
+	// AggregateVerbatim is the qualifier of an aggregate as it appears in
+	// the name-string, for example "s. agg.", "species group".
+	AggregateVerbatim string
 }
 
 type ambiguous struct {
@@ -119,8 +86,13 @@ type ambiguous struct {
 }
 
 // Preprocess runs a series of regular expressions over the input to determine
-// features of the input before parsing.
-func Preprocess(bs []byte) *Preprocessor {
+// features of the input before parsing. Exceptions for viruses, unparseable
+// and ambiguous names are taken from the dictionary d. If d is nil,
+// the default dictionary is used.
+func Preprocess(bs []byte, d *dict.Dictionary) *Preprocessor {
+	if d == nil {
+		d = dict.Dict
+	}
 	pr := &Preprocessor{}
 
 	// check for empty string
@@ -131,9 +103,9 @@ func Preprocess(bs []byte) *Preprocessor {
 	i := len(bs)
 	words := strings.Fields(string(bs))
 
-	// check for viruses, plasmids, RNA, DNA etc.
-	if !isException(words, VirusException) {
-		pr.Virus = IsVirus(bs[0:i])
+	// check for viruses, phages, plasmids, prions etc.
+	if !isException(words, d.VirusException) {
+		pr.Virus = IsVirus(bs[0:i]) || plasmidRe.Match(bs[0:i])
 	}
 	if pr.Virus {
 		pr.NoParse = true
@@ -142,7 +114,7 @@ func Preprocess(bs []byte) *Preprocessor {
 
 	// check for unparseable names
 	pr.NoParse = NoParse(bs[0:i])
-	if isException(words, NoParseException) {
+	if isException(words, d.NoParseException) {
 		pr.NoParse = false
 	}
 	if pr.NoParse {
@@ -152,7 +124,47 @@ func Preprocess(bs []byte) *Preprocessor {
 	pr.DaggerChar = hasDagger(bs[0:i])
 
 	if len(words) > 1 {
-		pr.ambiguous(words[0], bs)
+		pr.ambiguous(words[0], bs, d.AmbiguousException)
+	}
+
+	// tailEnd is the end of unparsed tail before a taxon concept or
+	// a nomenclatural status, statusTail is a remaining part of the string
+	// after a nomenclatural status.
+	tailEnd := i
+	var statusTail []byte
+	start, end, verbatim, status := nomStatus(bs[0:i])
+	if start > -1 {
+		pr.NomStatus = status
+		pr.NomStatusVerbatim = verbatim
+		statusEnd := i
+		// a taxon concept might follow the status, for example
+		// 'Aus bus stat. nov. sensu Smith'. The copy keeps short
+		// qualifiers in the middle of the status tail from being blanked.
+		statusPart := append([]byte{}, bs[start:i]...)
+		cStart, concept := taxonConcept(statusPart)
+		if concept != "" && cStart > -1 && start+cStart >= end {
+			pr.TaxonConcept = concept
+			statusEnd = start + cStart
+		}
+		statusTail = bs[end:statusEnd]
+		i, tailEnd = start, start
+	}
+
+	if pr.TaxonConcept == "" {
+		start, concept := taxonConcept(bs[0:i])
+		if concept != "" {
+			pr.TaxonConcept = concept
+			if start > -1 {
+				i, tailEnd = start, start
+			}
+		}
+	}
+
+	start, aggr, aggrVerbatim := aggregate(bs[0:i])
+	if start > -1 {
+		pr.Aggregate = aggr
+		pr.AggregateVerbatim = aggrVerbatim
+		i, tailEnd = start, start
 	}
 
 	j := procAnnot(bs[0:i])
@@ -168,7 +180,10 @@ func Preprocess(bs []byte) *Preprocessor {
 	}
 
 	pr.Body = bs[0:i]
-	pr.Tail = bs[i:]
+	pr.Tail = bs[i:tailEnd]
+	if len(statusTail) > 0 {
+		pr.Tail = append(bs[i:tailEnd:tailEnd], statusTail...)
+	}
 	return pr
 }
 
@@ -183,22 +198,28 @@ func hasDagger(bs []byte) bool {
 	return true
 }
 
-func isException(words []string, names map[string]string) bool {
+func isException(words []string, names map[string][]string) bool {
 	if len(words) < 2 {
 		return false
 	}
-	if epithet, ok := names[words[0]]; ok {
+	if epithets, ok := names[words[0]]; ok {
 		for _, w := range words[1:] {
-			if w == epithet {
-				return true
+			for _, epithet := range epithets {
+				if w == epithet {
+					return true
+				}
 			}
 		}
 	}
 	return false
 }
 
-func (p *Preprocessor) ambiguous(firstWord string, bs []byte) {
-	if epithets, ok := AmbiguousException[firstWord]; ok {
+func (p *Preprocessor) ambiguous(
+	firstWord string,
+	bs []byte,
+	exceptions map[string][]string,
+) {
+	if epithets, ok := exceptions[firstWord]; ok {
 		var sub byte = 'k'
 		for _, epithet := range epithets {
 			idx := bytes.Index(bs, []byte(" "+epithet))
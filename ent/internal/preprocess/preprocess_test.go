@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/gnames/gnparser/io/dict"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -49,7 +50,7 @@ func TestPreprocess(t *testing.T) {
 		}
 		for _, v := range data {
 			words := strings.Split(v.name, " ")
-			assert.Equal(t, isException(words, NoParseException), v.likeAnnotation, v.msg)
+			assert.Equal(t, isException(words, dict.Dict.NoParseException), v.likeAnnotation, v.msg)
 		}
 	})
 
@@ -79,7 +80,7 @@ func TestPreprocess(t *testing.T) {
 		}
 		for _, v := range data {
 			words := strings.Split(v.name, " ")
-			assert.Equal(t, isException(words, VirusException), v.likeVirus, v.msg)
+			assert.Equal(t, isException(words, dict.Dict.VirusException), v.likeVirus, v.msg)
 		}
 	})
 
@@ -114,6 +115,114 @@ func TestPreprocess(t *testing.T) {
 		}
 	})
 
+	t.Run("PlasmidIsVirus", func(t *testing.T) {
+		data := []struct {
+			msg     string
+			name    string
+			isVirus bool
+		}{
+			{"No match", "Homo sapiens", false},
+			{"Plasmid1", "E. coli plasmids", true},
+			{"Plasmid2", "E. coli plasmidia", false},
+			{"Plasmid3", "Escherichia coli plasmid pUC19", true},
+		}
+		for _, v := range data {
+			res := Preprocess([]byte(v.name), nil)
+			assert.Equal(t, res.Virus, v.isVirus, v.msg)
+		}
+	})
+
+	t.Run("NomStatus", func(t *testing.T) {
+		data := []struct {
+			msg, name, body, tail, verbatim, status string
+		}{
+			{"no status", "Aus bus Smith", "Aus bus Smith", "", "", ""},
+			{"nom. nud.", "Aus bus Smith, nom. nud.", "Aus bus Smith", "",
+				"nom. nud.", "nom. nud."},
+			{"nomen nudum", "Aus bus Smith (nomen nudum)", "Aus bus Smith", "",
+				"nomen nudum", "nom. nud."},
+			{"comb. nov.", "Aus bus (L.) Smith comb.nov.", "Aus bus (L.) Smith",
+				"", "comb.nov.", "comb. nov."},
+			{"sp. n.", "Aus bus Smith sp. n.", "Aus bus Smith", "",
+				"sp. n.", "sp. nov."},
+			{"gen. nov.", "Aus Smith gen. nov.", "Aus Smith", "",
+				"gen. nov.", "gen. nov."},
+			{"with tail", "Aus bus Smith, nom. illeg. (pro syn.)",
+				"Aus bus Smith", " (pro syn.)", "nom. illeg.", "nom. illeg."},
+			{"approximation", "Aus sp. nov.", "Aus sp. nov.", "", "", ""},
+			{"vel sp. nov.", "Aus bus vel sp. nov.", "Aus bus vel sp. nov.", "",
+				"", ""},
+			{"epithet", "Impatiens nomenyae Fisch.", "Impatiens nomenyae Fisch.",
+				"", "", ""},
+		}
+		for _, v := range data {
+			res := Preprocess([]byte(v.name), nil)
+			assert.Equal(t, v.body, string(res.Body), v.msg)
+			assert.Equal(t, v.tail, string(res.Tail), v.msg)
+			assert.Equal(t, v.verbatim, res.NomStatusVerbatim, v.msg)
+			assert.Equal(t, v.status, res.NomStatus, v.msg)
+		}
+	})
+
+	t.Run("TaxonConcept", func(t *testing.T) {
+		data := []struct {
+			msg, name, body, tail, concept string
+		}{
+			{"no concept", "Aus bus Smith", "Aus bus Smith", "", ""},
+			{"sensu lato", "Aus bus sensu lato", "Aus bus", "", "sensu lato"},
+			{"sensu author", "Aus bus L., sensu Smith 1990", "Aus bus L.", "",
+				"sensu Smith 1990"},
+			{"auct.", "Aus bus auct. non L.", "Aus bus", "", "auct. non L."},
+			{"pro parte", "Aus bus L., p.p.", "Aus bus L.", "", "p.p."},
+			{"parens", "Aus bus L. (s.str.)", "Aus bus L.", "", "s.str."},
+			{"s.l. before authors", "Aus bus s.l. (L.) Mill.",
+				"Aus bus      (L.) Mill.", "", "s.l."},
+			{"auct. author", "Aus bus var. cus (auct.) Baker",
+				"Aus bus var. cus (auct.) Baker", "", ""},
+			{"sensu word", "Aus bus sensu", "Aus bus", " sensu", ""},
+			{"sections", "Aus sect. Bus", "Aus sect. Bus", "", ""},
+			{"after status", "Aus bus stat. nov. sensu Smith", "Aus bus", "",
+				"sensu Smith"},
+			{"s.l. after status", "Aus bus stat. nov. s.l. Smith", "Aus bus",
+				" s.l. Smith", ""},
+		}
+		for _, v := range data {
+			res := Preprocess([]byte(v.name), nil)
+			assert.Equal(t, v.body, string(res.Body), v.msg)
+			assert.Equal(t, v.tail, string(res.Tail), v.msg)
+			assert.Equal(t, v.concept, res.TaxonConcept, v.msg)
+		}
+	})
+
+	t.Run("Aggregate", func(t *testing.T) {
+		data := []struct {
+			msg, name, body, tail, aggregate, verbatim string
+		}{
+			{"no aggregate", "Aus bus Smith", "Aus bus Smith", "", "", ""},
+			{"agg.", "Rubus fruticosus agg.", "Rubus fruticosus", "", "agg.",
+				"agg."},
+			{"s. agg.", "Aus bus L. s. agg.", "Aus bus L.", "", "agg.",
+				"s. agg."},
+			{"species group", "Aus bus species  group", "Aus bus", "", "group",
+				"species group"},
+			{"sp. gr.", "Aus bus sp. gr.", "Aus bus", "", "group", "sp. gr."},
+			{"parens", "Aus bus (group)", "Aus bus", "", "group", "group"},
+			{"complex", "Aus bus Smith, complex", "Aus bus Smith", "",
+				"complex", "complex"},
+			{"clade", "Aus bus clade", "Aus bus", "", "clade", "clade"},
+			{"author Gr.", "Aus bus Gr.", "Aus bus Gr.", "", "", ""},
+			{"uninomial", "Aus group", "Aus", " group", "", ""},
+			{"epithet", "Aus complexa", "Aus complexa", "", "", ""},
+		}
+		for _, v := range data {
+			res := Preprocess([]byte(v.name), nil)
+			assert.Equal(t, v.body, string(res.Body), v.msg)
+			assert.Equal(t, v.tail, string(res.Tail), v.msg)
+			assert.Equal(t, v.aggregate, res.Aggregate, v.msg)
+			assert.Equal(t, v.verbatim, res.AggregateVerbatim, v.msg)
+		}
+	})
+
 	t.Run("NoParse", func(t *testing.T) {
 		data := []struct {
 			msg    string
@@ -192,7 +301,7 @@ func TestPreprocess(t *testing.T) {
 
 	t.Run("does not remove spaces", func(t *testing.T) {
 		name := "    Asplenium       × inexpectatum(E. L. Braun ex Friesner      )Morton"
-		res := Preprocess([]byte(name))
+		res := Preprocess([]byte(name), nil)
 		assert.Equal(t, string(res.Body), name)
 	})
 }
@@ -33,6 +33,17 @@ const (
 	GraftChimeraFormulaAnnot
 	// NamedGraftChimeraAnnot is a stable graft-chimera in botany with registered name.
 	NamedGraftChimeraAnnot
+	// PhraseNameAnnot is an informal phrase name or a manuscript name
+	// (Pultenaea sp. Olinda (R.Coveny 6616), Aus sp. A).
+	PhraseNameAnnot
+	// AggregateAnnot is an aggregate of closely related species (agg.).
+	AggregateAnnot
+	// SpeciesGroupAnnot is a species group (Aus bus group).
+	SpeciesGroupAnnot
+	// ComplexAnnot is a species complex (Aus bus complex).
+	ComplexAnnot
+	// CladeAnnot is a clade within a species (Aus bus clade).
+	CladeAnnot
 )
 
 var annotMap = map[Annotation]string{
@@ -45,8 +56,14 @@ var annotMap = map[Annotation]string{
 	NamedHybridAnnot:         "NAMED_HYBRID",
 	HybridFormulaAnnot:       "HYBRID_FORMULA",
 	NothoHybridAnnot:         "NOTHO_HYBRID",
+	GraftChimeraAnnot:        "GRAFT_CHIMERA",
 	GraftChimeraFormulaAnnot: "GRAFT_CHIMERA_FORMULA",
 	NamedGraftChimeraAnnot:   "NAMED_GRAFT_CHIMERA",
+	PhraseNameAnnot:          "PHRASE_NAME",
+	AggregateAnnot:           "AGGREGATE",
+	SpeciesGroupAnnot:        "SPECIES_GROUP",
+	ComplexAnnot:             "COMPLEX",
+	CladeAnnot:               "CLADE",
 }
 
 var annotStrMap = func() map[string]Annotation {
@@ -17,6 +17,9 @@ func TestStringAnnot(t *testing.T) {
 		{parsed.ComparisonAnnot, "COMPARISON"},
 		{parsed.ApproximationAnnot, "APPROXIMATION"},
 		{parsed.SurrogateAnnot, "SURROGATE"},
+		{parsed.PhraseNameAnnot, "PHRASE_NAME"},
+		{parsed.SpeciesGroupAnnot, "SPECIES_GROUP"},
+		{parsed.CladeAnnot, "CLADE"},
 	}
 
 	for i := range data {
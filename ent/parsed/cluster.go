@@ -0,0 +1,151 @@
+package parsed
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/gnames/gnfmt"
+)
+
+// Cluster contains statistics about name-strings that share the same
+// canonical form.
+type Cluster struct {
+	// Canonical is the simple or stemmed canonical form of the cluster.
+	Canonical string `json:"canonical"`
+	// Count is the number of name-strings in the cluster.
+	Count int `json:"count"`
+	// VariantsNum is the number of distinct verbatim name-strings.
+	VariantsNum int `json:"variantsNum"`
+	// MostCommon is the most frequent verbatim name-string of the cluster.
+	MostCommon string `json:"mostCommonVerbatim"`
+	// QualityDistribution shows how many name-strings have a particular
+	// parsing quality.
+	QualityDistribution map[int]int `json:"qualityDistribution"`
+}
+
+// Clustering collects parsed names into clusters of variants with the same
+// simple or stemmed canonical form.
+type Clustering struct {
+	stemmed  bool
+	clusters map[string]*Cluster
+	// verbatims keeps counts of every verbatim name-string in a cluster,
+	// and the order of their first appearance.
+	verbatims map[string]map[string][2]int
+}
+
+// NewClustering creates Clustering. If stemmed is true, names are
+// clustered by stemmed canonical forms, otherwise by simple canonical forms.
+func NewClustering(stemmed bool) *Clustering {
+	return &Clustering{
+		stemmed:   stemmed,
+		clusters:  make(map[string]*Cluster),
+		verbatims: make(map[string]map[string][2]int),
+	}
+}
+
+// Add puts parsed names into clusters. Names that were not parsed are
+// ignored.
+func (c *Clustering) Add(ps ...Parsed) {
+	for i := range ps {
+		if !ps[i].Parsed || ps[i].Canonical == nil {
+			continue
+		}
+		can := ps[i].Canonical.Simple
+		if c.stemmed {
+			can = ps[i].Canonical.Stemmed
+		}
+		cl, ok := c.clusters[can]
+		if !ok {
+			cl = &Cluster{Canonical: can, QualityDistribution: make(map[int]int)}
+			c.clusters[can] = cl
+			c.verbatims[can] = make(map[string][2]int)
+		}
+		cl.Count++
+		cl.QualityDistribution[ps[i].ParseQuality]++
+
+		vs := c.verbatims[can]
+		v, ok := vs[ps[i].Verbatim]
+		if !ok {
+			v[1] = len(vs)
+		}
+		v[0]++
+		vs[ps[i].Verbatim] = v
+	}
+}
+
+// Clusters returns clusters sorted by the number of their names in
+// descending order.
+func (c *Clustering) Clusters() []Cluster {
+	res := make([]Cluster, 0, len(c.clusters))
+	for k, cl := range c.clusters {
+		var most string
+		var best [2]int
+		for verb, v := range c.verbatims[k] {
+			if most == "" || v[0] > best[0] ||
+				(v[0] == best[0] && v[1] < best[1]) {
+				most = verb
+				best = v
+			}
+		}
+		r := *cl
+		r.MostCommon = most
+		r.VariantsNum = len(c.verbatims[k])
+		res = append(res, r)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Count != res[j].Count {
+			return res[i].Count > res[j].Count
+		}
+		return res[i].Canonical < res[j].Canonical
+	})
+	return res
+}
+
+// Output creates a JSON or CSV representation of a Cluster.
+func (cl Cluster) Output(f gnfmt.Format) string {
+	switch f {
+	case gnfmt.CSV:
+		return cl.csvOutput(',')
+	case gnfmt.TSV:
+		return cl.csvOutput('\t')
+	case gnfmt.CompactJSON:
+		return cl.jsonOutput(false)
+	case gnfmt.PrettyJSON:
+		return cl.jsonOutput(true)
+	default:
+		return "N/A"
+	}
+}
+
+// HeaderClusterCSV returns the CSV header for clusters output.
+func HeaderClusterCSV(f gnfmt.Format) string {
+	header := []string{"Canonical", "Count", "VariantsNum",
+		"MostCommonVerbatim", "Quality1", "Quality2", "Quality3", "Quality4"}
+	switch f {
+	case gnfmt.CSV:
+		return gnfmt.ToCSV(header, ',')
+	case gnfmt.TSV:
+		return gnfmt.ToCSV(header, '\t')
+	default:
+		return ""
+	}
+}
+
+func (cl Cluster) csvOutput(sep rune) string {
+	res := []string{
+		cl.Canonical,
+		strconv.Itoa(cl.Count),
+		strconv.Itoa(cl.VariantsNum),
+		cl.MostCommon,
+	}
+	for i := 1; i <= 4; i++ {
+		res = append(res, strconv.Itoa(cl.QualityDistribution[i]))
+	}
+	return gnfmt.ToCSV(res, sep)
+}
+
+func (cl Cluster) jsonOutput(pretty bool) string {
+	enc := gnfmt.GNjson{Pretty: pretty}
+	res, _ := enc.Encode(cl)
+	return string(res)
+}
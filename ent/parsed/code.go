@@ -0,0 +1,98 @@
+package parsed
+
+import (
+	"errors"
+	"strings"
+)
+
+// Code is a nomenclatural code that regulates naming of a taxon.
+type Code int
+
+const (
+	// UnknownCode means that the nomenclatural code cannot be determined.
+	UnknownCode Code = iota
+	// ICZN is the International Code of Zoological Nomenclature.
+	ICZN
+	// ICN is the International Code of Nomenclature for algae, fungi,
+	// and plants.
+	ICN
+	// ICNP is the International Code of Nomenclature of Prokaryotes.
+	ICNP
+	// ICVCN is the International Code of Virus Classification and
+	// Nomenclature.
+	ICVCN
+	// ICNCP is the International Code of Nomenclature for Cultivated Plants.
+	ICNCP
+)
+
+var codeMap = map[Code]string{
+	UnknownCode: "",
+	ICZN:        "ICZN",
+	ICN:         "ICN",
+	ICNP:        "ICNP",
+	ICVCN:       "ICVCN",
+	ICNCP:       "ICNCP",
+}
+
+var codeStrMap = func() map[string]Code {
+	res := make(map[string]Code)
+	for k, v := range codeMap {
+		res[v] = k
+	}
+	return res
+}()
+
+// codeAliases contains informal names of the nomenclatural codes.
+var codeAliases = map[string]Code{
+	"zoo":        ICZN,
+	"zoology":    ICZN,
+	"zoological": ICZN,
+	"bot":        ICN,
+	"botany":     ICN,
+	"botanical":  ICN,
+	"bact":       ICNP,
+	"bacteria":   ICNP,
+	"bacterial":  ICNP,
+	"virus":      ICVCN,
+	"viral":      ICVCN,
+	"cult":       ICNCP,
+	"cultivar":   ICNCP,
+	"cultivars":  ICNCP,
+}
+
+// NewCode converts a string to a nomenclatural code. It understands
+// abbreviations of the codes ('ICZN', 'ICN' etc.) as well as informal
+// names like 'zoo', 'bot', 'bact', 'virus', 'cultivar'. Unrecognized strings
+// are converted to UnknownCode.
+func NewCode(s string) Code {
+	s = strings.TrimSpace(s)
+	if c, ok := codeStrMap[strings.ToUpper(s)]; ok {
+		return c
+	}
+	if c, ok := codeAliases[strings.ToLower(s)]; ok {
+		return c
+	}
+	return UnknownCode
+}
+
+// String is an implementation of fmt.Stringer interface.
+func (c Code) String() string {
+	return codeMap[c]
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c Code) MarshalJSON() ([]byte, error) {
+	return []byte("\"" + c.String() + "\""), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaller.
+func (c *Code) UnmarshalJSON(bs []byte) error {
+	var err error
+	var ok bool
+	s := strings.Trim(string(bs), `"`)
+	*c, ok = codeStrMap[s]
+	if !ok {
+		err = errors.New("cannot decode Code")
+	}
+	return err
+}
@@ -0,0 +1,242 @@
+package parsed
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Components are elements of a name provided separately, as they appear
+// in atomized Darwin Core data (genus, subgenus, specificEpithet,
+// infraspecificEpithet, taxonRank, scientificNameAuthorship).
+type Components struct {
+	// Genus is a genus or another uninomial.
+	Genus string `json:"genus"`
+	// Subgenus is a subgenus without parentheses.
+	Subgenus string `json:"subgenus,omitempty"`
+	// SpecificEpithet is a species epithet.
+	SpecificEpithet string `json:"specificEpithet,omitempty"`
+	// InfraspecificEpithet is the lowest infraspecific epithet.
+	InfraspecificEpithet string `json:"infraspecificEpithet,omitempty"`
+	// TaxonRank is a rank as a Darwin Core term ('variety') or as
+	// a rank marker ('var.').
+	TaxonRank string `json:"taxonRank,omitempty"`
+	// Authorship is the authorship of the lowest element of the name.
+	Authorship string `json:"authorship,omitempty"`
+}
+
+type rankLevel int
+
+const (
+	unknownLevel rankLevel = iota
+	supraspeciesLevel
+	speciesLevel
+	infraspeciesLevel
+)
+
+// supraspeciesRanks are Darwin Core ranks above species.
+var supraspeciesRanks = map[string]struct{}{
+	"kingdom": {}, "phylum": {}, "class": {}, "order": {}, "family": {},
+	"subfamily": {}, "tribe": {}, "subtribe": {}, "genus": {}, "subgenus": {},
+	"section": {}, "subsection": {}, "series": {}, "subseries": {},
+	"division": {}, "supertribe": {},
+}
+
+// infraspeciesMarkers converts Darwin Core infraspecific ranks and rank
+// markers to normalized rank markers.
+var infraspeciesMarkers = func() map[string]string {
+	res := map[string]string{
+		"subspecies": "subsp.", "subsp": "subsp.", "ssp": "subsp.",
+		"var": "var.", "variety": "var.", "f": "f.", "forma": "f.",
+		"form": "f.", "subvar": "subvar.", "subf": "subf.", "morph": "morph.",
+		"convar": "convar.", "pv": "pv.", "pathovar": "pv.",
+	}
+	for _, v := range rankMap {
+		if v.order <= rankMap[SpeciesRank].order {
+			continue
+		}
+		res[strings.TrimSuffix(v.marker, ".")] = v.marker
+		res[v.term] = v.marker
+	}
+	return res
+}()
+
+// rankInfo returns the level of a rank and its marker for infraspecific
+// ranks.
+func rankInfo(rank string) (rankLevel, string) {
+	r := strings.TrimSuffix(strings.ToLower(rank), ".")
+	switch r {
+	case "":
+		return unknownLevel, ""
+	case "species", "sp", "spec":
+		return speciesLevel, ""
+	}
+	if _, ok := supraspeciesRanks[r]; ok {
+		return supraspeciesLevel, ""
+	}
+	if m, ok := infraspeciesMarkers[r]; ok {
+		return infraspeciesLevel, m
+	}
+	return unknownLevel, ""
+}
+
+// Name assembles a name-string out of components and returns it with
+// warnings about inconsistent or missing components. Components with
+// a wrong capitalization are fixed.
+func (c Components) Name() (string, []Warning) {
+	ws := make(map[Warning]struct{})
+	genus := fixCase(strings.TrimSpace(c.Genus), true, ws)
+	subgenus := strings.Trim(strings.TrimSpace(c.Subgenus), "()")
+	subgenus = fixCase(subgenus, true, ws)
+	sp := fixCase(strings.TrimSpace(c.SpecificEpithet), false, ws)
+	infrasp := fixCase(strings.TrimSpace(c.InfraspecificEpithet), false, ws)
+	level, marker := rankInfo(strings.TrimSpace(c.TaxonRank))
+
+	if genus == "" || (infrasp != "" && sp == "") {
+		ws[ComponentsMissingWarn] = struct{}{}
+	}
+	if c.TaxonRank != "" && level == unknownLevel {
+		ws[ComponentsRankMismatchWarn] = struct{}{}
+	}
+	switch {
+	case infrasp != "":
+		if level == unknownLevel && c.TaxonRank == "" {
+			ws[ComponentsRankMissingWarn] = struct{}{}
+		} else if level != infraspeciesLevel {
+			ws[ComponentsRankMismatchWarn] = struct{}{}
+		}
+	case sp != "":
+		if level == infraspeciesLevel || level == supraspeciesLevel {
+			ws[ComponentsRankMismatchWarn] = struct{}{}
+		}
+	default:
+		if level == speciesLevel || level == infraspeciesLevel {
+			ws[ComponentsRankMismatchWarn] = struct{}{}
+		}
+	}
+
+	var res []string
+	if genus != "" {
+		res = append(res, genus)
+	}
+	if subgenus != "" {
+		if sp == "" && infrasp == "" {
+			res = append(res, "subgen.", subgenus)
+		} else {
+			res = append(res, "("+subgenus+")")
+		}
+	}
+	if sp != "" {
+		res = append(res, sp)
+	}
+	if infrasp != "" {
+		if marker != "" {
+			res = append(res, marker)
+		}
+		res = append(res, infrasp)
+	}
+	if au := strings.TrimSpace(c.Authorship); au != "" {
+		res = append(res, au)
+	}
+	return strings.Join(res, " "), warningsSlice(ws)
+}
+
+// CheckComponents adds a warning if words of a parsed name do not
+// correspond to the components the name was assembled from. It requires
+// Words of the parsing results.
+func (p *Parsed) CheckComponents(c Components) {
+	if !p.Parsed {
+		return
+	}
+	var words []string
+	for _, v := range p.Words {
+		switch v.Type {
+		case GenusType, UninomialType, SubgenusType, SpEpithetType,
+			InfraspEpithetType:
+			words = append(words, strings.ToLower(v.Verbatim))
+		}
+	}
+	exp := c.words()
+	if len(words) != len(exp) {
+		p.AddWarnings(ComponentsMismatchWarn)
+		return
+	}
+	for i := range exp {
+		if words[i] != exp[i] {
+			p.AddWarnings(ComponentsMismatchWarn)
+			return
+		}
+	}
+}
+
+func (c Components) words() []string {
+	var res []string
+	for _, v := range []string{
+		c.Genus, strings.Trim(c.Subgenus, " ()"), c.SpecificEpithet,
+		c.InfraspecificEpithet,
+	} {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			res = append(res, strings.ToLower(v))
+		}
+	}
+	return res
+}
+
+// AddWarnings adds warnings to the parsing results and updates the
+// parsing quality.
+func (p *Parsed) AddWarnings(ws ...Warning) {
+	if len(ws) == 0 {
+		return
+	}
+	seen := make(map[Warning]struct{})
+	for _, v := range p.QualityWarnings {
+		seen[v.Warning] = struct{}{}
+	}
+	for _, w := range ws {
+		if _, ok := seen[w]; ok {
+			continue
+		}
+		seen[w] = struct{}{}
+		p.QualityWarnings = append(p.QualityWarnings, w.NewQualityWarning())
+	}
+	sort.Slice(p.QualityWarnings, func(i, j int) bool {
+		qi, qj := p.QualityWarnings[i], p.QualityWarnings[j]
+		if qi.Quality != qj.Quality {
+			return qi.Quality > qj.Quality
+		}
+		return qi.Warning.String() < qj.Warning.String()
+	})
+	if p.Parsed {
+		p.ParseQuality = p.QualityWarnings[0].Quality
+	}
+}
+
+func fixCase(s string, upper bool, ws map[Warning]struct{}) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if size == 0 || !unicode.IsLetter(r) {
+		return s
+	}
+	if upper {
+		res := string(unicode.ToUpper(r)) + strings.ToLower(s[size:])
+		if res != s {
+			ws[ComponentsCaseWarn] = struct{}{}
+		}
+		return res
+	}
+	res := strings.ToLower(s)
+	if res != s {
+		ws[ComponentsCaseWarn] = struct{}{}
+	}
+	return res
+}
+
+func warningsSlice(ws map[Warning]struct{}) []Warning {
+	res := make([]Warning, 0, len(ws))
+	for k := range ws {
+		res = append(res, k)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	return res
+}
@@ -0,0 +1,90 @@
+package parsed
+
+import (
+	"errors"
+	"strings"
+)
+
+// ConceptQualifier describes how a taxon concept is indicated in
+// a name-string.
+type ConceptQualifier int
+
+const (
+	// UnknownConcept is used when a qualifier cannot be determined.
+	UnknownConcept ConceptQualifier = iota
+	// SensuConcept is a concept of particular authors, "sensu Smith 1990".
+	SensuConcept
+	// SensuLatoConcept is a concept in a broad sense, "s. l.".
+	SensuLatoConcept
+	// SensuStrictoConcept is a concept in a narrow sense, "s. str.".
+	SensuStrictoConcept
+	// AuctorumConcept is a concept of authors other than the authors of
+	// the name, usually a misapplication, "auct. non L.".
+	AuctorumConcept
+	// SecundumConcept is a concept according to a source, "sec. Jones".
+	SecundumConcept
+	// ProParteConcept indicates that only a part of the concept is meant,
+	// "pro parte".
+	ProParteConcept
+)
+
+var conceptQualifierMap = map[ConceptQualifier]string{
+	UnknownConcept:      "",
+	SensuConcept:        "SENSU",
+	SensuLatoConcept:    "SENSU_LATO",
+	SensuStrictoConcept: "SENSU_STRICTO",
+	AuctorumConcept:     "AUCTORUM",
+	SecundumConcept:     "SECUNDUM",
+	ProParteConcept:     "PRO_PARTE",
+}
+
+var conceptQualifierStrMap = func() map[string]ConceptQualifier {
+	res := make(map[string]ConceptQualifier)
+	for k, v := range conceptQualifierMap {
+		res[v] = k
+	}
+	return res
+}()
+
+// String is an implementation of fmt.Stringer interface.
+func (cq ConceptQualifier) String() string {
+	return conceptQualifierMap[cq]
+}
+
+// MarshalJSON implements json.Marshaler.
+func (cq ConceptQualifier) MarshalJSON() ([]byte, error) {
+	return []byte("\"" + cq.String() + "\""), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaller.
+func (cq *ConceptQualifier) UnmarshalJSON(bs []byte) error {
+	var err error
+	var ok bool
+	s := strings.Trim(string(bs), `"`)
+	*cq, ok = conceptQualifierStrMap[s]
+	if !ok {
+		err = errors.New("cannot decode ConceptQualifier")
+	}
+	return err
+}
+
+// TaxonConcept describes a taxon concept qualifier that follows a name,
+// for example "sensu lato", "sensu Smith 1990", "auct. non L.".
+type TaxonConcept struct {
+	// Verbatim is the qualifier as it appears in the name-string.
+	Verbatim string `json:"verbatim"`
+	// Qualifier is the type of the concept.
+	Qualifier ConceptQualifier `json:"qualifier"`
+	// Authors of the concept, for example "Smith" for "sensu Smith 1990".
+	Authors []string `json:"authors,omitempty"`
+	// NonAuthors are authors of the name that was misapplied, for example
+	// "L." for "auct. non L.".
+	NonAuthors []string `json:"nonAuthors,omitempty"`
+	// Year of the concept publication.
+	Year string `json:"year,omitempty"`
+	// Misapplied is true if the name is used in a sense that excludes
+	// its type, like in "auct. non L." or "sensu Smith non Jones".
+	Misapplied bool `json:"misapplied,omitempty"`
+	// ProParte is true if the concept includes only a part of the taxon.
+	ProParte bool `json:"proParte,omitempty"`
+}
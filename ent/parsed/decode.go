@@ -0,0 +1,127 @@
+package parsed
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// UnmarshalJSON implements json.Unmarshaler. Details is an interface,
+// therefore its concrete type is restored from the key used in the JSON
+// representation of the details.
+func (p *Parsed) UnmarshalJSON(bs []byte) error {
+	type parsedAlias Parsed
+	aux := struct {
+		*parsedAlias
+		Details json.RawMessage `json:"details,omitempty"`
+	}{parsedAlias: (*parsedAlias)(p)}
+
+	err := json.Unmarshal(bs, &aux)
+	if err != nil {
+		return err
+	}
+	p.Details, err = decodeDetails(aux.Details)
+	return err
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DetailsHybridFormula) UnmarshalJSON(bs []byte) error {
+	var aux struct {
+		HybridFormula []json.RawMessage `json:"hybridFormula"`
+	}
+	err := json.Unmarshal(bs, &aux)
+	if err != nil {
+		return err
+	}
+	d.HybridFormula, err = decodeDetailsSlice(aux.HybridFormula)
+	return err
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DetailsGraftChimeraFormula) UnmarshalJSON(bs []byte) error {
+	var aux struct {
+		GraftChimeraFormula []json.RawMessage `json:"graftChimeraFormula"`
+	}
+	err := json.Unmarshal(bs, &aux)
+	if err != nil {
+		return err
+	}
+	d.GraftChimeraFormula, err = decodeDetailsSlice(aux.GraftChimeraFormula)
+	return err
+}
+
+func decodeDetailsSlice(raws []json.RawMessage) ([]Details, error) {
+	if raws == nil {
+		return nil, nil
+	}
+	res := make([]Details, len(raws))
+	for i := range raws {
+		d, err := decodeDetails(raws[i])
+		if err != nil {
+			return nil, err
+		}
+		res[i] = d
+	}
+	return res, nil
+}
+
+// decodeDetails finds out the type of details from the only key of the
+// details object and decodes the details into the corresponding type.
+func decodeDetails(raw json.RawMessage) (Details, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	var keys map[string]json.RawMessage
+	err := json.Unmarshal(raw, &keys)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Details
+	for k := range keys {
+		switch k {
+		case "uninomial":
+			var d DetailsUninomial
+			err = json.Unmarshal(raw, &d)
+			res = d
+		case "species":
+			var d DetailsSpecies
+			err = json.Unmarshal(raw, &d)
+			res = d
+		case "infraspecies":
+			var d DetailsInfraspecies
+			err = json.Unmarshal(raw, &d)
+			res = d
+		case "comparison":
+			var d DetailsComparison
+			err = json.Unmarshal(raw, &d)
+			res = d
+		case "approximation":
+			var d DetailsApproximation
+			err = json.Unmarshal(raw, &d)
+			res = d
+		case "phraseName":
+			var d DetailsPhraseName
+			err = json.Unmarshal(raw, &d)
+			res = d
+		case "virus":
+			var d DetailsVirus
+			err = json.Unmarshal(raw, &d)
+			res = d
+		case "hybridFormula":
+			var d DetailsHybridFormula
+			err = json.Unmarshal(raw, &d)
+			res = d
+		case "graftChimeraFormula":
+			var d DetailsGraftChimeraFormula
+			err = json.Unmarshal(raw, &d)
+			res = d
+		default:
+			err = fmt.Errorf("cannot decode details of type '%s'", k)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
@@ -6,7 +6,9 @@ type Uninomial struct {
 	Value string `json:"uninomial"`
 	// Rank of the uninomial in a combination name, for example
 	// "Pereskia subg. Maihuenia Philippi ex F.A.C.Weber, 1898"
-	Rank string `json:"rank,omitempty"`
+	Rank Rank `json:"rank,omitempty"`
+	// RankVerbatim is the rank marker as it appears in the name-string.
+	RankVerbatim string `json:"rankVerbatim,omitempty"`
 	// Cultivar is a value of a cultivar of a uninomial.
 	Cultivar string `json:"cultivar,omitempty"`
 	// Parent of a uninomial in a combination name.
@@ -43,7 +45,9 @@ type InfraspeciesElem struct {
 	// Value of an infraspecific epithet.
 	Value string `json:"value"`
 	// Rank of the infraspecific epithet.
-	Rank string `json:"rank,omitempty"`
+	Rank Rank `json:"rank,omitempty"`
+	// RankVerbatim is the rank marker as it appears in the name-string.
+	RankVerbatim string `json:"rankVerbatim,omitempty"`
 	// Authorship of the infraspecific epithet.
 	Authorship *Authorship `json:"authorship,omitempty"`
 }
@@ -58,8 +62,13 @@ type Comparison struct {
 	Cultivar string `json:"cultivar,omitempty"`
 	// SpeciesAuthorship the authorship of Species.
 	SpeciesAuthorship *Authorship `json:"authorship,omitempty"`
+	// Infraspecies are infraspecific epithets of a name.
+	Infraspecies []InfraspeciesElem `json:"infraspecies,omitempty"`
 	// CompMarker, usually "cf.".
 	CompMarker string `json:"comparisonMarker"`
+	// AppliesTo is the element of a name qualified by CompMarker
+	// ("genus", "species" or "infraspecies").
+	AppliesTo string `json:"appliesTo,omitempty"`
 }
 
 // Approximation are details for a surrogate approximation name.
@@ -78,6 +87,45 @@ type Approximation struct {
 	Ignored string `json:"ignored,omitempty"`
 }
 
+// PhraseName are details for an informal phrase name or a manuscript name.
+type PhraseName struct {
+	// Genus is the genus of a name.
+	Genus string `json:"genus"`
+	// Species is a specific epithet of an infraspecific phrase name.
+	Species string `json:"species,omitempty"`
+	// Rank is the rank marker that precedes the phrase (sp., subsp., var.).
+	Rank Rank `json:"rank"`
+	// Phrase is an informal designation of a taxon, for example "Olinda",
+	// "A" or "Mt Smith".
+	Phrase string `json:"phrase"`
+	// VoucherCollector is the collector of a voucher specimen.
+	VoucherCollector string `json:"voucherCollector,omitempty"`
+	// VoucherNumber is the collecting number of a voucher specimen.
+	VoucherNumber string `json:"voucherNumber,omitempty"`
+}
+
+// Virus are details for names of viruses and other sub-cellular entities.
+type Virus struct {
+	// Category of the entity (virus, phage, plasmid, prion, satellite,
+	// viroid).
+	Category VirusCategory `json:"category"`
+	// Name is the name of the entity without strain, isolate and serotype
+	// designations.
+	Name string `json:"name"`
+	// Genus is a genus of a binomial ICTV species name, for example
+	// "Betacoronavirus" in "Betacoronavirus pandemicum".
+	Genus string `json:"genus,omitempty"`
+	// Species is an epithet of a binomial ICTV species name.
+	Species string `json:"species,omitempty"`
+	// Strain is a designation of a strain, for example
+	// "A/Puerto Rico/8/1934(H1N1)".
+	Strain string `json:"strain,omitempty"`
+	// Isolate is a designation of an isolate.
+	Isolate string `json:"isolate,omitempty"`
+	// Serotype is a designation of a serotype or a serovar.
+	Serotype string `json:"serotype,omitempty"`
+}
+
 // DetailsHybridFormula are details for a hybrid formula names.
 type DetailsHybridFormula struct {
 	HybridFormula []Details `json:"hybridFormula"`
@@ -139,3 +187,21 @@ type DetailsApproximation struct {
 
 // isDetails implements Details interface.
 func (DetailsApproximation) isDetails() {}
+
+// DetailsPhraseName are details for phrase names.
+type DetailsPhraseName struct {
+	// PhraseName details.
+	PhraseName PhraseName `json:"phraseName"`
+}
+
+// isDetails implements Details interface.
+func (DetailsPhraseName) isDetails() {}
+
+// DetailsVirus are details for names of viruses, phages, plasmids etc.
+type DetailsVirus struct {
+	// Virus details.
+	Virus Virus `json:"virus"`
+}
+
+// isDetails implements Details interface.
+func (DetailsVirus) isDetails() {}
@@ -0,0 +1,161 @@
+package parsed
+
+import (
+	"strings"
+
+	"github.com/gnames/gnfmt"
+)
+
+// DwCTerms contains Darwin Core terms that are provided in DwC output.
+var DwCTerms = []string{
+	"genus", "subgenus", "specificEpithet", "infraspecificEpithet",
+	"taxonRank", "verbatimTaxonRank", "scientificNameAuthorship",
+	"namePublishedInYear", "cultivarEpithet",
+}
+
+// DwCRecord contains values of Darwin Core terms for a parsed name.
+type DwCRecord struct {
+	Genus                    string
+	Subgenus                 string
+	SpecificEpithet          string
+	InfraspecificEpithet     string
+	TaxonRank                string
+	VerbatimTaxonRank        string
+	ScientificNameAuthorship string
+	NamePublishedInYear      string
+	CultivarEpithet          string
+}
+
+// ToDwC converts parsed data to Darwin Core terms. Most of the terms are
+// taken from Details, so they are empty if Details were not generated.
+func (p Parsed) ToDwC() DwCRecord {
+	var res DwCRecord
+	if !p.Parsed {
+		return res
+	}
+
+	if au := p.Authorship; au != nil {
+		res.ScientificNameAuthorship = au.Normalized
+		yr := au.Year
+		if au.Combination != nil && au.Combination.Year != nil {
+			yr = au.Combination.Year.Value
+		}
+		res.NamePublishedInYear = strings.Trim(yr, "()")
+	}
+
+	var rank Rank
+	switch d := p.Details.(type) {
+	case DetailsUninomial:
+		u := d.Uninomial
+		rank = u.Rank
+		if rank == SubgenusRank {
+			res.Genus = u.Parent
+			res.Subgenus = u.Value
+		}
+		res.CultivarEpithet = u.Cultivar
+	case DetailsSpecies:
+		res.dwcSpecies(d.Species)
+		rank = SpeciesRank
+	case DetailsInfraspecies:
+		res.dwcSpecies(d.Infraspecies.Species)
+		infs := d.Infraspecies.Infraspecies
+		if l := len(infs); l > 0 {
+			res.InfraspecificEpithet = infs[l-1].Value
+			rank = infs[l-1].Rank
+			if rank == UnknownRank && p.Cardinality == 3 {
+				rank = SubspeciesRank
+			}
+		}
+	case DetailsComparison:
+		res.Genus = d.Comparison.Genus
+		res.SpecificEpithet = d.Comparison.Species
+		res.CultivarEpithet = d.Comparison.Cultivar
+		if l := len(d.Comparison.Infraspecies); l > 0 {
+			res.InfraspecificEpithet = d.Comparison.Infraspecies[l-1].Value
+		}
+	case DetailsVirus:
+		if d.Virus.Genus != "" {
+			res.Genus = d.Virus.Genus
+			res.SpecificEpithet = d.Virus.Species
+			rank = SpeciesRank
+		}
+	case DetailsApproximation:
+		res.Genus = d.Approximation.Genus
+		res.SpecificEpithet = d.Approximation.Species
+		res.CultivarEpithet = d.Approximation.Cultivar
+	case DetailsPhraseName:
+		res.Genus = d.PhraseName.Genus
+		res.SpecificEpithet = d.PhraseName.Species
+		rank = SpeciesRank
+		if d.PhraseName.Species != "" {
+			rank = d.PhraseName.Rank
+		}
+	}
+
+	res.TaxonRank = rank.Term()
+	res.VerbatimTaxonRank = p.verbatimRank()
+	res.CultivarEpithet = strings.Trim(res.CultivarEpithet, "‘’")
+	return res
+}
+
+func (r *DwCRecord) dwcSpecies(sp Species) {
+	r.Genus = sp.Genus
+	r.Subgenus = sp.Subgenus
+	r.SpecificEpithet = sp.Species
+	r.CultivarEpithet = sp.Cultivar
+}
+
+// verbatimRank returns the verbatim value of the last rank of a name.
+func (p Parsed) verbatimRank() string {
+	for i := len(p.Words) - 1; i >= 0; i-- {
+		if p.Words[i].Type == RankType {
+			return p.Words[i].Verbatim
+		}
+	}
+	return ""
+}
+
+// OutputDwC creates a CSV or TSV row of Darwin Core terms of Parsed
+// results. JSON formats are the same as in Output.
+func (p Parsed) OutputDwC(f gnfmt.Format) string {
+	switch f {
+	case gnfmt.CSV:
+		return p.dwcOutput(',')
+	case gnfmt.TSV:
+		return p.dwcOutput('\t')
+	default:
+		return p.Output(f)
+	}
+}
+
+// HeaderDwC returns the CSV or TSV header for Darwin Core output.
+func HeaderDwC(f gnfmt.Format) string {
+	header := append([]string{"Id", "Verbatim", "scientificName"}, DwCTerms...)
+	switch f {
+	case gnfmt.CSV:
+		return gnfmt.ToCSV(header, ',')
+	case gnfmt.TSV:
+		return gnfmt.ToCSV(header, '\t')
+	default:
+		return ""
+	}
+}
+
+func (p Parsed) dwcOutput(sep rune) string {
+	d := p.ToDwC()
+	res := []string{
+		p.VerbatimID,
+		p.Verbatim,
+		p.Normalized,
+		d.Genus,
+		d.Subgenus,
+		d.SpecificEpithet,
+		d.InfraspecificEpithet,
+		d.TaxonRank,
+		d.VerbatimTaxonRank,
+		d.ScientificNameAuthorship,
+		d.NamePublishedInYear,
+		d.CultivarEpithet,
+	}
+	return gnfmt.ToCSV(res, sep)
+}
@@ -0,0 +1,127 @@
+package parsed
+
+import (
+	"strings"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser/ent/str"
+)
+
+// HomotypyKey returns a key that is the same for names that are likely
+// homotypic synonyms, for example "Aus bus (L.) Smith" and "Cus bus L.".
+// The key consists of the stemmed terminal epithet, authors of the original
+// description and their year. The key requires authorship details, so the
+// name has to be parsed with details. Uninomials, names without original
+// authors and names parsed without details return an empty key.
+func (p Parsed) HomotypyKey() string {
+	if p.Cardinality < 2 || p.Canonical == nil || p.Authorship == nil ||
+		p.Authorship.Original == nil || len(p.Authorship.Original.Authors) == 0 {
+		return ""
+	}
+	ws := strings.Fields(p.Canonical.Stemmed)
+	if len(ws) < 2 {
+		return ""
+	}
+	orig := p.Authorship.Original
+	aus := make([]string, len(orig.Authors))
+	for i, v := range orig.Authors {
+		aus[i] = authorKey(v)
+	}
+	var yr string
+	if orig.Year != nil {
+		yr = strings.Trim(orig.Year.Value, "()")
+	}
+	return strings.Join(
+		[]string{ws[len(ws)-1], strings.Join(aus, ","), yr}, "|",
+	)
+}
+
+// authorKey removes diacritics, spaces and periods from an author's name.
+func authorKey(au string) string {
+	au = strings.Join(strings.Fields(str.Normalize(au)), "")
+	return strings.ToLower(au)
+}
+
+// HomotypyGroup contains names that have the same homotypy key.
+type HomotypyGroup struct {
+	// Key is the homotypy key of the names.
+	Key string `json:"homotypyKey"`
+	// Names are verbatim name-strings of the group in the order of input.
+	Names []string `json:"names"`
+}
+
+// GroupHomotypic groups parsed names by their homotypy keys. Groups are
+// returned in the order of the first appearance of their names in the
+// input. Names without a key are ignored. If all is false, groups with
+// only one name are ignored as well.
+func GroupHomotypic(ps []Parsed, all bool) []HomotypyGroup {
+	var res []HomotypyGroup
+	idx := make(map[string]int)
+	for i := range ps {
+		key := ps[i].HomotypyKey()
+		if key == "" {
+			continue
+		}
+		j, ok := idx[key]
+		if !ok {
+			j = len(res)
+			idx[key] = j
+			res = append(res, HomotypyGroup{Key: key})
+		}
+		res[j].Names = append(res[j].Names, ps[i].Verbatim)
+	}
+	if all {
+		return res
+	}
+	groups := res[:0]
+	for _, v := range res {
+		if len(v.Names) > 1 {
+			groups = append(groups, v)
+		}
+	}
+	return groups
+}
+
+// Output creates a JSON or CSV representation of a HomotypyGroup. CSV
+// output contains one line per name.
+func (g HomotypyGroup) Output(f gnfmt.Format) string {
+	switch f {
+	case gnfmt.CSV:
+		return g.csvOutput(',')
+	case gnfmt.TSV:
+		return g.csvOutput('\t')
+	case gnfmt.CompactJSON:
+		return g.jsonOutput(false)
+	case gnfmt.PrettyJSON:
+		return g.jsonOutput(true)
+	default:
+		return "N/A"
+	}
+}
+
+// HeaderHomotypyCSV returns the CSV header for homotypy groups output.
+func HeaderHomotypyCSV(f gnfmt.Format) string {
+	header := []string{"HomotypyKey", "Verbatim"}
+	switch f {
+	case gnfmt.CSV:
+		return gnfmt.ToCSV(header, ',')
+	case gnfmt.TSV:
+		return gnfmt.ToCSV(header, '\t')
+	default:
+		return ""
+	}
+}
+
+func (g HomotypyGroup) csvOutput(sep rune) string {
+	res := make([]string, len(g.Names))
+	for i, v := range g.Names {
+		res[i] = gnfmt.ToCSV([]string{g.Key, v}, sep)
+	}
+	return strings.Join(res, "\n")
+}
+
+func (g HomotypyGroup) jsonOutput(pretty bool) string {
+	enc := gnfmt.GNjson{Pretty: pretty}
+	res, _ := enc.Encode(g)
+	return string(res)
+}
@@ -0,0 +1,170 @@
+package parsed
+
+import (
+	"errors"
+	"strings"
+)
+
+// Confidence shows how reliable an inferred value is.
+type Confidence int
+
+const (
+	// NoConfidence means that confidence is not set.
+	NoConfidence Confidence = iota
+	// LowConfidence means that the evidence contradicts the nomenclatural
+	// code of a name, or that it is weak and ambiguous.
+	LowConfidence
+	// MediumConfidence means that the nomenclatural code of a name is
+	// unknown, but the evidence is unambiguous, or that the evidence agrees
+	// with the code, but is ambiguous.
+	MediumConfidence
+	// HighConfidence means that the unambiguous evidence agrees with the
+	// nomenclatural code of a name.
+	HighConfidence
+)
+
+var confidenceMap = map[Confidence]string{
+	NoConfidence:     "",
+	LowConfidence:    "LOW",
+	MediumConfidence: "MEDIUM",
+	HighConfidence:   "HIGH",
+}
+
+var confidenceStrMap = func() map[string]Confidence {
+	res := make(map[string]Confidence)
+	for k, v := range confidenceMap {
+		res[v] = k
+	}
+	return res
+}()
+
+// String is an implementation of fmt.Stringer interface.
+func (c Confidence) String() string {
+	return confidenceMap[c]
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c Confidence) MarshalJSON() ([]byte, error) {
+	return []byte("\"" + c.String() + "\""), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaller.
+func (c *Confidence) UnmarshalJSON(bs []byte) error {
+	var err error
+	var ok bool
+	s := strings.Trim(string(bs), `"`)
+	*c, ok = confidenceStrMap[s]
+	if !ok {
+		err = errors.New("cannot decode Confidence")
+	}
+	return err
+}
+
+// InferredRank is a rank of a uninomial inferred from its ending.
+type InferredRank struct {
+	// Rank is a suprageneric rank, for example family, order, tribe.
+	Rank Rank `json:"rank"`
+	// Confidence shows if the ending agrees with the nomenclatural code of
+	// the name.
+	Confidence Confidence `json:"confidence"`
+}
+
+// RankEvidence contains facts about a uninomial, besides its ending, that
+// make an inferred rank more or less reliable.
+type RankEvidence struct {
+	// Code is the nomenclatural code of the name.
+	Code Code
+	// KnownGenus is true if the uninomial is found in a dictionary of
+	// genera, for example of bacterial genera.
+	KnownGenus bool
+	// BotanicalAuthor is true if an author of the uninomial is found in
+	// dictionaries of botanical authors. It is used when the Code is
+	// unknown.
+	BotanicalAuthor bool
+}
+
+// rankSuffixes contain standard endings of suprageneric names with their
+// ranks according to nomenclatural codes. Longer endings go before
+// shorter ones that they contain (-oideae before -eae). Ambiguous endings
+// are common among genera as well (Leucophyta is a genus of Asteraceae).
+var rankSuffixes = []struct {
+	suffix    string
+	ranks     map[Code]Rank
+	ambiguous bool
+}{
+	{"oideae", map[Code]Rank{ICN: SubfamilyRank}, false},
+	{"aceae", map[Code]Rank{ICN: FamilyRank, ICNP: FamilyRank}, false},
+	{"oidea", map[Code]Rank{ICZN: SuperfamilyRank}, false},
+	{"phyta", map[Code]Rank{ICN: PhylumRank}, true},
+	{"ales", map[Code]Rank{ICN: OrderRank, ICNP: OrderRank}, false},
+	{"idae", map[Code]Rank{ICZN: FamilyRank}, false},
+	{"inae", map[Code]Rank{ICZN: SubfamilyRank}, false},
+	{"eae", map[Code]Rank{ICN: TribeRank}, false},
+	{"ini", map[Code]Rank{ICZN: TribeRank}, false},
+}
+
+// minNameLen is the length of a uninomial, below which an inferred rank
+// is less reliable, because short genera often look like tribes.
+const minNameLen = 6
+
+// InferRank infers a suprageneric rank of a uninomial from its ending
+// (-idae, -inae, -ini, -oidea for zoology; -aceae, -oideae, -eae, -ales,
+// -phyta for botany; -aceae, -ales for bacteria). Names of cultivated
+// plants use botanical endings. Botanical authors count as botanical code,
+// if the code is unknown. The confidence is lowered for ambiguous endings
+// and short names. It returns nil if the ending is not recognized, or if
+// the uninomial is a known genus.
+func InferRank(uninomial string, ev RankEvidence) *InferredRank {
+	if ev.KnownGenus {
+		return nil
+	}
+	code := ev.Code
+	switch {
+	case code == ICNCP:
+		code = ICN
+	case code == UnknownCode && ev.BotanicalAuthor:
+		code = ICN
+	}
+
+	name := strings.ToLower(uninomial)
+	for _, v := range rankSuffixes {
+		if len(name) < len(v.suffix)+2 || !strings.HasSuffix(name, v.suffix) {
+			continue
+		}
+		res := inferRank(v.ranks, code)
+		short := len([]rune(name)) < minNameLen
+		if (v.ambiguous || short) &&
+			res.Confidence > LowConfidence {
+			res.Confidence--
+		}
+		return &res
+	}
+	return nil
+}
+
+// inferRank picks a rank of an ending for a code. If the ending is not
+// used by the code, it picks the rank of another code with lower
+// confidence.
+func inferRank(ranks map[Code]Rank, code Code) InferredRank {
+	if rank, ok := ranks[code]; ok {
+		return InferredRank{Rank: rank, Confidence: HighConfidence}
+	}
+	res := InferredRank{Confidence: MediumConfidence}
+	if code != UnknownCode {
+		res.Confidence = LowConfidence
+	}
+	for _, c := range []Code{ICZN, ICN, ICNP} {
+		if rank, ok := ranks[c]; ok {
+			res.Rank = rank
+			break
+		}
+	}
+	return res
+}
+
+// IsSuprageneric returns true if a name is a uninomial with a rank higher
+// than genus inferred from its ending. It allows to separate genera from
+// families, orders etc. in mixed lists of uninomials.
+func (p Parsed) IsSuprageneric() bool {
+	return p.Cardinality == 1 && p.InferredRank != nil
+}
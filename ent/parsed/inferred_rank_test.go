@@ -0,0 +1,72 @@
+package parsed_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInferRank(t *testing.T) {
+	tests := []struct {
+		msg, name string
+		ev        parsed.RankEvidence
+		rank      parsed.Rank
+		conf      parsed.Confidence
+	}{
+		{"genus", "Aus", parsed.RankEvidence{}, parsed.UnknownRank,
+			parsed.NoConfidence},
+		{"zoo family", "Muscidae", parsed.RankEvidence{Code: parsed.ICZN},
+			parsed.FamilyRank, parsed.HighConfidence},
+		{"no code", "Muscidae", parsed.RankEvidence{}, parsed.FamilyRank,
+			parsed.MediumConfidence},
+		{"wrong code", "Muscidae", parsed.RankEvidence{Code: parsed.ICN},
+			parsed.FamilyRank, parsed.LowConfidence},
+		{"bot family", "Rosaceae", parsed.RankEvidence{Code: parsed.ICN},
+			parsed.FamilyRank, parsed.HighConfidence},
+		{"bot author", "Rosaceae", parsed.RankEvidence{BotanicalAuthor: true},
+			parsed.FamilyRank, parsed.HighConfidence},
+		{"bot author zoo", "Muscidae",
+			parsed.RankEvidence{Code: parsed.ICZN, BotanicalAuthor: true},
+			parsed.FamilyRank, parsed.HighConfidence},
+		{"bact order", "Lactobacillales", parsed.RankEvidence{Code: parsed.ICNP},
+			parsed.OrderRank, parsed.HighConfidence},
+		{"bact genus", "Rhodobacterales", parsed.RankEvidence{KnownGenus: true},
+			parsed.UnknownRank, parsed.NoConfidence},
+		{"cultivar", "Rosaceae", parsed.RankEvidence{Code: parsed.ICNCP},
+			parsed.FamilyRank, parsed.HighConfidence},
+		{"subfamily", "Asteroideae", parsed.RankEvidence{Code: parsed.ICN},
+			parsed.SubfamilyRank, parsed.HighConfidence},
+		{"tribe", "Bombini", parsed.RankEvidence{Code: parsed.ICZN},
+			parsed.TribeRank, parsed.HighConfidence},
+		{"short name", "Apini", parsed.RankEvidence{Code: parsed.ICZN},
+			parsed.TribeRank, parsed.MediumConfidence},
+		{"short name no code", "Apini", parsed.RankEvidence{},
+			parsed.TribeRank, parsed.LowConfidence},
+		{"short stem", "Poales", parsed.RankEvidence{}, parsed.OrderRank,
+			parsed.MediumConfidence},
+		{"ambiguous", "Leucophyta", parsed.RankEvidence{}, parsed.PhylumRank,
+			parsed.LowConfidence},
+		{"ambiguous bot", "Magnoliophyta", parsed.RankEvidence{Code: parsed.ICN},
+			parsed.PhylumRank, parsed.MediumConfidence},
+		{"ambiguous zoo", "Leucophyta", parsed.RankEvidence{Code: parsed.ICZN},
+			parsed.PhylumRank, parsed.LowConfidence},
+		{"short", "Ini", parsed.RankEvidence{Code: parsed.ICZN},
+			parsed.UnknownRank, parsed.NoConfidence},
+	}
+	for _, v := range tests {
+		res := parsed.InferRank(v.name, v.ev)
+		if v.rank == parsed.UnknownRank {
+			assert.Nil(t, res, v.msg)
+			continue
+		}
+		assert.Equal(t, v.rank, res.Rank, v.msg)
+		assert.Equal(t, v.conf, res.Confidence, v.msg)
+	}
+}
+
+func TestIsSuprageneric(t *testing.T) {
+	ir := &parsed.InferredRank{Rank: parsed.FamilyRank}
+	assert.True(t, parsed.Parsed{Cardinality: 1, InferredRank: ir}.IsSuprageneric())
+	assert.False(t, parsed.Parsed{Cardinality: 1}.IsSuprageneric())
+}
@@ -2,6 +2,7 @@ package parsed
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/gnames/gnfmt"
 	gncsv "github.com/gnames/gnfmt"
@@ -69,3 +70,48 @@ func (p Parsed) jsonOutput(pretty bool) string {
 	res, _ := enc.Encode(p)
 	return string(res)
 }
+
+// Output creates a JSON or CSV representation of a parsed authorship.
+func (a Authorship) Output(f gnfmt.Format) string {
+	switch f {
+	case gnfmt.CSV:
+		return a.csvOutput(',')
+	case gnfmt.TSV:
+		return a.csvOutput('\t')
+	case gnfmt.CompactJSON:
+		return a.jsonOutput(false)
+	case gnfmt.PrettyJSON:
+		return a.jsonOutput(true)
+	default:
+		return "N/A"
+	}
+}
+
+// HeaderAuthorshipCSV returns the CSV header for authorship parsing output.
+func HeaderAuthorshipCSV(f gnfmt.Format) string {
+	header := []string{"Verbatim", "Authorship", "Year", "Authors"}
+	switch f {
+	case gnfmt.CSV:
+		return gnfmt.ToCSV(header, ',')
+	case gnfmt.TSV:
+		return gnfmt.ToCSV(header, '\t')
+	default:
+		return ""
+	}
+}
+
+func (a Authorship) csvOutput(sep rune) string {
+	res := []string{
+		a.Verbatim,
+		a.Normalized,
+		a.Year,
+		strings.Join(a.Authors, "|"),
+	}
+	return gncsv.ToCSV(res, sep)
+}
+
+func (a Authorship) jsonOutput(pretty bool) string {
+	enc := gnfmt.GNjson{Pretty: pretty}
+	res, _ := enc.Encode(a)
+	return string(res)
+}
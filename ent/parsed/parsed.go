@@ -50,6 +50,18 @@ type Parsed struct {
 	// 4 - quadrinomial
 	Cardinality int `json:"cardinality"`
 
+	// Code is a nomenclatural code of a name. It is either inferred from
+	// evidence found during parsing (bacterial genera, ICN authors, ranks,
+	// style of authorship etc.), or set by a code hint from settings.
+	// If there is not enough evidence, the Code stays unknown and is
+	// omitted from JSON output.
+	Code Code `json:"code,omitempty"`
+
+	// InferredRank is a suprageneric rank of a uninomial inferred from
+	// its ending, for example "family" for "Muscidae". It is nil for
+	// other names and for uninomials without a standard ending.
+	InferredRank *InferredRank `json:"inferredRank,omitempty"`
+
 	// Authorship describes provided metainformation about authors of a name.
 	// This authorship provided outside of Details belongs to
 	// the most fine-grained element of a name.
@@ -60,25 +72,31 @@ type Parsed struct {
 	// values are "maybe" - if the genus has homonyms in other groups
 	// and "yes" if GNparser dictionary does not detect any homonyms
 	//
-	// The bacterial names often contain strain information which are
-	// not parseable and are placed into the "tail" field.
+	// The bacterial names often contain strain information. Recognized
+	// strain designations are placed into the "strains" field, the rest
+	// goes to the "tail" field.
 	Bacteria *tb.Tribool `json:"bacteria,omitempty"`
 
-	// Virus is set to true in case if name is not parsed, and probably
-	// belongs to a wide variety of sub-cellular entities like
+	// Strains contains strain designations and culture-collection
+	// numbers found after a bacterial name, for example
+	// "strain K-12" or "ATCC 25922".
+	Strains []Strain `json:"strains,omitempty"`
+
+	// Virus is set to true in case if a name belongs to a wide variety
+	// of sub-cellular entities like
 	//
 	// - viruses
+	// - bacteriophages
 	// - plasmids
 	// - prions
-	// - RNA
-	// - DNA
+	// - satellites
+	// - viroids
 	//
 	// Viruses are the vast majority in this group of names,
 	// as a result they gave (very imprecise) name to
-	// the field.
-	//
-	// We do plan to create a parser for viruses at some point,
-	// which will expand this group into more precise categories.
+	// the field. Such names are parsed by a separate virus parser, their
+	// category, ICTV binomial, strain, isolate and serotype information
+	// can be found in DetailsVirus.
 	Virus bool `json:"virus,omitempty"`
 
 	// DaggerChar if true if a name-string includes '†' rune.
@@ -109,10 +127,24 @@ type Parsed struct {
 	// - approximations (names for specimen that not fully identified)
 	Surrogate *Annotation `json:"surrogate,omitempty"`
 
+	// NomenclaturalStatus is provided if a name-string contains
+	// a nomenclatural status like "nom. nud.", "nom. illeg.", "comb. nov.",
+	// "sp. nov.".
+	NomenclaturalStatus *NomenclaturalStatus `json:"nomenclaturalStatus,omitempty"`
+
+	// TaxonConcept is provided if a name-string contains a taxon concept
+	// qualifier like "sensu lato", "sensu Smith 1990", "auct. non L.",
+	// "pro parte". It does not affect the quality of parsing.
+	TaxonConcept *TaxonConcept `json:"taxonConcept,omitempty"`
+
+	// PublishedIn is a bibliographic reference that follows the authorship
+	// of a name. It is parsed only if WithPublishedIn option is set.
+	PublishedIn *PublishedIn `json:"publishedIn,omitempty"`
+
 	// Tail is an unparseable tail of a name. It might contain "junk",
-	// annotations, malformed parts of a scientific name, taxonomic concept
-	// indications, bacterial strains etc.  If there is an unparseable tail, the
-	// quality of the name-parsing is set to the worst category.
+	// annotations, malformed parts of a scientific name etc.  If there is
+	// an unparseable tail, the quality of the name-parsing is set to the
+	// worst category.
 	Tail string `json:"tail,omitempty"`
 
 	// Details contain more fine-grained information about parsed name.
@@ -154,6 +186,15 @@ type Canonical struct {
 	// multiple results. It is also recommended for displaying
 	// canonical forms of botanical names.
 	Full string `json:"full"`
+	// Phonetic is a version of the simple canonical form where words are
+	// converted to Taxamatch-style phonetic keys. Sound-alike spelling
+	// variants (ae/e, ph/f, y/i, double consonants etc.) and endings of
+	// epithets are normalized.
+	//
+	// It is most useful to match names with misspellings, for example
+	// from OCR-ed texts ("Pseudophyllum" and "Pseudofillum").
+	// Phonetic is empty unless phonetic keys are requested.
+	Phonetic string `json:"phonetic,omitempty"`
 }
 
 // Authorship describes provided metainformation about authors of a name.
@@ -179,6 +220,18 @@ type Authorship struct {
 	// Combination is an AuthGroup that contains authors of new combination,
 	// rank etc.
 	Combination *AuthGroup `json:"combinationAuth,omitempty"`
+
+	// ParseQuality is the quality of parsing of a standalone authorship
+	// string. It follows the same scale as the ParseQuality of a name and
+	// is empty for authorships that are parts of a name.
+	ParseQuality int `json:"quality,omitempty"`
+
+	// QualityWarnings contains problems encountered during parsing of
+	// a standalone authorship string.
+	QualityWarnings []QualityWarning `json:"qualityWarnings,omitempty"`
+
+	// Tail is an unparsed remainder of a standalone authorship string.
+	Tail string `json:"tail,omitempty"`
 }
 
 // AuthGroup are provided only if config.WithDetails is true. Group of
@@ -190,6 +243,8 @@ type Authorship struct {
 type AuthGroup struct {
 	// Authors is a slice of strings containing found outhors
 	Authors []string `json:"authors"`
+	// Persons contain structured information about each of the authors.
+	Persons []AuthorPerson `json:"persons,omitempty"`
 	// Year provided only if "with_details=true" Year of the original
 	// publication. If a range of the years provided, the start year is kept,
 	// with isApproximate flag set to true.
@@ -208,10 +263,27 @@ type AuthGroup struct {
 type Authors struct {
 	// Authors is a slice of strings containing found outhors of an AuthGroup
 	Authors []string `json:"authors"`
+	// Persons contain structured information about each of the authors.
+	Persons []AuthorPerson `json:"persons,omitempty"`
 	// Year of publication by the AuthGroup.
 	Year *Year `json:"year,omitempty"`
 }
 
+// AuthorPerson is a structured representation of an author's name, for
+// example "R. C. van der Smith fil.".
+type AuthorPerson struct {
+	// Verbatim is the author's name as it appears in the name-string.
+	Verbatim string `json:"verbatim"`
+	// Surname of the author, often abbreviated, like "Mill." or "DC.".
+	Surname string `json:"surname"`
+	// Initials of the author, for example "R. C.".
+	Initials string `json:"initials,omitempty"`
+	// Particle of the surname like "de", "von", "van der".
+	Particle string `json:"particle,omitempty"`
+	// Suffix like "fil." (filius, f.), "bis", "ter".
+	Suffix string `json:"suffix,omitempty"`
+}
+
 // Year provided only if "with_details=true" Year of the original
 // publication. If a range of the years provided, the start year is kept,
 // with isApproximate flag set to true.
@@ -223,3 +295,41 @@ type Year struct {
 	// a question mark "188?", by parentheses "(1888)".
 	IsApproximate bool `json:"isApproximate,omitempty"`
 }
+
+// NomenclaturalStatus describes a nomenclatural status of a name.
+type NomenclaturalStatus struct {
+	// Verbatim is the status as it appears in the name-string.
+	Verbatim string `json:"verbatim"`
+	// Normalized is a standard abbreviation of the status, for example
+	// "nom. nud." for "nomen nudum".
+	Normalized string `json:"normalized"`
+}
+
+// PublishedIn is a bibliographic reference to a publication where a name
+// appeared, for example "Sp. Pl. 2: 1001. 1753".
+type PublishedIn struct {
+	// Verbatim is the reference as it appears in the name-string.
+	Verbatim string `json:"verbatim"`
+	// Work is an abbreviated title of a book or a journal.
+	Work string `json:"work"`
+	// Volume of the work, it might include an issue, e.g. "5(1)".
+	Volume string `json:"volume,omitempty"`
+	// Pages are pages, plates or numbers of the publication.
+	Pages string `json:"pages,omitempty"`
+	// Year of the publication.
+	Year string `json:"year,omitempty"`
+}
+
+// Strain is a designation of a bacterial strain, often in a form of an
+// accession number of a culture collection.
+type Strain struct {
+	// Verbatim is the strain designation as it appears in the name-string.
+	Verbatim string `json:"verbatim"`
+	// Collection is an acronym of a culture collection, for example "ATCC".
+	Collection string `json:"collection,omitempty"`
+	// Number is a strain number or a name of a strain without a type
+	// strain marker.
+	Number string `json:"number"`
+	// IsType is true for type strains, usually marked by a superscript "T".
+	IsType bool `json:"isType,omitempty"`
+}
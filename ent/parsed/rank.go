@@ -0,0 +1,330 @@
+package parsed
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Rank is a controlled vocabulary of ranks that appear in scientific names
+// as rank markers ('subsp.', 'var.', 'subg.' etc.). Every spelling
+// recognized by the parser is mapped to one of the ranks.
+type Rank int
+
+const (
+	// UnknownRank means that the rank is not given or cannot be recognized.
+	UnknownRank Rank = iota
+	// PhylumRank ('phyl.').
+	PhylumRank
+	// DivisionRank ('div.') is a botanical rank, equivalent to phylum.
+	DivisionRank
+	// OrderRank ('ord.').
+	OrderRank
+	// SuperfamilyRank ('superfam.').
+	SuperfamilyRank
+	// FamilyRank ('fam.').
+	FamilyRank
+	// SubfamilyRank ('subfam.').
+	SubfamilyRank
+	// SupertribeRank ('supertrib.').
+	SupertribeRank
+	// TribeRank ('trib.').
+	TribeRank
+	// SubtribeRank ('subtrib.').
+	SubtribeRank
+	// GenusRank ('gen.').
+	GenusRank
+	// SubgenusRank ('subgen.', 'subg.').
+	SubgenusRank
+	// SectionRank ('sect.').
+	SectionRank
+	// SubsectionRank ('subsect.').
+	SubsectionRank
+	// SeriesRank ('ser.').
+	SeriesRank
+	// SubseriesRank ('subser.').
+	SubseriesRank
+	// SpeciesRank ('sp.').
+	SpeciesRank
+	// AgamospeciesRank ('agamosp.') is a species of apomictic plants.
+	AgamospeciesRank
+	// SubspeciesRank ('subsp.', 'ssp.').
+	SubspeciesRank
+	// AgamosubspeciesRank ('agamossp.').
+	AgamosubspeciesRank
+	// NatioRank ('natio', 'nat.') is an old zoological infrasubspecific rank.
+	NatioRank
+	// ConvarietyRank ('convar.') is a rank of cultivated plants.
+	ConvarietyRank
+	// RaceRank ('race').
+	RaceRank
+	// VarietyRank ('var.').
+	VarietyRank
+	// AgamovarietyRank ('agamovar.').
+	AgamovarietyRank
+	// PseudovarietyRank ('pseudovar.').
+	PseudovarietyRank
+	// SubvarietyRank ('subvar.').
+	SubvarietyRank
+	// FormRank ('f.', 'fo.', 'forma').
+	FormRank
+	// SubformRank ('subf.').
+	SubformRank
+	// FormaSpecialisRank ('f.sp.') is a rank of parasitic fungi adapted to
+	// a specific host.
+	FormaSpecialisRank
+	// PathovarRank ('pv.') is a rank of pathogenic bacteria.
+	PathovarRank
+	// MorphRank ('morph.').
+	MorphRank
+	// StirpsRank ('st.').
+	StirpsRank
+	// AberrationRank ('ab.').
+	AberrationRank
+	// MutationRank ('mut.').
+	MutationRank
+	// UnrankedRank is given to infraspecific epithets that are preceded by
+	// enumerating markers ('α', 'β', 'a.', 'b.', '*') instead of ranks.
+	UnrankedRank
+	// NothoGenusRank ('nothogen.') is a hybrid genus.
+	NothoGenusRank
+	// NothoSubgenusRank ('nothosubgen.').
+	NothoSubgenusRank
+	// NothoSectionRank ('nothosect.').
+	NothoSectionRank
+	// NothoSubsectionRank ('nothosubsect.').
+	NothoSubsectionRank
+	// NothoSeriesRank ('nothoser.').
+	NothoSeriesRank
+	// NothoSubtribeRank ('nothosubtrib.').
+	NothoSubtribeRank
+	// NothoSpeciesRank ('nothosp.').
+	NothoSpeciesRank
+	// NothoSubspeciesRank ('nothosubsp.').
+	NothoSubspeciesRank
+	// NothoVarietyRank ('nothovar.').
+	NothoVarietyRank
+	// NothoFormRank ('nothof.').
+	NothoFormRank
+	// NothoMorphRank ('nothomorph.').
+	NothoMorphRank
+)
+
+// rankData describes a rank of the vocabulary.
+type rankData struct {
+	// marker is a normalized rank marker.
+	marker string
+	// term is a full name of the rank, as used in Darwin Core.
+	term string
+	// order is a position of the rank in the hierarchy.
+	order int
+	// base is the corresponding non-hybrid rank for notho-ranks.
+	base Rank
+	// codes are nomenclatural codes that use the rank.
+	codes []Code
+}
+
+var (
+	allCodes    = []Code{ICZN, ICN, ICNP}
+	botCodes    = []Code{ICN}
+	zooBotCodes = []Code{ICZN, ICN}
+	zooCodes    = []Code{ICZN}
+)
+
+var rankMap = map[Rank]rankData{
+	UnknownRank:         {},
+	PhylumRank:          {"phyl.", "phylum", 10, PhylumRank, []Code{ICN, ICNP}},
+	DivisionRank:        {"div.", "division", 10, DivisionRank, botCodes},
+	OrderRank:           {"ord.", "order", 15, OrderRank, []Code{ICN, ICNP}},
+	SuperfamilyRank:     {"superfam.", "superfamily", 19, SuperfamilyRank, zooCodes},
+	FamilyRank:          {"fam.", "family", 20, FamilyRank, allCodes},
+	SubfamilyRank:       {"subfam.", "subfamily", 21, SubfamilyRank, allCodes},
+	SupertribeRank:      {"supertrib.", "supertribe", 22, SupertribeRank, zooBotCodes},
+	TribeRank:           {"trib.", "tribe", 23, TribeRank, allCodes},
+	SubtribeRank:        {"subtrib.", "subtribe", 24, SubtribeRank, allCodes},
+	GenusRank:           {"gen.", "genus", 30, GenusRank, allCodes},
+	SubgenusRank:        {"subgen.", "subgenus", 31, SubgenusRank, allCodes},
+	SectionRank:         {"sect.", "section", 32, SectionRank, botCodes},
+	SubsectionRank:      {"subsect.", "subsection", 33, SubsectionRank, botCodes},
+	SeriesRank:          {"ser.", "series", 34, SeriesRank, botCodes},
+	SubseriesRank:       {"subser.", "subseries", 35, SubseriesRank, botCodes},
+	SpeciesRank:         {"sp.", "species", 40, SpeciesRank, allCodes},
+	AgamospeciesRank:    {"agamosp.", "agamospecies", 40, AgamospeciesRank, botCodes},
+	SubspeciesRank:      {"subsp.", "subspecies", 41, SubspeciesRank, allCodes},
+	AgamosubspeciesRank: {"agamossp.", "agamosubspecies", 41, AgamosubspeciesRank, botCodes},
+	NatioRank:           {"natio", "natio", 42, NatioRank, zooCodes},
+	ConvarietyRank:      {"convar.", "convariety", 42, ConvarietyRank, []Code{ICNCP}},
+	RaceRank:            {"race", "race", 42, RaceRank, zooCodes},
+	VarietyRank:         {"var.", "variety", 43, VarietyRank, []Code{ICN, ICNP}},
+	AgamovarietyRank:    {"agamovar.", "agamovariety", 43, AgamovarietyRank, botCodes},
+	PseudovarietyRank:   {"pseudovar.", "pseudovariety", 43, PseudovarietyRank, botCodes},
+	SubvarietyRank:      {"subvar.", "subvariety", 44, SubvarietyRank, botCodes},
+	FormRank:            {"f.", "form", 45, FormRank, botCodes},
+	SubformRank:         {"subf.", "subform", 46, SubformRank, botCodes},
+	FormaSpecialisRank:  {"f.sp.", "forma specialis", 47, FormaSpecialisRank, botCodes},
+	PathovarRank:        {"pv.", "pathovar", 47, PathovarRank, []Code{ICNP}},
+	StirpsRank:          {"st.", "stirps", 47, StirpsRank, botCodes},
+	MorphRank:           {"morph.", "morph", 48, MorphRank, zooCodes},
+	AberrationRank:      {"ab.", "aberration", 48, AberrationRank, zooCodes},
+	MutationRank:        {"mut.", "mutation", 48, MutationRank, zooCodes},
+	UnrankedRank:        {"[unranked]", "unranked", 0, UnrankedRank, nil},
+	NothoGenusRank:      {"nothogen.", "nothogenus", 30, GenusRank, botCodes},
+	NothoSubgenusRank:   {"nothosubgen.", "nothosubgenus", 31, SubgenusRank, botCodes},
+	NothoSectionRank:    {"nothosect.", "nothosection", 32, SectionRank, botCodes},
+	NothoSubsectionRank: {"nothosubsect.", "nothosubsection", 33, SubsectionRank, botCodes},
+	NothoSeriesRank:     {"nothoser.", "nothoseries", 34, SeriesRank, botCodes},
+	NothoSubtribeRank:   {"nothosubtrib.", "nothosubtribe", 24, SubtribeRank, botCodes},
+	NothoSpeciesRank:    {"nothosp.", "nothospecies", 40, SpeciesRank, botCodes},
+	NothoSubspeciesRank: {"nothosubsp.", "nothosubspecies", 41, SubspeciesRank, botCodes},
+	NothoVarietyRank:    {"nothovar.", "nothovariety", 43, VarietyRank, botCodes},
+	NothoFormRank:       {"nothof.", "nothoform", 45, FormRank, botCodes},
+	NothoMorphRank:      {"nothomorph.", "nothomorph", 48, MorphRank, botCodes},
+}
+
+// rankSpellings maps spellings of rank markers to ranks. Keys are
+// lower-case spellings without dots, spaces and square brackets.
+var rankSpellings = map[string]Rank{
+	"div": DivisionRank, "fam": FamilyRank, "subfam": SubfamilyRank,
+	"supertrib": SupertribeRank, "trib": TribeRank, "subtrib": SubtribeRank,
+	"gen": GenusRank, "subgen": SubgenusRank, "subg": SubgenusRank,
+	"sect": SectionRank, "subsect": SubsectionRank, "ser": SeriesRank,
+	"subser": SubseriesRank, "sp": SpeciesRank, "spec": SpeciesRank,
+	"agamosp": AgamospeciesRank, "subsp": SubspeciesRank,
+	"ssp": SubspeciesRank, "subspec": SubspeciesRank,
+	"agamossp": AgamosubspeciesRank, "nat": NatioRank,
+	"convar": ConvarietyRank, "var": VarietyRank,
+	"agamovar": AgamovarietyRank, "pseudovar": PseudovarietyRank,
+	"subvar": SubvarietyRank, "f": FormRank, "fo": FormRank, "fm": FormRank,
+	"fma": FormRank, "forma": FormRank, "subf": SubformRank,
+	"subforma": SubformRank, "fsp": FormaSpecialisRank, "pv": PathovarRank,
+	"st": StirpsRank, "ab": AberrationRank, "abn": AberrationRank,
+	"mut": MutationRank, "*": UnrankedRank, "α": UnrankedRank,
+	"β": UnrankedRank, "ββ": UnrankedRank, "γ": UnrankedRank,
+	"δ": UnrankedRank, "ε": UnrankedRank, "φ": UnrankedRank,
+	"θ": UnrankedRank, "μ": UnrankedRank, "a": UnrankedRank,
+	"b": UnrankedRank, "c": UnrankedRank, "d": UnrankedRank,
+	"e": UnrankedRank, "g": UnrankedRank, "k": UnrankedRank,
+	"nothosubg": NothoSubgenusRank, "nothosubgeen": NothoSubgenusRank,
+	"nothossp": NothoSubspeciesRank, "nothosupsp": NothoSubspeciesRank,
+	"nothosu": NothoSubspeciesRank, "nvar": NothoVarietyRank,
+	"nothofo": NothoFormRank, "nothoforma": NothoFormRank,
+	"nothomorth": NothoMorphRank,
+}
+
+// rankStrMap maps normalized markers and terms of ranks, as well as all
+// known spellings of rank markers, to ranks.
+var rankStrMap = func() map[string]Rank {
+	res := make(map[string]Rank)
+	for k, v := range rankMap {
+		if k == UnknownRank {
+			continue
+		}
+		res[rankKey(v.marker)] = k
+		res[rankKey(v.term)] = k
+	}
+	for k, v := range rankSpellings {
+		res[k] = v
+	}
+	return res
+}()
+
+// rankKey removes dots, spaces and square brackets from a rank marker
+// and converts it to lower case.
+func rankKey(s string) string {
+	s = strings.ToLower(s)
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '.', '[', ']':
+			return -1
+		}
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// NewRank converts a rank marker, or a full name of a rank, to a Rank.
+// It understands all spellings of rank markers recognized by the parser,
+// including markers followed by Greek letters ('var. β'). Unrecognized
+// strings are converted to UnknownRank.
+func NewRank(s string) Rank {
+	k := rankKey(s)
+	if r, ok := rankStrMap[k]; ok {
+		return r
+	}
+	k = strings.TrimRightFunc(k, func(r rune) bool {
+		return unicode.Is(unicode.Greek, r)
+	})
+	if r, ok := rankStrMap[k]; ok {
+		return r
+	}
+	return UnknownRank
+}
+
+// String is an implementation of fmt.Stringer interface. It returns
+// a normalized rank marker.
+func (r Rank) String() string {
+	return rankMap[r].marker
+}
+
+// Term returns a full name of a rank, as it is used in Darwin Core
+// taxonRank vocabulary ('subspecies', 'variety').
+func (r Rank) Term() string {
+	return rankMap[r].term
+}
+
+// Order returns a position of a rank in the hierarchy. Higher ranks
+// have smaller numbers, ranks of the same level (for example variety
+// and agamovariety) have the same number. UnknownRank and UnrankedRank
+// return 0.
+func (r Rank) Order() int {
+	return rankMap[r].order
+}
+
+// IsNotho returns true for ranks of hybrid taxa (nothospecies,
+// nothosubspecies etc.).
+func (r Rank) IsNotho() bool {
+	return r != rankMap[r].base
+}
+
+// Base returns a corresponding non-hybrid rank for notho-ranks. For other
+// ranks it returns the rank itself.
+func (r Rank) Base() Rank {
+	return rankMap[r].base
+}
+
+// Codes returns nomenclatural codes that use the rank.
+func (r Rank) Codes() []Code {
+	return rankMap[r].codes
+}
+
+// AppliesTo returns true if the rank is used by the given nomenclatural
+// code. Ranks of ICN apply to ICNCP as well. For UnknownCode it returns
+// true for every recognized rank.
+func (r Rank) AppliesTo(code Code) bool {
+	if r == UnknownRank {
+		return false
+	}
+	if code == UnknownCode {
+		return true
+	}
+	for _, v := range rankMap[r].codes {
+		if v == code || (code == ICNCP && v == ICN) {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r Rank) MarshalJSON() ([]byte, error) {
+	return []byte("\"" + r.String() + "\""), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaller. Unrecognized ranks, for
+// example from outputs of other versions of the parser, are decoded to
+// UnknownRank.
+func (r *Rank) UnmarshalJSON(bs []byte) error {
+	*r = NewRank(strings.Trim(string(bs), `"`))
+	return nil
+}
@@ -0,0 +1,98 @@
+package parsed_test
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRank(t *testing.T) {
+	tests := []struct {
+		msg, spelling string
+		rank          parsed.Rank
+	}{
+		{"ssp", "ssp.", parsed.SubspeciesRank},
+		{"subspec", "subspec.", parsed.SubspeciesRank},
+		{"subsp", "subsp.", parsed.SubspeciesRank},
+		{"forma", "forma", parsed.FormRank},
+		{"fma", "fma.", parsed.FormRank},
+		{"var brackets", "[var.]", parsed.VarietyRank},
+		{"var greek", "var. β", parsed.VarietyRank},
+		{"natio", "natio", parsed.NatioRank},
+		{"nat", "nat.", parsed.NatioRank},
+		{"f.sp", "f.sp.", parsed.FormaSpecialisRank},
+		{"ab. n.", "ab. n.", parsed.AberrationRank},
+		{"pathovar", "pathovar.", parsed.PathovarRank},
+		{"greek", "β", parsed.UnrankedRank},
+		{"star", "*", parsed.UnrankedRank},
+		{"nvar", "nvar.", parsed.NothoVarietyRank},
+		{"nothossp", "nothossp.", parsed.NothoSubspeciesRank},
+		{"notho sect", "notho sect.", parsed.NothoSectionRank},
+		{"subg", "subg.", parsed.SubgenusRank},
+		{"term", "Subspecies", parsed.SubspeciesRank},
+		{"forma specialis", "forma specialis", parsed.FormaSpecialisRank},
+		{"unknown", "cv.", parsed.UnknownRank},
+		{"empty", "", parsed.UnknownRank},
+	}
+	for _, v := range tests {
+		assert.Equal(t, v.rank, parsed.NewRank(v.spelling), v.msg)
+	}
+}
+
+func TestRankProperties(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("nothosubsp.", parsed.NothoSubspeciesRank.String())
+	assert.Equal("nothosubspecies", parsed.NothoSubspeciesRank.Term())
+	assert.True(parsed.NothoSubspeciesRank.IsNotho())
+	assert.False(parsed.SubspeciesRank.IsNotho())
+	assert.Equal(parsed.SubspeciesRank, parsed.NothoSubspeciesRank.Base())
+	assert.Equal(
+		parsed.SubspeciesRank.Order(),
+		parsed.NothoSubspeciesRank.Order(),
+	)
+
+	assert.True(parsed.PathovarRank.AppliesTo(parsed.ICNP))
+	assert.False(parsed.PathovarRank.AppliesTo(parsed.ICZN))
+	assert.True(parsed.FormRank.AppliesTo(parsed.ICNCP))
+	assert.True(parsed.SectionRank.AppliesTo(parsed.UnknownCode))
+	assert.False(parsed.UnknownRank.AppliesTo(parsed.UnknownCode))
+	assert.Nil(parsed.UnrankedRank.Codes())
+
+	ranks := []parsed.Rank{
+		parsed.FormRank, parsed.SpeciesRank, parsed.FamilyRank,
+		parsed.VarietyRank, parsed.SubgenusRank, parsed.SubspeciesRank,
+	}
+	sort.Slice(ranks, func(i, j int) bool {
+		return ranks[i].Order() < ranks[j].Order()
+	})
+	assert.Equal([]parsed.Rank{
+		parsed.FamilyRank, parsed.SubgenusRank, parsed.SpeciesRank,
+		parsed.SubspeciesRank, parsed.VarietyRank, parsed.FormRank,
+	}, ranks)
+}
+
+func TestRankJSON(t *testing.T) {
+	assert := assert.New(t)
+	type data struct {
+		Rank parsed.Rank `json:"rank,omitempty"`
+	}
+	res, err := json.Marshal(data{Rank: parsed.NothoVarietyRank})
+	assert.Nil(err)
+	assert.Equal(`{"rank":"nothovar."}`, string(res))
+
+	res, err = json.Marshal(data{})
+	assert.Nil(err)
+	assert.Equal(`{}`, string(res))
+
+	var d data
+	err = json.Unmarshal([]byte(`{"rank":"[unranked]"}`), &d)
+	assert.Nil(err)
+	assert.Equal(parsed.UnrankedRank, d.Rank)
+
+	err = json.Unmarshal([]byte(`{"rank":"cv."}`), &d)
+	assert.Nil(err)
+	assert.Equal(parsed.UnknownRank, d.Rank)
+}
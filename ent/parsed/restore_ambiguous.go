@@ -3,6 +3,7 @@ package parsed
 import (
 	"strings"
 
+	"github.com/gnames/gnparser/ent/phonetic"
 	"github.com/gnames/gnparser/ent/stemmer"
 )
 
@@ -17,6 +18,9 @@ func (p *Parsed) RestoreAmbiguous(epithet, subst string) {
 	p.Canonical.Full = restoreString(p.Canonical.Full, epithet, subst)
 	p.Canonical.Simple = restoreString(p.Canonical.Simple, epithet, subst)
 	p.Canonical.Stemmed = restoreString(p.Canonical.Stemmed, stem, stemSubst)
+	if p.Canonical.Phonetic != "" {
+		p.Canonical.Phonetic = phonetic.Canonical(p.Canonical.Simple)
+	}
 
 	for i := range p.Words {
 		p.Words[i].Verbatim = restoreWord(p.Words[i].Verbatim, epithet, subst)
@@ -0,0 +1,67 @@
+package parsed
+
+import (
+	"errors"
+	"strings"
+)
+
+// VirusCategory describes what kind of a sub-cellular entity a name
+// belongs to.
+type VirusCategory int
+
+const (
+	// UnknownVirusCategory is used when a category cannot be determined.
+	UnknownVirusCategory VirusCategory = iota
+	// VirusCat is a category of viruses.
+	VirusCat
+	// PhageCat is a category of bacteriophages.
+	PhageCat
+	// PlasmidCat is a category of plasmids and cloning vectors.
+	PlasmidCat
+	// PrionCat is a category of prions.
+	PrionCat
+	// SatelliteCat is a category of satellite viruses and nucleic acids.
+	SatelliteCat
+	// ViroidCat is a category of viroids.
+	ViroidCat
+)
+
+var virusCategoryMap = map[VirusCategory]string{
+	UnknownVirusCategory: "",
+	VirusCat:             "VIRUS",
+	PhageCat:             "PHAGE",
+	PlasmidCat:           "PLASMID",
+	PrionCat:             "PRION",
+	SatelliteCat:         "SATELLITE",
+	ViroidCat:            "VIROID",
+}
+
+var virusCategoryStrMap = func() map[string]VirusCategory {
+	res := make(map[string]VirusCategory)
+	for k, v := range virusCategoryMap {
+		res[v] = k
+	}
+	return res
+}()
+
+// String is an implementation of fmt.Stringer interface.
+func (vc VirusCategory) String() string {
+	return virusCategoryMap[vc]
+}
+
+// MarshalJSON implements json.Marshaler.
+func (vc VirusCategory) MarshalJSON() ([]byte, error) {
+	return []byte("\"" + vc.String() + "\""), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaller.
+func (vc *VirusCategory) UnmarshalJSON(bs []byte) error {
+	var err error
+	var ok bool
+	s := strings.Trim(string(bs), `"`)
+	*vc, ok = virusCategoryStrMap[s]
+	if !ok {
+		err = errors.New("cannot decode VirusCategory")
+	}
+	return err
+}
@@ -29,6 +29,11 @@ const (
 	CanonicalApostropheWarn
 	CapWordQuestionWarn
 	CharBadWarn
+	ComponentsCaseWarn
+	ComponentsMismatchWarn
+	ComponentsMissingWarn
+	ComponentsRankMismatchWarn
+	ComponentsRankMissingWarn
 	CultivarEpithetWarn
 	DotEpithetWarn
 	GenusAbbrWarn
@@ -48,6 +53,10 @@ const (
 	LowCaseWarn
 	NameApproxWarn
 	NameComparisonWarn
+	PhraseNameWarn
+	PublishedInNoPagesWarn
+	PublishedInNoYearWarn
+	PublishedInYearMismatchWarn
 	RankUncommonWarn
 	SpaceNonStandardWarn
 	SpanishAndAsSeparator
@@ -56,6 +65,7 @@ const (
 	SuperspeciesWarn
 	UTF8ConvBadWarn
 	UninomialComboWarn
+	VirusParensUnbalancedWarn
 	WhiteSpaceTrailWarn
 	YearCharWarn
 	YearDotWarn
@@ -87,6 +97,11 @@ var warningMap = map[Warning]string{
 	CanonicalApostropheWarn:               "Apostrophe is not allowed in canonical",
 	CapWordQuestionWarn:                   "Uninomial word with question mark",
 	CharBadWarn:                           "Non-standard characters in canonical",
+	ComponentsCaseWarn:                    "Name component with a wrong case",
+	ComponentsMismatchWarn:                "Parsed name does not match name components",
+	ComponentsMissingWarn:                 "Required name component is missing",
+	ComponentsRankMismatchWarn:            "Rank is inconsistent with name components",
+	ComponentsRankMissingWarn:             "Rank is missing for infraspecific epithet",
 	CultivarEpithetWarn:                   "Cultivar epithet",
 	DotEpithetWarn:                        "Period character is not allowed in canonical",
 	GenusAbbrWarn:                         "Abbreviated uninomial word",
@@ -106,6 +121,10 @@ var warningMap = map[Warning]string{
 	LowCaseWarn:                           "Name starts with low-case character",
 	NameApproxWarn:                        "Name is approximate",
 	NameComparisonWarn:                    "Name comparison",
+	PhraseNameWarn:                        "Informal phrase name",
+	PublishedInNoPagesWarn:                "Publication reference without pages",
+	PublishedInNoYearWarn:                 "Publication reference without year",
+	PublishedInYearMismatchWarn:           "Publication year differs from authorship year",
 	RankUncommonWarn:                      "Uncommon rank",
 	SpaceNonStandardWarn:                  "Non-standard space characters",
 	SpanishAndAsSeparator:                 "Spanish 'y' is used instead of '&'",
@@ -114,6 +133,7 @@ var warningMap = map[Warning]string{
 	SuperspeciesWarn:                      "Ambiguity: subgenus or superspecies found",
 	UTF8ConvBadWarn:                       "Incorrect conversion to UTF-8",
 	UninomialComboWarn:                    "Combination of two uninomials",
+	VirusParensUnbalancedWarn:             "Unbalanced parentheses in virus name",
 	WhiteSpaceTrailWarn:                   "Trailing whitespace",
 	YearCharWarn:                          "Year with latin character",
 	YearDotWarn:                           "Year with period",
@@ -154,6 +174,11 @@ var WarningQualityMap = map[Warning]int{
 	CanonicalApostropheWarn:               3,
 	CapWordQuestionWarn:                   4,
 	CharBadWarn:                           2,
+	ComponentsCaseWarn:                    3,
+	ComponentsMismatchWarn:                3,
+	ComponentsMissingWarn:                 4,
+	ComponentsRankMismatchWarn:            3,
+	ComponentsRankMissingWarn:             2,
 	CultivarEpithetWarn:                   2,
 	DotEpithetWarn:                        3,
 	GenusAbbrWarn:                         4,
@@ -173,6 +198,10 @@ var WarningQualityMap = map[Warning]int{
 	LowCaseWarn:                           4,
 	NameApproxWarn:                        4,
 	NameComparisonWarn:                    4,
+	PhraseNameWarn:                        2,
+	PublishedInNoPagesWarn:                2,
+	PublishedInNoYearWarn:                 2,
+	PublishedInYearMismatchWarn:           2,
 	RankUncommonWarn:                      3,
 	SpaceNonStandardWarn:                  2,
 	SpanishAndAsSeparator:                 2,
@@ -181,6 +210,7 @@ var WarningQualityMap = map[Warning]int{
 	SuperspeciesWarn:                      2,
 	UTF8ConvBadWarn:                       4,
 	UninomialComboWarn:                    2,
+	VirusParensUnbalancedWarn:             3,
 	WhiteSpaceTrailWarn:                   2,
 	YearCharWarn:                          2,
 	YearDotWarn:                           2,
@@ -64,6 +64,10 @@ const (
 	UninomialType
 	YearApproximateType
 	YearType
+	VirusWordType
+	StrainType
+	PhraseType
+	VoucherType
 )
 
 var wordTypeMap = map[WordType]string{
@@ -84,6 +88,10 @@ var wordTypeMap = map[WordType]string{
 	UninomialType:        "UNINOMIAL",
 	YearApproximateType:  "APPROXIMATE_YEAR",
 	YearType:             "YEAR",
+	VirusWordType:        "VIRUS_WORD",
+	StrainType:           "STRAIN",
+	PhraseType:           "PHRASE",
+	VoucherType:          "VOUCHER",
 }
 
 var wordTypeStrMap = func() map[string]WordType {
@@ -9,7 +9,6 @@ import (
 
 	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/gnames/gnparser/ent/str"
-	"github.com/gnames/gnparser/io/dict"
 	"github.com/gnames/gnuuid"
 	"github.com/gnames/tribool"
 )
@@ -25,10 +24,19 @@ type scientificNameNode struct {
 	surrogate        *parsed.Annotation
 	bacteria         *tribool.Tribool
 	tail             string
+	strains          []parsed.Strain
+	nomStatus        *parsed.NomenclaturalStatus
+	taxonConcept     *parsed.TaxonConcept
+	aggregate        string
+	publishedIn      *parsed.PublishedIn
 	parserVersion    string
 	ambiguousEpithet string
 	ambiguousModif   string
 	warnings         map[parsed.Warning]struct{}
+	codeHint         parsed.Code
+	icnAuthor        bool
+	authorForm       bool
+	withPhonetic     bool
 }
 
 func (p *Engine) newScientificNameNode() {
@@ -296,6 +304,8 @@ func (p *Engine) newNamedGenusHybridNode(n *node32) *namedGenusHybridNode {
 		name = p.newUninomialComboNode(n)
 	case ruleNameSpecies:
 		name = p.newSpeciesNode(n)
+	case ruleNamePhrase:
+		name = p.newPhraseOrApproxNode(n)
 	case ruleNameApprox:
 		name = p.newApproxNode(n)
 	}
@@ -382,6 +392,8 @@ func (p *Engine) newNamedGenusGraftChimeraNode(n *node32) *namedGenusGraftChimer
 		name = p.newUninomialComboNode(n)
 	case ruleNameSpecies:
 		name = p.newSpeciesNode(n)
+	case ruleNamePhrase:
+		name = p.newPhraseOrApproxNode(n)
 	case ruleNameApprox:
 		name = p.newApproxNode(n)
 	}
@@ -404,10 +416,7 @@ func (p *Engine) botanicalUninomial(n *node32) bool {
 	}
 	w := p.newWordNode(n, parsed.UnknownType)
 
-	if _, ok := dict.Dict.AuthorICN[w.Normalized]; ok {
-		return true
-	}
-	return false
+	return p.isICNAuthor(w.Normalized)
 }
 
 func (p *Engine) newBotanicalUninomialNode(n *node32) *uninomialNode {
@@ -443,6 +452,8 @@ func (p *Engine) newSingleName(n *node32) nameData {
 	switch n.pegRule {
 	case ruleNameSpecies:
 		name = p.newSpeciesNode(n)
+	case ruleNamePhrase:
+		name = p.newPhraseOrApproxNode(n)
 	case ruleNameApprox:
 		name = p.newApproxNode(n)
 	case ruleNameComp:
@@ -532,10 +543,92 @@ func (p *Engine) newApproxNode(n *node32) *approxNode {
 	return an
 }
 
+type phraseNode struct {
+	Genus     *parsed.Word
+	SpEpithet *spEpithetNode
+	Rank      *parsed.Word
+	Phrase    *parsed.Word
+	Collector *parsed.Word
+	Number    *parsed.Word
+	Quoted    bool
+}
+
+// newPhraseOrApproxNode creates a phrase name node. Bacterial isolates
+// like 'Sphingomonas sp. 37' look like manuscript names 'Aus sp. 1', but
+// the number is not a name of a taxon there, so such names stay
+// approximations.
+func (p *Engine) newPhraseOrApproxNode(n *node32) nameData {
+	pn := p.newPhraseNode(n)
+	if pn == nil || pn.SpEpithet != nil || pn.Quoted || pn.Collector != nil {
+		return pn
+	}
+	if _, ok := p.dictionary().Bacteria[pn.Genus.Normalized]; !ok {
+		return pn
+	}
+	annot := parsed.ApproximationAnnot
+	p.surrogate = &annot
+	delete(p.warnings, parsed.PhraseNameWarn)
+	p.addWarn(parsed.NameApproxWarn)
+	pn.Rank.Type = parsed.ApproxMarkerType
+	p.cardinality = 0
+	return &approxNode{
+		Genus:   pn.Genus,
+		Approx:  pn.Rank,
+		Ignored: string(p.buffer[pn.Rank.End:n.end]),
+	}
+}
+
+func (p *Engine) newPhraseNode(n *node32) *phraseNode {
+	var pn *phraseNode
+	annot := parsed.PhraseNameAnnot
+	p.surrogate = &annot
+	p.addWarn(parsed.PhraseNameWarn)
+	if n.pegRule != ruleNamePhrase {
+		return pn
+	}
+	pn = &phraseNode{}
+	for v := n.up; v != nil; v = v.next {
+		switch v.pegRule {
+		case ruleGenusWord:
+			pn.Genus = p.newWordNode(v, parsed.GenusType)
+		case ruleSpeciesEpithet:
+			pn.SpEpithet = p.newSpeciesEpithetNode(v)
+		case rulePhraseRankSp, rulePhraseRankInfrasp:
+			pn.Rank = p.newWordNode(v, parsed.RankType)
+			if pn.Rank.Verbatim == "ssp." {
+				pn.Rank.Normalized = "subsp."
+			}
+		case rulePhraseValue:
+			pn.Quoted = true
+			pn.Phrase = p.newWordNode(v, parsed.PhraseType)
+		case rulePhraseWords, rulePhraseCode:
+			pn.Phrase = p.newWordNode(v, parsed.PhraseType)
+		case ruleVoucherCollector:
+			pn.Collector = p.newWordNode(v, parsed.VoucherType)
+		case ruleVoucherNumber:
+			pn.Number = p.newWordNode(v, parsed.VoucherType)
+		}
+	}
+	p.cardinality = 2
+	if pn.SpEpithet != nil {
+		p.cardinality = 3
+	}
+	return pn
+}
+
 type comparisonNode struct {
-	Genus      *parsed.Word
-	SpEpithet  *spEpithetNode
-	Comparison *parsed.Word
+	Genus        *parsed.Word
+	SpEpithet    *spEpithetNode
+	Infraspecies []*infraspEpithetNode
+	Comparison   *parsed.Word
+	// compPos is the number of epithets that precede the comparison
+	// marker.
+	compPos int
+	// appliesTo is the element of the name qualified by the marker.
+	appliesTo string
+	// rankFirst is true if the rank of the first infraspecific epithet
+	// precedes the marker, like in 'Aus bus var. cf. cus'.
+	rankFirst bool
 }
 
 func (p *Engine) newComparisonNode(n *node32) *comparisonNode {
@@ -544,25 +637,48 @@ func (p *Engine) newComparisonNode(n *node32) *comparisonNode {
 		return cn
 	}
 	n = n.up
-	var gen, comp *parsed.Word
-	var spEp *spEpithetNode
+	cn = &comparisonNode{}
+	var epithets int
+	var rank *rankNode
 	for n != nil {
 		switch n.pegRule {
 		case ruleGenusWord:
-			gen = p.newWordNode(n, parsed.GenusType)
-			p.cardinality = 1
-		case ruleComparison:
-			comp = p.newWordNode(n, parsed.ComparisonMarkerType)
+			cn.Genus = p.newWordNode(n, parsed.GenusType)
+		case ruleRank:
+			rank = p.newRankNode(n)
+		case ruleComparison, ruleComparisonGenus, ruleComparisonTrailing,
+			ruleComparisonSpInc:
+			cn.Comparison = p.newWordNode(n, parsed.ComparisonMarkerType)
+			cn.compPos = epithets
+			cn.appliesTo = "genus"
+			if n.pegRule == ruleComparisonTrailing && epithets == 0 {
+				cn.appliesTo = "species"
+			}
 		case ruleSpeciesEpithet:
-			spEp = p.newSpeciesEpithetNode(n)
-			p.cardinality = 2
+			cn.SpEpithet = p.newSpeciesEpithetNode(n)
+			epithets++
+		case ruleInfraspEpithet:
+			cn.Infraspecies = []*infraspEpithetNode{p.newInfraspEpithetNode(n)}
+			epithets++
+		case ruleInfraspGroup:
+			cn.Infraspecies = p.newInfraspeciesGroup(n)
+			epithets += len(cn.Infraspecies)
 		}
 		n = n.next
 	}
-	cn = &comparisonNode{
-		Genus:      gen,
-		Comparison: comp,
-		SpEpithet:  spEp,
+	if rank != nil && len(cn.Infraspecies) > 0 {
+		cn.Infraspecies[0].Rank = rank
+		cn.rankFirst = true
+	}
+	switch {
+	case cn.compPos == 1 && len(cn.Infraspecies) > 0:
+		cn.appliesTo = "infraspecies"
+	case cn.SpEpithet != nil:
+		cn.appliesTo = "species"
+	}
+	p.cardinality = 1
+	if cn.SpEpithet != nil {
+		p.cardinality = 2 + len(cn.Infraspecies)
 	}
 	return cn
 }
@@ -595,7 +711,7 @@ func (p *Engine) newSpeciesNode(n *node32) *speciesNode {
 		switch n.pegRule {
 		case ruleSubgenus:
 			w := p.newWordNode(n.up, parsed.SubgenusType)
-			if _, ok := dict.Dict.AuthorICN[w.Normalized]; ok {
+			if p.isICNAuthor(w.Normalized) {
 				p.addWarn(parsed.BotanyAuthorNotSubgenWarn)
 			} else {
 				sg = w
@@ -795,7 +911,7 @@ func (p *Engine) newUninomialComboNode(n *node32) *uninomialComboNode {
 			Normalized: "subgen.",
 			Type:       parsed.RankType,
 		}
-		r = &rankUninomialNode{Word: rw}
+		r = &rankUninomialNode{Word: rw, Implied: true}
 		u2 = &uninomialNode{
 			Word:       u2w,
 			Authorship: au2,
@@ -812,6 +928,10 @@ func (p *Engine) newUninomialComboNode(n *node32) *uninomialComboNode {
 
 type rankUninomialNode struct {
 	Word *parsed.Word
+	// Implied is true if the rank is absent from the name-string and
+	// is deduced from the structure of the name, like 'subgen.' in
+	// 'Aus (Bus)'.
+	Implied bool
 }
 
 func (p *Engine) newRankUninomialNode(n *node32) *rankUninomialNode {
@@ -918,7 +1038,10 @@ func (p *Engine) newAuthorsGroupNode(n *node32) *authorsGroupNode {
 	}
 	switch n.pegRule {
 	case ruleAuthorEx:
-		p.addWarn(parsed.AuthExWarn)
+		// ex authors are common and legitimate in botany and bacteriology.
+		if p.code != parsed.ICN && p.code != parsed.ICNP {
+			p.addWarn(parsed.AuthExWarn)
+		}
 		t2t = teamEx
 		t2wrd = p.newWordNode(n, parsed.AuthorWordType)
 		ex := strings.TrimSpace(t2wrd.Verbatim)
@@ -954,12 +1077,15 @@ type authorsTeamNode struct {
 	Authors        []*authorNode
 	TerminalFilius bool
 	Year           *yearNode
+	YearComma      bool
 }
 
 func (p *Engine) newAuthorTeam(n *node32) *authorsTeamNode {
 	var anodes []*node32
 	var seps []string
 	var yr *yearNode
+	var yrComma bool
+	var prevEnd uint32
 	n = n.up
 	for n != nil {
 		switch n.pegRule {
@@ -969,7 +1095,9 @@ func (p *Engine) newAuthorTeam(n *node32) *authorsTeamNode {
 			seps = append(seps, p.nodeValue(n))
 		case ruleYear:
 			yr = p.newYearNode(n)
+			yrComma = strings.ContainsRune(string(p.buffer[prevEnd:n.begin]), ',')
 		}
+		prevEnd = n.end
 		n = n.next
 	}
 	aus := make([]*authorNode, len(anodes))
@@ -991,6 +1119,7 @@ func (p *Engine) newAuthorTeam(n *node32) *authorsTeamNode {
 		Authors:        aus,
 		TerminalFilius: aus[len(aus)-1].Filius,
 		Year:           yr,
+		YearComma:      yrComma,
 	}
 	return &atn
 }
@@ -1000,21 +1129,28 @@ type authorNode struct {
 	Sep    string
 	Words  []*parsed.Word
 	Filius bool
+	Person parsed.AuthorPerson
 }
 
 func (p *Engine) newAuthorNode(n *node32) *authorNode {
 	var w *parsed.Word
 	var fil bool
 	var ws []*parsed.Word
+	var roles []personRole
 	val := ""
 	rawVal := ""
 	n = n.up
 	for n != nil {
+		role := personName
 		switch n.pegRule {
 		case ruleFilius, ruleFiliusFNoSpace:
 			w = p.newWordNode(n, parsed.AuthorWordFiliusType)
 			w.Normalized = "fil."
 			fil = true
+			role = personSuffix
+		case ruleAuthorSuffix:
+			w = p.authorWord(n)
+			role = personSuffix
 		case ruleUnknownAuthor:
 			p.addWarn(parsed.AuthUnknownWarn)
 			w = p.authorWord(n)
@@ -1027,10 +1163,15 @@ func (p *Engine) newAuthorNode(n *node32) *authorNode {
 			if strings.Contains(w.Normalized, "&") {
 				w.Normalized = "et al."
 			}
+			role = personEtAl
 		default:
 			w = p.authorWord(n)
+			if n.up != nil && n.up.pegRule == ruleAuthorPrefix {
+				role = personParticle
+			}
 		}
 		ws = append(ws, w)
+		roles = append(roles, role)
 		val = str.JoinStrings(val, w.Normalized, " ")
 		rawVal = str.JoinStrings(rawVal, w.Verbatim, " ")
 		n = n.next
@@ -1042,7 +1183,10 @@ func (p *Engine) newAuthorNode(n *node32) *authorNode {
 		Value:  val,
 		Words:  ws,
 		Filius: fil,
+		Person: p.newAuthorPerson(ws, roles),
 	}
+	p.checkICNAuthor(au.Person.Surname)
+	p.checkAuthorForm(au.Value)
 	return &au
 }
 
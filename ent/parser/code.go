@@ -0,0 +1,128 @@
+package parser
+
+import (
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// nomCode returns the code hint, if it was given, or infers the
+// nomenclatural code of a name from evidence collected during parsing.
+func (sn *scientificNameNode) nomCode() parsed.Code {
+	if sn.codeHint != parsed.UnknownCode {
+		return sn.codeHint
+	}
+	if sn.virus {
+		return parsed.ICVCN
+	}
+	if sn.nameData == nil {
+		return parsed.UnknownCode
+	}
+	return sn.inferCode()
+}
+
+// inferCode weighs botanical and zoological evidence against each other.
+// Cultivars, graft-chimeras and bacterial names are recognized
+// unambiguously.
+func (sn *scientificNameNode) inferCode() parsed.Code {
+	if sn.hasWarn(parsed.CandidatusName) {
+		return parsed.ICNP
+	}
+
+	words := sn.Words()
+	for i := range words {
+		switch words[i].Type {
+		case parsed.CultivarType, parsed.GraftChimeraCharType:
+			return parsed.ICNCP
+		}
+	}
+
+	var bac bool
+	if sn.bacteria != nil {
+		switch sn.bacteria.Int() {
+		case 1:
+			return parsed.ICNP
+		case 0:
+			bac = true
+		}
+	}
+
+	var bot, zoo int
+	if sn.hasWarn(parsed.BotanyAuthorNotSubgenWarn) {
+		bot++
+	}
+	if sn.hasWarn(parsed.AuthExWarn) {
+		bot++
+	}
+	if sn.hasWarn(parsed.AuthEmendWarn) {
+		if bac {
+			return parsed.ICNP
+		}
+		bot++
+	}
+	if sn.hasWarn(parsed.HybridNamedWarn) {
+		bot++
+	}
+
+	// a comparison marker might separate a rank from its epithet, like in
+	// 'Aus bus var. cf. cus'.
+	next := func(i int) int {
+		if i < len(words) && words[i].Type == parsed.ComparisonMarkerType {
+			return i + 1
+		}
+		return i
+	}
+	prev := func(i int) int {
+		if i >= 0 && words[i].Type == parsed.ComparisonMarkerType {
+			return i - 1
+		}
+		return i
+	}
+	for i, v := range words {
+		switch v.Type {
+		case parsed.SubgenusType:
+			zoo++
+		case parsed.RankType:
+			// ranks of uninomial combinations are used by both codes.
+			j := next(i + 1)
+			if j < len(words) && words[j].Type == parsed.InfraspEpithetType {
+				bot++
+			}
+		case parsed.InfraspEpithetType:
+			if j := prev(i - 1); j >= 0 && words[j].Type != parsed.RankType {
+				zoo++
+			}
+		}
+	}
+
+	if sn.icnAuthor {
+		bot++
+	}
+
+	// only zoological '(Author, year)' or 'Author, year' styles count,
+	// a year without a comma is common in botanical names as well. The
+	// style outweighs an author from the dictionary of ICN authors, because
+	// many of them named animals too.
+	if au := sn.lastAuthorship(); au != nil && au.OriginalAuthors != nil {
+		oa := au.OriginalAuthors
+		switch {
+		case oa.Parens && au.CombinationAuthors != nil:
+			bot++
+		case oa.Team1 != nil && oa.Team1.Year != nil &&
+			(oa.Parens || oa.Team1.YearComma):
+			zoo += 2
+		}
+	}
+
+	switch {
+	case bot > zoo:
+		return parsed.ICN
+	case zoo > bot:
+		return parsed.ICZN
+	default:
+		return parsed.UnknownCode
+	}
+}
+
+func (sn *scientificNameNode) hasWarn(w parsed.Warning) bool {
+	_, ok := sn.warnings[w]
+	return ok
+}
@@ -0,0 +1,86 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+var conceptProParteRe = regexp.MustCompile(
+	`(?i)[,\s]*\(?(pro parte|p\.\s?p\.)\)?\s*$`,
+)
+
+// conceptQualifiers are ordered, so that more specific qualifiers are
+// tried first.
+var conceptQualifiers = []struct {
+	re   *regexp.Regexp
+	qual parsed.ConceptQualifier
+}{
+	{regexp.MustCompile(`^(?i:sensu)\s+(?i:lato|latu|l\.)`), parsed.SensuLatoConcept},
+	{regexp.MustCompile(`^(s\.\s?l\.|s\.\s?lat\.)`), parsed.SensuLatoConcept},
+	{regexp.MustCompile(`^(?i:sensu)\s+(?i:stricto|str\.|s\.)`), parsed.SensuStrictoConcept},
+	{regexp.MustCompile(`^(s\.\s?s\.|s\.\s?str\.)`), parsed.SensuStrictoConcept},
+	{regexp.MustCompile(`^(?i:(sensu\s+)?(auctorum|auctt?)\.?)`), parsed.AuctorumConcept},
+	{regexp.MustCompile(`^(?i:(secundum|sec)\.?)`), parsed.SecundumConcept},
+	{regexp.MustCompile(`^(?i:sensu)\.?`), parsed.SensuConcept},
+}
+
+var conceptNonRe = regexp.MustCompile(`(?i)(^|[\s,(])(non|nec)\s+`)
+var conceptYearRe = regexp.MustCompile(`\b(1[5-9]\d\d|20\d\d)\b`)
+var conceptAuthorsSepRe = regexp.MustCompile(`\s*(,|;|&|\(|\)|\bet\b|\band\b)\s*`)
+
+// newTaxonConcept converts a verbatim taxon concept qualifier found by the
+// preprocessor into a TaxonConcept.
+func newTaxonConcept(verbatim string) *parsed.TaxonConcept {
+	res := &parsed.TaxonConcept{Verbatim: verbatim}
+	s := verbatim
+	if loc := conceptProParteRe.FindStringIndex(s); loc != nil {
+		res.ProParte = true
+		s = s[:loc[0]]
+	}
+	s = strings.TrimSpace(strings.Trim(s, "()"))
+	if s == "" {
+		res.Qualifier = parsed.ProParteConcept
+		return res
+	}
+
+	for _, v := range conceptQualifiers {
+		if loc := v.re.FindStringIndex(s); loc != nil {
+			res.Qualifier = v.qual
+			s = s[loc[1]:]
+			break
+		}
+	}
+	if res.Qualifier == parsed.AuctorumConcept {
+		res.Misapplied = true
+	}
+
+	authors, nonAuthors := s, ""
+	if loc := conceptNonRe.FindStringIndex(s); loc != nil {
+		res.Misapplied = true
+		authors, nonAuthors = s[:loc[0]], s[loc[1]:]
+	}
+	if yr := conceptYearRe.FindString(authors); yr != "" {
+		res.Year = yr
+	}
+	res.Authors = conceptAuthors(authors)
+	res.NonAuthors = conceptAuthors(nonAuthors)
+	return res
+}
+
+// conceptAuthors splits a list of authors of a concept, ignoring years
+// and "et al.".
+func conceptAuthors(s string) []string {
+	s = conceptYearRe.ReplaceAllString(s, "")
+	var res []string
+	for _, v := range conceptAuthorsSepRe.Split(s, -1) {
+		v = strings.Join(strings.Fields(v), " ")
+		v = strings.TrimSuffix(strings.Trim(v, " ()[]:,"), " .")
+		if v == "" || v == "." || v == "al." {
+			continue
+		}
+		res = append(res, v)
+	}
+	return res
+}
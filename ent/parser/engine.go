@@ -2,6 +2,7 @@ package parser
 
 import (
   "io"
+  "strings"
 
   "github.com/gnames/gnparser/ent/parsed"
   "github.com/gnames/gnparser/io/dict"
@@ -21,15 +22,32 @@ type baseEngine struct {
   tail            		string
   enableCultivars 		bool
   preserveDiaereses 	bool
+  code              	parsed.Code
+  authorshipOnly    	bool
+  icnAuthor         	bool
+  authorForm        	bool
+  dict              	*dict.Dictionary
 }
 
-// New creates implementation of Parser interface.
-func New() Parser {
+// New creates implementation of Parser interface. The dictionary d provides
+// bacterial genera, ICN authors and exceptions used during parsing. If d is
+// nil, the default dictionary is used.
+func New(d *dict.Dictionary) Parser {
   p := Engine{}
+  p.dict = d
   p.Init()
   return &p
 }
 
+// dictionary returns the dictionary of the Engine, or the default
+// dictionary, if the Engine was created without one.
+func (p *Engine) dictionary() *dict.Dictionary {
+  if p.dict == nil {
+    return dict.Dict
+  }
+  return p.dict
+}
+
 func (p *Engine) fullReset() {
   p.cardinality = 0
   p.error = nil
@@ -37,6 +55,8 @@ func (p *Engine) fullReset() {
   p.graftChimera = nil
   p.surrogate = nil
   p.bacteria = nil
+  p.icnAuthor = false
+  p.authorForm = false
   var warnReset map[parsed.Warning]struct{}
   p.warnings = warnReset
   p.tail = ""
@@ -52,8 +72,46 @@ func (p *Engine) addWarn(w parsed.Warning) {
   }
 }
 
+// isICNAuthor checks if a word in parentheses after a genus is an author
+// of a botanical name instead of a subgenus. The code hint takes precedence
+// over the dictionary of ICN authors.
+func (p *Engine) isICNAuthor(w string) bool {
+  switch p.code {
+  case parsed.ICZN:
+    return false
+  case parsed.ICN:
+    return true
+  }
+  _, ok := p.dictionary().AuthorICN[w]
+  return ok
+}
+
+// checkICNAuthor notes if an author's surname is in the dictionary of ICN
+// authors. Such authors are evidence of a botanical name. Surnames with
+// particles, like "Sousa da Câmara", are also checked by their last word.
+func (p *Engine) checkICNAuthor(surname string) {
+  au := p.dictionary().AuthorICN
+  if _, ok := au[surname]; ok {
+    p.icnAuthor = true
+    return
+  }
+  if ws := strings.Fields(surname); len(ws) > 1 {
+    if _, ok := au[ws[len(ws)-1]]; ok {
+      p.icnAuthor = true
+    }
+  }
+}
+
+// checkAuthorForm notes if an author is written in one of the standard
+// forms of botanical authors, like "Juss." or "R.Br.".
+func (p *Engine) checkAuthorForm(au string) {
+  if _, ok := p.dictionary().AuthorForms[strings.ReplaceAll(au, " ", "")]; ok {
+    p.authorForm = true
+  }
+}
+
 func (p *Engine) isBacteria(gen string) {
-  if hom, ok := dict.Dict.Bacteria[gen]; ok {
+  if hom, ok := p.dictionary().Bacteria[gen]; ok {
     if hom {
       p.addWarn(parsed.BacteriaMaybeWarn)
       bac := tribool.New(0)
@@ -188,6 +246,9 @@ var nodeRules = map[pegRule]struct{}{
   ruleCandidatusName:                  {},
   ruleCombinationAuthorship:           {},
   ruleComparison:                      {},
+  ruleComparisonGenus:                 {},
+  ruleComparisonSpInc:                 {},
+  ruleComparisonTrailing:              {},
   ruleCultivar:                        {},
   ruleCultivarRecursive:               {},
   ruleDotPrefix:                       {},
@@ -204,12 +265,18 @@ var nodeRules = map[pegRule]struct{}{
   ruleName:                            {},
   ruleNameApprox:                      {},
   ruleNameComp:                        {},
+  ruleNamePhrase:                      {},
   ruleNameSpecies:                     {},
   ruleNamedGenusGraftChimera:          {},
   ruleNamedGenusHybrid:                {},
   ruleNamedSpeciesHybrid:              {},
   ruleOriginalAuthorship:              {},
   ruleOriginalAuthorshipComb:          {},
+  rulePhraseCode:                      {},
+  rulePhraseRankInfrasp:               {},
+  rulePhraseRankSp:                    {},
+  rulePhraseValue:                     {},
+  rulePhraseWords:                     {},
   ruleRank:                            {},
   ruleRankCultivar:                    {},
   ruleRankForma:                       {},
@@ -228,6 +295,8 @@ var nodeRules = map[pegRule]struct{}{
   ruleUninomialWord:                   {},
   ruleUnknownAuthor:                   {},
   ruleUpperCharExtended:               {},
+  ruleVoucherCollector:                {},
+  ruleVoucherNumber:                   {},
   ruleWord:                            {},
   ruleWordApostr:                      {},
   ruleWordStartsWithDigit:             {},
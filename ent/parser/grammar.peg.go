@@ -20,6 +20,7 @@ const (
 	ruleUnknown pegRule = iota
 	ruleSciName
 	ruleTail
+	ruleAuthorshipOnly
 	ruleName
 	ruleHybridFormula
 	ruleHybridFormulaFull
@@ -35,6 +36,17 @@ const (
 	ruleCandidatus
 	ruleSingleName
 	ruleNameUninomial
+	ruleNamePhrase
+	rulePhraseRankSp
+	rulePhraseRankInfrasp
+	rulePhrase
+	rulePhraseValue
+	rulePhraseWords
+	rulePhraseCode
+	rulePhraseChar
+	ruleVoucher
+	ruleVoucherCollector
+	ruleVoucherNumber
 	ruleNameApprox
 	ruleNameComp
 	ruleNameSpecies
@@ -49,6 +61,9 @@ const (
 	ruleCultivarApostrophe
 	ruleSpeciesEpithet
 	ruleComparison
+	ruleComparisonGenus
+	ruleComparisonTrailing
+	ruleComparisonSpInc
 	ruleRank
 	ruleRankNotho
 	ruleRankOtherUncommon
@@ -174,6 +189,7 @@ var rul3s = [...]string{
 	"Unknown",
 	"SciName",
 	"Tail",
+	"AuthorshipOnly",
 	"Name",
 	"HybridFormula",
 	"HybridFormulaFull",
@@ -189,6 +205,17 @@ var rul3s = [...]string{
 	"Candidatus",
 	"SingleName",
 	"NameUninomial",
+	"NamePhrase",
+	"PhraseRankSp",
+	"PhraseRankInfrasp",
+	"Phrase",
+	"PhraseValue",
+	"PhraseWords",
+	"PhraseCode",
+	"PhraseChar",
+	"Voucher",
+	"VoucherCollector",
+	"VoucherNumber",
 	"NameApprox",
 	"NameComp",
 	"NameSpecies",
@@ -203,6 +230,9 @@ var rul3s = [...]string{
 	"CultivarApostrophe",
 	"SpeciesEpithet",
 	"Comparison",
+	"ComparisonGenus",
+	"ComparisonTrailing",
+	"ComparisonSpInc",
 	"Rank",
 	"RankNotho",
 	"RankOtherUncommon",
@@ -438,7 +468,7 @@ type Engine struct {
 
 	Buffer string
 	buffer []rune
-	rules  [151]func() bool
+	rules  [166]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -618,30 +648,43 @@ func (p *Engine) Init(options ...func(*Engine) error) error {
 
 	_rules = [...]func() bool{
 		nil,
-		/* 0 SciName <- <(_? Name Tail END)> */
+		/* 0 SciName <- <((&{ p.authorshipOnly } AuthorshipOnly) / (_? Name Tail END))> */
 		func() bool {
 			position0, tokenIndex0 := position, tokenIndex
 			{
 				position1 := position
 				{
 					position2, tokenIndex2 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l2
+					if !(p.authorshipOnly) {
+						goto l3
+					}
+					if !_rules[ruleAuthorshipOnly]() {
+						goto l3
 					}
-					goto l3
-				l2:
+					goto l2
+				l3:
 					position, tokenIndex = position2, tokenIndex2
+					{
+						position4, tokenIndex4 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l4
+						}
+						goto l5
+					l4:
+						position, tokenIndex = position4, tokenIndex4
+					}
+				l5:
+					if !_rules[ruleName]() {
+						goto l0
+					}
+					if !_rules[ruleTail]() {
+						goto l0
+					}
+					if !_rules[ruleEND]() {
+						goto l0
+					}
 				}
-			l3:
-				if !_rules[ruleName]() {
-					goto l0
-				}
-				if !_rules[ruleTail]() {
-					goto l0
-				}
-				if !_rules[ruleEND]() {
-					goto l0
-				}
+			l2:
 				add(ruleSciName, position1)
 			}
 			return true
@@ -652,9924 +695,10836 @@ func (p *Engine) Init(options ...func(*Engine) error) error {
 		/* 1 Tail <- <((_ / ';' / ',') .*)?> */
 		func() bool {
 			{
-				position5 := position
+				position7 := position
 				{
-					position6, tokenIndex6 := position, tokenIndex
+					position8, tokenIndex8 := position, tokenIndex
 					{
-						position8, tokenIndex8 := position, tokenIndex
+						position10, tokenIndex10 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l9
+							goto l11
 						}
-						goto l8
-					l9:
-						position, tokenIndex = position8, tokenIndex8
+						goto l10
+					l11:
+						position, tokenIndex = position10, tokenIndex10
 						if buffer[position] != rune(';') {
-							goto l10
+							goto l12
 						}
 						position++
-						goto l8
-					l10:
-						position, tokenIndex = position8, tokenIndex8
+						goto l10
+					l12:
+						position, tokenIndex = position10, tokenIndex10
 						if buffer[position] != rune(',') {
-							goto l6
+							goto l8
 						}
 						position++
 					}
-				l8:
-				l11:
+				l10:
+				l13:
 					{
-						position12, tokenIndex12 := position, tokenIndex
+						position14, tokenIndex14 := position, tokenIndex
 						if !matchDot() {
-							goto l12
+							goto l14
 						}
-						goto l11
-					l12:
-						position, tokenIndex = position12, tokenIndex12
+						goto l13
+					l14:
+						position, tokenIndex = position14, tokenIndex14
 					}
-					goto l7
-				l6:
-					position, tokenIndex = position6, tokenIndex6
+					goto l9
+				l8:
+					position, tokenIndex = position8, tokenIndex8
 				}
-			l7:
-				add(ruleTail, position5)
+			l9:
+				add(ruleTail, position7)
 			}
 			return true
 		},
-		/* 2 Name <- <(NamedGenusGraftChimera / GraftChimeraFormula / NamedHybrid / HybridFormula / CandidatusName / SingleName)> */
+		/* 2 AuthorshipOnly <- <(_? Authorship Tail END)> */
 		func() bool {
-			position13, tokenIndex13 := position, tokenIndex
+			position15, tokenIndex15 := position, tokenIndex
 			{
-				position14 := position
+				position16 := position
 				{
-					position15, tokenIndex15 := position, tokenIndex
-					if !_rules[ruleNamedGenusGraftChimera]() {
-						goto l16
+					position17, tokenIndex17 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l17
 					}
+					goto l18
+				l17:
+					position, tokenIndex = position17, tokenIndex17
+				}
+			l18:
+				if !_rules[ruleAuthorship]() {
+					goto l15
+				}
+				if !_rules[ruleTail]() {
+					goto l15
+				}
+				if !_rules[ruleEND]() {
 					goto l15
-				l16:
-					position, tokenIndex = position15, tokenIndex15
+				}
+				add(ruleAuthorshipOnly, position16)
+			}
+			return true
+		l15:
+			position, tokenIndex = position15, tokenIndex15
+			return false
+		},
+		/* 3 Name <- <(NamedGenusGraftChimera / GraftChimeraFormula / NamedHybrid / HybridFormula / CandidatusName / SingleName)> */
+		func() bool {
+			position19, tokenIndex19 := position, tokenIndex
+			{
+				position20 := position
+				{
+					position21, tokenIndex21 := position, tokenIndex
+					if !_rules[ruleNamedGenusGraftChimera]() {
+						goto l22
+					}
+					goto l21
+				l22:
+					position, tokenIndex = position21, tokenIndex21
 					if !_rules[ruleGraftChimeraFormula]() {
-						goto l17
+						goto l23
 					}
-					goto l15
-				l17:
-					position, tokenIndex = position15, tokenIndex15
+					goto l21
+				l23:
+					position, tokenIndex = position21, tokenIndex21
 					if !_rules[ruleNamedHybrid]() {
-						goto l18
+						goto l24
 					}
-					goto l15
-				l18:
-					position, tokenIndex = position15, tokenIndex15
+					goto l21
+				l24:
+					position, tokenIndex = position21, tokenIndex21
 					if !_rules[ruleHybridFormula]() {
-						goto l19
+						goto l25
 					}
-					goto l15
-				l19:
-					position, tokenIndex = position15, tokenIndex15
+					goto l21
+				l25:
+					position, tokenIndex = position21, tokenIndex21
 					if !_rules[ruleCandidatusName]() {
-						goto l20
+						goto l26
 					}
-					goto l15
-				l20:
-					position, tokenIndex = position15, tokenIndex15
+					goto l21
+				l26:
+					position, tokenIndex = position21, tokenIndex21
 					if !_rules[ruleSingleName]() {
-						goto l13
+						goto l19
 					}
 				}
-			l15:
-				add(ruleName, position14)
+			l21:
+				add(ruleName, position20)
 			}
 			return true
-		l13:
-			position, tokenIndex = position13, tokenIndex13
+		l19:
+			position, tokenIndex = position19, tokenIndex19
 			return false
 		},
-		/* 3 HybridFormula <- <(SingleName (_ (HybridFormulaPart / HybridFormulaFull))+)> */
+		/* 4 HybridFormula <- <(SingleName (_ (HybridFormulaPart / HybridFormulaFull))+)> */
 		func() bool {
-			position21, tokenIndex21 := position, tokenIndex
+			position27, tokenIndex27 := position, tokenIndex
 			{
-				position22 := position
+				position28 := position
 				if !_rules[ruleSingleName]() {
-					goto l21
+					goto l27
 				}
 				if !_rules[rule_]() {
-					goto l21
+					goto l27
 				}
 				{
-					position25, tokenIndex25 := position, tokenIndex
+					position31, tokenIndex31 := position, tokenIndex
 					if !_rules[ruleHybridFormulaPart]() {
-						goto l26
+						goto l32
 					}
-					goto l25
-				l26:
-					position, tokenIndex = position25, tokenIndex25
+					goto l31
+				l32:
+					position, tokenIndex = position31, tokenIndex31
 					if !_rules[ruleHybridFormulaFull]() {
-						goto l21
+						goto l27
 					}
 				}
-			l25:
-			l23:
+			l31:
+			l29:
 				{
-					position24, tokenIndex24 := position, tokenIndex
+					position30, tokenIndex30 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l24
+						goto l30
 					}
 					{
-						position27, tokenIndex27 := position, tokenIndex
+						position33, tokenIndex33 := position, tokenIndex
 						if !_rules[ruleHybridFormulaPart]() {
-							goto l28
+							goto l34
 						}
-						goto l27
-					l28:
-						position, tokenIndex = position27, tokenIndex27
+						goto l33
+					l34:
+						position, tokenIndex = position33, tokenIndex33
 						if !_rules[ruleHybridFormulaFull]() {
-							goto l24
+							goto l30
 						}
 					}
-				l27:
-					goto l23
-				l24:
-					position, tokenIndex = position24, tokenIndex24
+				l33:
+					goto l29
+				l30:
+					position, tokenIndex = position30, tokenIndex30
 				}
-				add(ruleHybridFormula, position22)
+				add(ruleHybridFormula, position28)
 			}
 			return true
-		l21:
-			position, tokenIndex = position21, tokenIndex21
+		l27:
+			position, tokenIndex = position27, tokenIndex27
 			return false
 		},
-		/* 4 HybridFormulaFull <- <(HybridChar (_ SingleName)?)> */
+		/* 5 HybridFormulaFull <- <(HybridChar (_ SingleName)?)> */
 		func() bool {
-			position29, tokenIndex29 := position, tokenIndex
+			position35, tokenIndex35 := position, tokenIndex
 			{
-				position30 := position
+				position36 := position
 				if !_rules[ruleHybridChar]() {
-					goto l29
+					goto l35
 				}
 				{
-					position31, tokenIndex31 := position, tokenIndex
+					position37, tokenIndex37 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l31
+						goto l37
 					}
 					if !_rules[ruleSingleName]() {
-						goto l31
+						goto l37
 					}
-					goto l32
-				l31:
-					position, tokenIndex = position31, tokenIndex31
+					goto l38
+				l37:
+					position, tokenIndex = position37, tokenIndex37
 				}
-			l32:
-				add(ruleHybridFormulaFull, position30)
+			l38:
+				add(ruleHybridFormulaFull, position36)
 			}
 			return true
-		l29:
-			position, tokenIndex = position29, tokenIndex29
+		l35:
+			position, tokenIndex = position35, tokenIndex35
 			return false
 		},
-		/* 5 HybridFormulaPart <- <(HybridChar _ SpeciesEpithet (_ InfraspGroup)?)> */
+		/* 6 HybridFormulaPart <- <(HybridChar _ SpeciesEpithet (_ InfraspGroup)?)> */
 		func() bool {
-			position33, tokenIndex33 := position, tokenIndex
+			position39, tokenIndex39 := position, tokenIndex
 			{
-				position34 := position
+				position40 := position
 				if !_rules[ruleHybridChar]() {
-					goto l33
+					goto l39
 				}
 				if !_rules[rule_]() {
-					goto l33
+					goto l39
 				}
 				if !_rules[ruleSpeciesEpithet]() {
-					goto l33
+					goto l39
 				}
 				{
-					position35, tokenIndex35 := position, tokenIndex
+					position41, tokenIndex41 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l35
+						goto l41
 					}
 					if !_rules[ruleInfraspGroup]() {
-						goto l35
+						goto l41
 					}
-					goto l36
-				l35:
-					position, tokenIndex = position35, tokenIndex35
+					goto l42
+				l41:
+					position, tokenIndex = position41, tokenIndex41
 				}
-			l36:
-				add(ruleHybridFormulaPart, position34)
+			l42:
+				add(ruleHybridFormulaPart, position40)
 			}
 			return true
-		l33:
-			position, tokenIndex = position33, tokenIndex33
+		l39:
+			position, tokenIndex = position39, tokenIndex39
 			return false
 		},
-		/* 6 NamedHybrid <- <(NamedGenusHybrid / NamedSpeciesHybrid)> */
+		/* 7 NamedHybrid <- <(NamedGenusHybrid / NamedSpeciesHybrid)> */
 		func() bool {
-			position37, tokenIndex37 := position, tokenIndex
+			position43, tokenIndex43 := position, tokenIndex
 			{
-				position38 := position
+				position44 := position
 				{
-					position39, tokenIndex39 := position, tokenIndex
+					position45, tokenIndex45 := position, tokenIndex
 					if !_rules[ruleNamedGenusHybrid]() {
-						goto l40
+						goto l46
 					}
-					goto l39
-				l40:
-					position, tokenIndex = position39, tokenIndex39
+					goto l45
+				l46:
+					position, tokenIndex = position45, tokenIndex45
 					if !_rules[ruleNamedSpeciesHybrid]() {
-						goto l37
+						goto l43
 					}
 				}
-			l39:
-				add(ruleNamedHybrid, position38)
+			l45:
+				add(ruleNamedHybrid, position44)
 			}
 			return true
-		l37:
-			position, tokenIndex = position37, tokenIndex37
+		l43:
+			position, tokenIndex = position43, tokenIndex43
 			return false
 		},
-		/* 7 NamedSpeciesHybrid <- <(GenusWord (_ Subgenus)? (_ Comparison)? _ HybridChar _? SpeciesEpithet (_ InfraspGroup)?)> */
+		/* 8 NamedSpeciesHybrid <- <(GenusWord (_ Subgenus)? (_ Comparison)? _ HybridChar _? SpeciesEpithet (_ InfraspGroup)?)> */
 		func() bool {
-			position41, tokenIndex41 := position, tokenIndex
+			position47, tokenIndex47 := position, tokenIndex
 			{
-				position42 := position
+				position48 := position
 				if !_rules[ruleGenusWord]() {
-					goto l41
+					goto l47
 				}
 				{
-					position43, tokenIndex43 := position, tokenIndex
+					position49, tokenIndex49 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l43
+						goto l49
 					}
 					if !_rules[ruleSubgenus]() {
-						goto l43
+						goto l49
 					}
-					goto l44
-				l43:
-					position, tokenIndex = position43, tokenIndex43
+					goto l50
+				l49:
+					position, tokenIndex = position49, tokenIndex49
 				}
-			l44:
+			l50:
 				{
-					position45, tokenIndex45 := position, tokenIndex
+					position51, tokenIndex51 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l45
+						goto l51
 					}
 					if !_rules[ruleComparison]() {
-						goto l45
+						goto l51
 					}
-					goto l46
-				l45:
-					position, tokenIndex = position45, tokenIndex45
+					goto l52
+				l51:
+					position, tokenIndex = position51, tokenIndex51
 				}
-			l46:
+			l52:
 				if !_rules[rule_]() {
-					goto l41
+					goto l47
 				}
 				if !_rules[ruleHybridChar]() {
-					goto l41
+					goto l47
 				}
 				{
-					position47, tokenIndex47 := position, tokenIndex
+					position53, tokenIndex53 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l47
+						goto l53
 					}
-					goto l48
-				l47:
-					position, tokenIndex = position47, tokenIndex47
+					goto l54
+				l53:
+					position, tokenIndex = position53, tokenIndex53
 				}
-			l48:
+			l54:
 				if !_rules[ruleSpeciesEpithet]() {
-					goto l41
+					goto l47
 				}
 				{
-					position49, tokenIndex49 := position, tokenIndex
+					position55, tokenIndex55 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l49
+						goto l55
 					}
 					if !_rules[ruleInfraspGroup]() {
-						goto l49
+						goto l55
 					}
-					goto l50
-				l49:
-					position, tokenIndex = position49, tokenIndex49
+					goto l56
+				l55:
+					position, tokenIndex = position55, tokenIndex55
 				}
-			l50:
-				add(ruleNamedSpeciesHybrid, position42)
+			l56:
+				add(ruleNamedSpeciesHybrid, position48)
 			}
 			return true
-		l41:
-			position, tokenIndex = position41, tokenIndex41
+		l47:
+			position, tokenIndex = position47, tokenIndex47
 			return false
 		},
-		/* 8 NamedGenusHybrid <- <(HybridChar _? SingleName)> */
+		/* 9 NamedGenusHybrid <- <(HybridChar _? SingleName)> */
 		func() bool {
-			position51, tokenIndex51 := position, tokenIndex
+			position57, tokenIndex57 := position, tokenIndex
 			{
-				position52 := position
+				position58 := position
 				if !_rules[ruleHybridChar]() {
-					goto l51
+					goto l57
 				}
 				{
-					position53, tokenIndex53 := position, tokenIndex
+					position59, tokenIndex59 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l53
+						goto l59
 					}
-					goto l54
-				l53:
-					position, tokenIndex = position53, tokenIndex53
+					goto l60
+				l59:
+					position, tokenIndex = position59, tokenIndex59
 				}
-			l54:
+			l60:
 				if !_rules[ruleSingleName]() {
-					goto l51
+					goto l57
 				}
-				add(ruleNamedGenusHybrid, position52)
+				add(ruleNamedGenusHybrid, position58)
 			}
 			return true
-		l51:
-			position, tokenIndex = position51, tokenIndex51
+		l57:
+			position, tokenIndex = position57, tokenIndex57
 			return false
 		},
-		/* 9 GraftChimeraFormula <- <(SingleName (_ (GraftChimeraFormulaPart / GraftChimeraFormulaFull))+)> */
+		/* 10 GraftChimeraFormula <- <(SingleName (_ (GraftChimeraFormulaPart / GraftChimeraFormulaFull))+)> */
 		func() bool {
-			position55, tokenIndex55 := position, tokenIndex
+			position61, tokenIndex61 := position, tokenIndex
 			{
-				position56 := position
+				position62 := position
 				if !_rules[ruleSingleName]() {
-					goto l55
+					goto l61
 				}
 				if !_rules[rule_]() {
-					goto l55
+					goto l61
 				}
 				{
-					position59, tokenIndex59 := position, tokenIndex
+					position65, tokenIndex65 := position, tokenIndex
 					if !_rules[ruleGraftChimeraFormulaPart]() {
-						goto l60
+						goto l66
 					}
-					goto l59
-				l60:
-					position, tokenIndex = position59, tokenIndex59
+					goto l65
+				l66:
+					position, tokenIndex = position65, tokenIndex65
 					if !_rules[ruleGraftChimeraFormulaFull]() {
-						goto l55
+						goto l61
 					}
 				}
-			l59:
-			l57:
+			l65:
+			l63:
 				{
-					position58, tokenIndex58 := position, tokenIndex
+					position64, tokenIndex64 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l58
+						goto l64
 					}
 					{
-						position61, tokenIndex61 := position, tokenIndex
+						position67, tokenIndex67 := position, tokenIndex
 						if !_rules[ruleGraftChimeraFormulaPart]() {
-							goto l62
+							goto l68
 						}
-						goto l61
-					l62:
-						position, tokenIndex = position61, tokenIndex61
+						goto l67
+					l68:
+						position, tokenIndex = position67, tokenIndex67
 						if !_rules[ruleGraftChimeraFormulaFull]() {
-							goto l58
+							goto l64
 						}
 					}
-				l61:
-					goto l57
-				l58:
-					position, tokenIndex = position58, tokenIndex58
+				l67:
+					goto l63
+				l64:
+					position, tokenIndex = position64, tokenIndex64
 				}
-				add(ruleGraftChimeraFormula, position56)
+				add(ruleGraftChimeraFormula, position62)
 			}
 			return true
-		l55:
-			position, tokenIndex = position55, tokenIndex55
+		l61:
+			position, tokenIndex = position61, tokenIndex61
 			return false
 		},
-		/* 10 GraftChimeraFormulaFull <- <(GraftChimeraChar (_ SingleName)?)> */
+		/* 11 GraftChimeraFormulaFull <- <(GraftChimeraChar (_ SingleName)?)> */
 		func() bool {
-			position63, tokenIndex63 := position, tokenIndex
+			position69, tokenIndex69 := position, tokenIndex
 			{
-				position64 := position
+				position70 := position
 				if !_rules[ruleGraftChimeraChar]() {
-					goto l63
+					goto l69
 				}
 				{
-					position65, tokenIndex65 := position, tokenIndex
+					position71, tokenIndex71 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l65
+						goto l71
 					}
 					if !_rules[ruleSingleName]() {
-						goto l65
+						goto l71
 					}
-					goto l66
-				l65:
-					position, tokenIndex = position65, tokenIndex65
+					goto l72
+				l71:
+					position, tokenIndex = position71, tokenIndex71
 				}
-			l66:
-				add(ruleGraftChimeraFormulaFull, position64)
+			l72:
+				add(ruleGraftChimeraFormulaFull, position70)
 			}
 			return true
-		l63:
-			position, tokenIndex = position63, tokenIndex63
+		l69:
+			position, tokenIndex = position69, tokenIndex69
 			return false
 		},
-		/* 11 GraftChimeraFormulaPart <- <(GraftChimeraChar _ SpeciesEpithet (_ InfraspGroup)?)> */
+		/* 12 GraftChimeraFormulaPart <- <(GraftChimeraChar _ SpeciesEpithet (_ InfraspGroup)?)> */
 		func() bool {
-			position67, tokenIndex67 := position, tokenIndex
+			position73, tokenIndex73 := position, tokenIndex
 			{
-				position68 := position
+				position74 := position
 				if !_rules[ruleGraftChimeraChar]() {
-					goto l67
+					goto l73
 				}
 				if !_rules[rule_]() {
-					goto l67
+					goto l73
 				}
 				if !_rules[ruleSpeciesEpithet]() {
-					goto l67
+					goto l73
 				}
 				{
-					position69, tokenIndex69 := position, tokenIndex
+					position75, tokenIndex75 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l69
+						goto l75
 					}
 					if !_rules[ruleInfraspGroup]() {
-						goto l69
+						goto l75
 					}
-					goto l70
-				l69:
-					position, tokenIndex = position69, tokenIndex69
+					goto l76
+				l75:
+					position, tokenIndex = position75, tokenIndex75
 				}
-			l70:
-				add(ruleGraftChimeraFormulaPart, position68)
+			l76:
+				add(ruleGraftChimeraFormulaPart, position74)
 			}
 			return true
-		l67:
-			position, tokenIndex = position67, tokenIndex67
+		l73:
+			position, tokenIndex = position73, tokenIndex73
 			return false
 		},
-		/* 12 NamedGenusGraftChimera <- <(GraftChimeraChar _? SingleName)> */
+		/* 13 NamedGenusGraftChimera <- <(GraftChimeraChar _? SingleName)> */
 		func() bool {
-			position71, tokenIndex71 := position, tokenIndex
+			position77, tokenIndex77 := position, tokenIndex
 			{
-				position72 := position
+				position78 := position
 				if !_rules[ruleGraftChimeraChar]() {
-					goto l71
+					goto l77
 				}
 				{
-					position73, tokenIndex73 := position, tokenIndex
+					position79, tokenIndex79 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l73
+						goto l79
 					}
-					goto l74
-				l73:
-					position, tokenIndex = position73, tokenIndex73
+					goto l80
+				l79:
+					position, tokenIndex = position79, tokenIndex79
 				}
-			l74:
+			l80:
 				if !_rules[ruleSingleName]() {
-					goto l71
+					goto l77
 				}
-				add(ruleNamedGenusGraftChimera, position72)
+				add(ruleNamedGenusGraftChimera, position78)
 			}
 			return true
-		l71:
-			position, tokenIndex = position71, tokenIndex71
+		l77:
+			position, tokenIndex = position77, tokenIndex77
 			return false
 		},
-		/* 13 CandidatusName <- <(Candidatus _ SingleName)> */
+		/* 14 CandidatusName <- <(Candidatus _ SingleName)> */
 		func() bool {
-			position75, tokenIndex75 := position, tokenIndex
+			position81, tokenIndex81 := position, tokenIndex
 			{
-				position76 := position
+				position82 := position
 				if !_rules[ruleCandidatus]() {
-					goto l75
+					goto l81
 				}
 				if !_rules[rule_]() {
-					goto l75
+					goto l81
 				}
 				if !_rules[ruleSingleName]() {
-					goto l75
+					goto l81
 				}
-				add(ruleCandidatusName, position76)
+				add(ruleCandidatusName, position82)
 			}
 			return true
-		l75:
-			position, tokenIndex = position75, tokenIndex75
+		l81:
+			position, tokenIndex = position81, tokenIndex81
 			return false
 		},
-		/* 14 Candidatus <- <('C' 'a' 'n' 'd' 'i' 'd' 'a' 't' 'u' 's')> */
+		/* 15 Candidatus <- <('C' 'a' 'n' 'd' 'i' 'd' 'a' 't' 'u' 's')> */
 		func() bool {
-			position77, tokenIndex77 := position, tokenIndex
+			position83, tokenIndex83 := position, tokenIndex
 			{
-				position78 := position
+				position84 := position
 				if buffer[position] != rune('C') {
-					goto l77
+					goto l83
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l77
+					goto l83
 				}
 				position++
 				if buffer[position] != rune('n') {
-					goto l77
+					goto l83
 				}
 				position++
 				if buffer[position] != rune('d') {
-					goto l77
+					goto l83
 				}
 				position++
 				if buffer[position] != rune('i') {
-					goto l77
+					goto l83
 				}
 				position++
 				if buffer[position] != rune('d') {
-					goto l77
+					goto l83
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l77
+					goto l83
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l77
+					goto l83
 				}
 				position++
 				if buffer[position] != rune('u') {
-					goto l77
+					goto l83
 				}
 				position++
 				if buffer[position] != rune('s') {
-					goto l77
+					goto l83
 				}
 				position++
-				add(ruleCandidatus, position78)
+				add(ruleCandidatus, position84)
 			}
 			return true
-		l77:
-			position, tokenIndex = position77, tokenIndex77
+		l83:
+			position, tokenIndex = position83, tokenIndex83
 			return false
 		},
-		/* 15 SingleName <- <(NameComp / NameApprox / NameSpecies / NameUninomial)> */
+		/* 16 SingleName <- <(NameComp / NamePhrase / NameApprox / NameSpecies / NameUninomial)> */
 		func() bool {
-			position79, tokenIndex79 := position, tokenIndex
+			position85, tokenIndex85 := position, tokenIndex
 			{
-				position80 := position
+				position86 := position
 				{
-					position81, tokenIndex81 := position, tokenIndex
+					position87, tokenIndex87 := position, tokenIndex
 					if !_rules[ruleNameComp]() {
-						goto l82
+						goto l88
 					}
-					goto l81
-				l82:
-					position, tokenIndex = position81, tokenIndex81
+					goto l87
+				l88:
+					position, tokenIndex = position87, tokenIndex87
+					if !_rules[ruleNamePhrase]() {
+						goto l89
+					}
+					goto l87
+				l89:
+					position, tokenIndex = position87, tokenIndex87
 					if !_rules[ruleNameApprox]() {
-						goto l83
+						goto l90
 					}
-					goto l81
-				l83:
-					position, tokenIndex = position81, tokenIndex81
+					goto l87
+				l90:
+					position, tokenIndex = position87, tokenIndex87
 					if !_rules[ruleNameSpecies]() {
-						goto l84
+						goto l91
 					}
-					goto l81
-				l84:
-					position, tokenIndex = position81, tokenIndex81
+					goto l87
+				l91:
+					position, tokenIndex = position87, tokenIndex87
 					if !_rules[ruleNameUninomial]() {
-						goto l79
+						goto l85
 					}
 				}
-			l81:
-				add(ruleSingleName, position80)
+			l87:
+				add(ruleSingleName, position86)
 			}
 			return true
-		l79:
-			position, tokenIndex = position79, tokenIndex79
+		l85:
+			position, tokenIndex = position85, tokenIndex85
 			return false
 		},
-		/* 16 NameUninomial <- <((UninomialCombo / Uninomial) (_ CultivarWordGroup)?)> */
+		/* 17 NameUninomial <- <((UninomialCombo / Uninomial) (_ CultivarWordGroup)?)> */
 		func() bool {
-			position85, tokenIndex85 := position, tokenIndex
+			position92, tokenIndex92 := position, tokenIndex
 			{
-				position86 := position
+				position93 := position
 				{
-					position87, tokenIndex87 := position, tokenIndex
+					position94, tokenIndex94 := position, tokenIndex
 					if !_rules[ruleUninomialCombo]() {
-						goto l88
+						goto l95
 					}
-					goto l87
-				l88:
-					position, tokenIndex = position87, tokenIndex87
+					goto l94
+				l95:
+					position, tokenIndex = position94, tokenIndex94
 					if !_rules[ruleUninomial]() {
-						goto l85
+						goto l92
 					}
 				}
-			l87:
+			l94:
 				{
-					position89, tokenIndex89 := position, tokenIndex
+					position96, tokenIndex96 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l89
+						goto l96
 					}
 					if !_rules[ruleCultivarWordGroup]() {
-						goto l89
+						goto l96
 					}
-					goto l90
-				l89:
-					position, tokenIndex = position89, tokenIndex89
+					goto l97
+				l96:
+					position, tokenIndex = position96, tokenIndex96
 				}
-			l90:
-				add(ruleNameUninomial, position86)
+			l97:
+				add(ruleNameUninomial, position93)
 			}
 			return true
-		l85:
-			position, tokenIndex = position85, tokenIndex85
+		l92:
+			position, tokenIndex = position92, tokenIndex92
 			return false
 		},
-		/* 17 NameApprox <- <(GenusWord (_ SpeciesEpithet)? _ Approximation ApproxNameIgnored)> */
+		/* 18 NamePhrase <- <(GenusWord _ ((SpeciesEpithet _ PhraseRankInfrasp) / PhraseRankSp) _ Phrase)> */
 		func() bool {
-			position91, tokenIndex91 := position, tokenIndex
+			position98, tokenIndex98 := position, tokenIndex
 			{
-				position92 := position
+				position99 := position
 				if !_rules[ruleGenusWord]() {
-					goto l91
+					goto l98
+				}
+				if !_rules[rule_]() {
+					goto l98
 				}
 				{
-					position93, tokenIndex93 := position, tokenIndex
+					position100, tokenIndex100 := position, tokenIndex
+					if !_rules[ruleSpeciesEpithet]() {
+						goto l101
+					}
 					if !_rules[rule_]() {
-						goto l93
+						goto l101
 					}
-					if !_rules[ruleSpeciesEpithet]() {
-						goto l93
+					if !_rules[rulePhraseRankInfrasp]() {
+						goto l101
+					}
+					goto l100
+				l101:
+					position, tokenIndex = position100, tokenIndex100
+					if !_rules[rulePhraseRankSp]() {
+						goto l98
 					}
-					goto l94
-				l93:
-					position, tokenIndex = position93, tokenIndex93
 				}
-			l94:
+			l100:
 				if !_rules[rule_]() {
-					goto l91
-				}
-				if !_rules[ruleApproximation]() {
-					goto l91
+					goto l98
 				}
-				if !_rules[ruleApproxNameIgnored]() {
-					goto l91
+				if !_rules[rulePhrase]() {
+					goto l98
 				}
-				add(ruleNameApprox, position92)
+				add(ruleNamePhrase, position99)
 			}
 			return true
-		l91:
-			position, tokenIndex = position91, tokenIndex91
+		l98:
+			position, tokenIndex = position98, tokenIndex98
 			return false
 		},
-		/* 18 NameComp <- <(GenusWord _ Comparison (_ SpeciesEpithet)?)> */
+		/* 19 PhraseRankSp <- <('s' 'p' '.')> */
 		func() bool {
-			position95, tokenIndex95 := position, tokenIndex
+			position102, tokenIndex102 := position, tokenIndex
 			{
-				position96 := position
-				if !_rules[ruleGenusWord]() {
-					goto l95
-				}
-				if !_rules[rule_]() {
-					goto l95
+				position103 := position
+				if buffer[position] != rune('s') {
+					goto l102
 				}
-				if !_rules[ruleComparison]() {
-					goto l95
+				position++
+				if buffer[position] != rune('p') {
+					goto l102
 				}
-				{
-					position97, tokenIndex97 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l97
-					}
-					if !_rules[ruleSpeciesEpithet]() {
-						goto l97
-					}
-					goto l98
-				l97:
-					position, tokenIndex = position97, tokenIndex97
+				position++
+				if buffer[position] != rune('.') {
+					goto l102
 				}
-			l98:
-				add(ruleNameComp, position96)
+				position++
+				add(rulePhraseRankSp, position103)
 			}
 			return true
-		l95:
-			position, tokenIndex = position95, tokenIndex95
+		l102:
+			position, tokenIndex = position102, tokenIndex102
 			return false
 		},
-		/* 19 NameSpecies <- <(GenusWord (_? (Subgenus / SubgenusOrSuperspecies))? _ SpeciesEpithet (_ InfraspGroup)? (_ CultivarWordGroup)?)> */
+		/* 20 PhraseRankInfrasp <- <(('s' 'u' 'b' 's' 'p' '.') / ('s' 's' 'p' '.') / ('v' 'a' 'r' '.'))> */
 		func() bool {
-			position99, tokenIndex99 := position, tokenIndex
+			position104, tokenIndex104 := position, tokenIndex
 			{
-				position100 := position
-				if !_rules[ruleGenusWord]() {
-					goto l99
-				}
-				{
-					position101, tokenIndex101 := position, tokenIndex
-					{
-						position103, tokenIndex103 := position, tokenIndex
-						if !_rules[rule_]() {
-							goto l103
-						}
-						goto l104
-					l103:
-						position, tokenIndex = position103, tokenIndex103
-					}
-				l104:
-					{
-						position105, tokenIndex105 := position, tokenIndex
-						if !_rules[ruleSubgenus]() {
-							goto l106
-						}
-						goto l105
-					l106:
-						position, tokenIndex = position105, tokenIndex105
-						if !_rules[ruleSubgenusOrSuperspecies]() {
-							goto l101
-						}
-					}
-				l105:
-					goto l102
-				l101:
-					position, tokenIndex = position101, tokenIndex101
-				}
-			l102:
-				if !_rules[rule_]() {
-					goto l99
-				}
-				if !_rules[ruleSpeciesEpithet]() {
-					goto l99
-				}
+				position105 := position
 				{
-					position107, tokenIndex107 := position, tokenIndex
-					if !_rules[rule_]() {
+					position106, tokenIndex106 := position, tokenIndex
+					if buffer[position] != rune('s') {
 						goto l107
 					}
-					if !_rules[ruleInfraspGroup]() {
+					position++
+					if buffer[position] != rune('u') {
 						goto l107
 					}
-					goto l108
-				l107:
-					position, tokenIndex = position107, tokenIndex107
+					position++
+					if buffer[position] != rune('b') {
+						goto l107
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l107
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l107
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l107
+					}
+					position++
+					goto l106
+				l107:
+					position, tokenIndex = position106, tokenIndex106
+					if buffer[position] != rune('s') {
+						goto l108
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l108
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l108
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l108
+					}
+					position++
+					goto l106
+				l108:
+					position, tokenIndex = position106, tokenIndex106
+					if buffer[position] != rune('v') {
+						goto l104
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l104
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l104
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l104
+					}
+					position++
+				}
+			l106:
+				add(rulePhraseRankInfrasp, position105)
+			}
+			return true
+		l104:
+			position, tokenIndex = position104, tokenIndex104
+			return false
+		},
+		/* 21 Phrase <- <((CultivarApostrophe PhraseValue CultivarApostrophe ((_ Voucher) / &END)) / (PhraseWords _ Voucher) / (PhraseCode &END))> */
+		func() bool {
+			position109, tokenIndex109 := position, tokenIndex
+			{
+				position110 := position
+				{
+					position111, tokenIndex111 := position, tokenIndex
+					if !_rules[ruleCultivarApostrophe]() {
+						goto l112
+					}
+					if !_rules[rulePhraseValue]() {
+						goto l112
+					}
+					if !_rules[ruleCultivarApostrophe]() {
+						goto l112
+					}
+					{
+						position113, tokenIndex113 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l114
+						}
+						if !_rules[ruleVoucher]() {
+							goto l114
+						}
+						goto l113
+					l114:
+						position, tokenIndex = position113, tokenIndex113
+						{
+							position115, tokenIndex115 := position, tokenIndex
+							if !_rules[ruleEND]() {
+								goto l112
+							}
+							position, tokenIndex = position115, tokenIndex115
+						}
+					}
+				l113:
+					goto l111
+				l112:
+					position, tokenIndex = position111, tokenIndex111
+					if !_rules[rulePhraseWords]() {
+						goto l116
+					}
+					if !_rules[rule_]() {
+						goto l116
+					}
+					if !_rules[ruleVoucher]() {
+						goto l116
+					}
+					goto l111
+				l116:
+					position, tokenIndex = position111, tokenIndex111
+					if !_rules[rulePhraseCode]() {
+						goto l109
+					}
+					{
+						position117, tokenIndex117 := position, tokenIndex
+						if !_rules[ruleEND]() {
+							goto l109
+						}
+						position, tokenIndex = position117, tokenIndex117
+					}
+				}
+			l111:
+				add(rulePhrase, position110)
+			}
+			return true
+		l109:
+			position, tokenIndex = position109, tokenIndex109
+			return false
+		},
+		/* 22 PhraseValue <- <(!CultivarApostrophe .)+> */
+		func() bool {
+			position118, tokenIndex118 := position, tokenIndex
+			{
+				position119 := position
+				{
+					position122, tokenIndex122 := position, tokenIndex
+					if !_rules[ruleCultivarApostrophe]() {
+						goto l122
+					}
+					goto l118
+				l122:
+					position, tokenIndex = position122, tokenIndex122
+				}
+				if !matchDot() {
+					goto l118
+				}
+			l120:
+				{
+					position121, tokenIndex121 := position, tokenIndex
+					{
+						position123, tokenIndex123 := position, tokenIndex
+						if !_rules[ruleCultivarApostrophe]() {
+							goto l123
+						}
+						goto l121
+					l123:
+						position, tokenIndex = position123, tokenIndex123
+					}
+					if !matchDot() {
+						goto l121
+					}
+					goto l120
+				l121:
+					position, tokenIndex = position121, tokenIndex121
+				}
+				add(rulePhraseValue, position119)
+			}
+			return true
+		l118:
+			position, tokenIndex = position118, tokenIndex118
+			return false
+		},
+		/* 23 PhraseWords <- <((UpperChar / Nums) PhraseChar* (_ PhraseChar+)*)> */
+		func() bool {
+			position124, tokenIndex124 := position, tokenIndex
+			{
+				position125 := position
+				{
+					position126, tokenIndex126 := position, tokenIndex
+					if !_rules[ruleUpperChar]() {
+						goto l127
+					}
+					goto l126
+				l127:
+					position, tokenIndex = position126, tokenIndex126
+					if !_rules[ruleNums]() {
+						goto l124
+					}
+				}
+			l126:
+			l128:
+				{
+					position129, tokenIndex129 := position, tokenIndex
+					if !_rules[rulePhraseChar]() {
+						goto l129
+					}
+					goto l128
+				l129:
+					position, tokenIndex = position129, tokenIndex129
+				}
+			l130:
+				{
+					position131, tokenIndex131 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l131
+					}
+					if !_rules[rulePhraseChar]() {
+						goto l131
+					}
+				l132:
+					{
+						position133, tokenIndex133 := position, tokenIndex
+						if !_rules[rulePhraseChar]() {
+							goto l133
+						}
+						goto l132
+					l133:
+						position, tokenIndex = position133, tokenIndex133
+					}
+					goto l130
+				l131:
+					position, tokenIndex = position131, tokenIndex131
+				}
+				add(rulePhraseWords, position125)
+			}
+			return true
+		l124:
+			position, tokenIndex = position124, tokenIndex124
+			return false
+		},
+		/* 24 PhraseCode <- <((UpperChar UpperChar? UpperChar?) / (Nums Nums? Nums?))> */
+		func() bool {
+			position134, tokenIndex134 := position, tokenIndex
+			{
+				position135 := position
+				{
+					position136, tokenIndex136 := position, tokenIndex
+					if !_rules[ruleUpperChar]() {
+						goto l137
+					}
+					{
+						position138, tokenIndex138 := position, tokenIndex
+						if !_rules[ruleUpperChar]() {
+							goto l138
+						}
+						goto l139
+					l138:
+						position, tokenIndex = position138, tokenIndex138
+					}
+				l139:
+					{
+						position140, tokenIndex140 := position, tokenIndex
+						if !_rules[ruleUpperChar]() {
+							goto l140
+						}
+						goto l141
+					l140:
+						position, tokenIndex = position140, tokenIndex140
+					}
+				l141:
+					goto l136
+				l137:
+					position, tokenIndex = position136, tokenIndex136
+					if !_rules[ruleNums]() {
+						goto l134
+					}
+					{
+						position142, tokenIndex142 := position, tokenIndex
+						if !_rules[ruleNums]() {
+							goto l142
+						}
+						goto l143
+					l142:
+						position, tokenIndex = position142, tokenIndex142
+					}
+				l143:
+					{
+						position144, tokenIndex144 := position, tokenIndex
+						if !_rules[ruleNums]() {
+							goto l144
+						}
+						goto l145
+					l144:
+						position, tokenIndex = position144, tokenIndex144
+					}
+				l145:
+				}
+			l136:
+				add(rulePhraseCode, position135)
+			}
+			return true
+		l134:
+			position, tokenIndex = position134, tokenIndex134
+			return false
+		},
+		/* 25 PhraseChar <- <(!(SingleSpace / '(' / ')') .)> */
+		func() bool {
+			position146, tokenIndex146 := position, tokenIndex
+			{
+				position147 := position
+				{
+					position148, tokenIndex148 := position, tokenIndex
+					{
+						position149, tokenIndex149 := position, tokenIndex
+						if !_rules[ruleSingleSpace]() {
+							goto l150
+						}
+						goto l149
+					l150:
+						position, tokenIndex = position149, tokenIndex149
+						if buffer[position] != rune('(') {
+							goto l151
+						}
+						position++
+						goto l149
+					l151:
+						position, tokenIndex = position149, tokenIndex149
+						if buffer[position] != rune(')') {
+							goto l148
+						}
+						position++
+					}
+				l149:
+					goto l146
+				l148:
+					position, tokenIndex = position148, tokenIndex148
+				}
+				if !matchDot() {
+					goto l146
+				}
+				add(rulePhraseChar, position147)
+			}
+			return true
+		l146:
+			position, tokenIndex = position146, tokenIndex146
+			return false
+		},
+		/* 26 Voucher <- <('(' VoucherCollector _ VoucherNumber ')')> */
+		func() bool {
+			position152, tokenIndex152 := position, tokenIndex
+			{
+				position153 := position
+				if buffer[position] != rune('(') {
+					goto l152
+				}
+				position++
+				if !_rules[ruleVoucherCollector]() {
+					goto l152
+				}
+				if !_rules[rule_]() {
+					goto l152
+				}
+				if !_rules[ruleVoucherNumber]() {
+					goto l152
+				}
+				if buffer[position] != rune(')') {
+					goto l152
+				}
+				position++
+				add(ruleVoucher, position153)
+			}
+			return true
+		l152:
+			position, tokenIndex = position152, tokenIndex152
+			return false
+		},
+		/* 27 VoucherCollector <- <(PhraseChar+ (_ !(VoucherNumber ')') PhraseChar+)*)> */
+		func() bool {
+			position154, tokenIndex154 := position, tokenIndex
+			{
+				position155 := position
+				if !_rules[rulePhraseChar]() {
+					goto l154
+				}
+			l156:
+				{
+					position157, tokenIndex157 := position, tokenIndex
+					if !_rules[rulePhraseChar]() {
+						goto l157
+					}
+					goto l156
+				l157:
+					position, tokenIndex = position157, tokenIndex157
+				}
+			l158:
+				{
+					position159, tokenIndex159 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l159
+					}
+					{
+						position160, tokenIndex160 := position, tokenIndex
+						if !_rules[ruleVoucherNumber]() {
+							goto l160
+						}
+						if buffer[position] != rune(')') {
+							goto l160
+						}
+						position++
+						goto l159
+					l160:
+						position, tokenIndex = position160, tokenIndex160
+					}
+					if !_rules[rulePhraseChar]() {
+						goto l159
+					}
+				l161:
+					{
+						position162, tokenIndex162 := position, tokenIndex
+						if !_rules[rulePhraseChar]() {
+							goto l162
+						}
+						goto l161
+					l162:
+						position, tokenIndex = position162, tokenIndex162
+					}
+					goto l158
+				l159:
+					position, tokenIndex = position159, tokenIndex159
+				}
+				add(ruleVoucherCollector, position155)
+			}
+			return true
+		l154:
+			position, tokenIndex = position154, tokenIndex154
+			return false
+		},
+		/* 28 VoucherNumber <- <(('s' '.' 'n' '.') / (UpperChar* Nums PhraseChar*))> */
+		func() bool {
+			position163, tokenIndex163 := position, tokenIndex
+			{
+				position164 := position
+				{
+					position165, tokenIndex165 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l166
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l166
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l166
+					}
+					position++
+					if buffer[position] != rune('.') {
+						goto l166
+					}
+					position++
+					goto l165
+				l166:
+					position, tokenIndex = position165, tokenIndex165
+				l167:
+					{
+						position168, tokenIndex168 := position, tokenIndex
+						if !_rules[ruleUpperChar]() {
+							goto l168
+						}
+						goto l167
+					l168:
+						position, tokenIndex = position168, tokenIndex168
+					}
+					if !_rules[ruleNums]() {
+						goto l163
+					}
+				l169:
+					{
+						position170, tokenIndex170 := position, tokenIndex
+						if !_rules[rulePhraseChar]() {
+							goto l170
+						}
+						goto l169
+					l170:
+						position, tokenIndex = position170, tokenIndex170
+					}
+				}
+			l165:
+				add(ruleVoucherNumber, position164)
+			}
+			return true
+		l163:
+			position, tokenIndex = position163, tokenIndex163
+			return false
+		},
+		/* 29 NameApprox <- <(GenusWord (_ SpeciesEpithet)? _ Approximation ApproxNameIgnored)> */
+		func() bool {
+			position171, tokenIndex171 := position, tokenIndex
+			{
+				position172 := position
+				if !_rules[ruleGenusWord]() {
+					goto l171
+				}
+				{
+					position173, tokenIndex173 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l173
+					}
+					if !_rules[ruleSpeciesEpithet]() {
+						goto l173
+					}
+					goto l174
+				l173:
+					position, tokenIndex = position173, tokenIndex173
+				}
+			l174:
+				if !_rules[rule_]() {
+					goto l171
+				}
+				if !_rules[ruleApproximation]() {
+					goto l171
+				}
+				if !_rules[ruleApproxNameIgnored]() {
+					goto l171
+				}
+				add(ruleNameApprox, position172)
+			}
+			return true
+		l171:
+			position, tokenIndex = position171, tokenIndex171
+			return false
+		},
+		/* 30 NameComp <- <(GenusWord _ ((SpeciesEpithet _ (Rank _?)? Comparison _ InfraspEpithet) / (SpeciesEpithet _ ComparisonTrailing) / ((Comparison / ComparisonSpInc) _ SpeciesEpithet (_ InfraspGroup)?) / ComparisonTrailing / ComparisonGenus))> */
+		func() bool {
+			position175, tokenIndex175 := position, tokenIndex
+			{
+				position176 := position
+				if !_rules[ruleGenusWord]() {
+					goto l175
+				}
+				if !_rules[rule_]() {
+					goto l175
+				}
+				{
+					position177, tokenIndex177 := position, tokenIndex
+					if !_rules[ruleSpeciesEpithet]() {
+						goto l178
+					}
+					if !_rules[rule_]() {
+						goto l178
+					}
+					{
+						position179, tokenIndex179 := position, tokenIndex
+						if !_rules[ruleRank]() {
+							goto l179
+						}
+						{
+							position181, tokenIndex181 := position, tokenIndex
+							if !_rules[rule_]() {
+								goto l181
+							}
+							goto l182
+						l181:
+							position, tokenIndex = position181, tokenIndex181
+						}
+					l182:
+						goto l180
+					l179:
+						position, tokenIndex = position179, tokenIndex179
+					}
+				l180:
+					if !_rules[ruleComparison]() {
+						goto l178
+					}
+					if !_rules[rule_]() {
+						goto l178
+					}
+					if !_rules[ruleInfraspEpithet]() {
+						goto l178
+					}
+					goto l177
+				l178:
+					position, tokenIndex = position177, tokenIndex177
+					if !_rules[ruleSpeciesEpithet]() {
+						goto l183
+					}
+					if !_rules[rule_]() {
+						goto l183
+					}
+					if !_rules[ruleComparisonTrailing]() {
+						goto l183
+					}
+					goto l177
+				l183:
+					position, tokenIndex = position177, tokenIndex177
+					{
+						position185, tokenIndex185 := position, tokenIndex
+						if !_rules[ruleComparison]() {
+							goto l186
+						}
+						goto l185
+					l186:
+						position, tokenIndex = position185, tokenIndex185
+						if !_rules[ruleComparisonSpInc]() {
+							goto l184
+						}
+					}
+				l185:
+					if !_rules[rule_]() {
+						goto l184
+					}
+					if !_rules[ruleSpeciesEpithet]() {
+						goto l184
+					}
+					{
+						position187, tokenIndex187 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l187
+						}
+						if !_rules[ruleInfraspGroup]() {
+							goto l187
+						}
+						goto l188
+					l187:
+						position, tokenIndex = position187, tokenIndex187
+					}
+				l188:
+					goto l177
+				l184:
+					position, tokenIndex = position177, tokenIndex177
+					if !_rules[ruleComparisonTrailing]() {
+						goto l189
+					}
+					goto l177
+				l189:
+					position, tokenIndex = position177, tokenIndex177
+					if !_rules[ruleComparisonGenus]() {
+						goto l175
+					}
+				}
+			l177:
+				add(ruleNameComp, position176)
+			}
+			return true
+		l175:
+			position, tokenIndex = position175, tokenIndex175
+			return false
+		},
+		/* 31 NameSpecies <- <(GenusWord (_? (Subgenus / SubgenusOrSuperspecies))? _ SpeciesEpithet (_ InfraspGroup)? (_ CultivarWordGroup)?)> */
+		func() bool {
+			position190, tokenIndex190 := position, tokenIndex
+			{
+				position191 := position
+				if !_rules[ruleGenusWord]() {
+					goto l190
+				}
+				{
+					position192, tokenIndex192 := position, tokenIndex
+					{
+						position194, tokenIndex194 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l194
+						}
+						goto l195
+					l194:
+						position, tokenIndex = position194, tokenIndex194
+					}
+				l195:
+					{
+						position196, tokenIndex196 := position, tokenIndex
+						if !_rules[ruleSubgenus]() {
+							goto l197
+						}
+						goto l196
+					l197:
+						position, tokenIndex = position196, tokenIndex196
+						if !_rules[ruleSubgenusOrSuperspecies]() {
+							goto l192
+						}
+					}
+				l196:
+					goto l193
+				l192:
+					position, tokenIndex = position192, tokenIndex192
+				}
+			l193:
+				if !_rules[rule_]() {
+					goto l190
+				}
+				if !_rules[ruleSpeciesEpithet]() {
+					goto l190
 				}
-			l108:
 				{
-					position109, tokenIndex109 := position, tokenIndex
+					position198, tokenIndex198 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l109
+						goto l198
+					}
+					if !_rules[ruleInfraspGroup]() {
+						goto l198
+					}
+					goto l199
+				l198:
+					position, tokenIndex = position198, tokenIndex198
+				}
+			l199:
+				{
+					position200, tokenIndex200 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l200
 					}
 					if !_rules[ruleCultivarWordGroup]() {
-						goto l109
+						goto l200
 					}
-					goto l110
-				l109:
-					position, tokenIndex = position109, tokenIndex109
+					goto l201
+				l200:
+					position, tokenIndex = position200, tokenIndex200
 				}
-			l110:
-				add(ruleNameSpecies, position100)
+			l201:
+				add(ruleNameSpecies, position191)
 			}
 			return true
-		l99:
-			position, tokenIndex = position99, tokenIndex99
+		l190:
+			position, tokenIndex = position190, tokenIndex190
 			return false
 		},
-		/* 20 GenusWord <- <((AbbrGenus / UninomialWord) !(_ AuthorWord))> */
+		/* 32 GenusWord <- <((AbbrGenus / UninomialWord) !(_ AuthorWord))> */
 		func() bool {
-			position111, tokenIndex111 := position, tokenIndex
+			position202, tokenIndex202 := position, tokenIndex
 			{
-				position112 := position
+				position203 := position
 				{
-					position113, tokenIndex113 := position, tokenIndex
+					position204, tokenIndex204 := position, tokenIndex
 					if !_rules[ruleAbbrGenus]() {
-						goto l114
+						goto l205
 					}
-					goto l113
-				l114:
-					position, tokenIndex = position113, tokenIndex113
+					goto l204
+				l205:
+					position, tokenIndex = position204, tokenIndex204
 					if !_rules[ruleUninomialWord]() {
-						goto l111
+						goto l202
 					}
 				}
-			l113:
+			l204:
 				{
-					position115, tokenIndex115 := position, tokenIndex
+					position206, tokenIndex206 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l115
+						goto l206
 					}
 					if !_rules[ruleAuthorWord]() {
-						goto l115
+						goto l206
 					}
-					goto l111
-				l115:
-					position, tokenIndex = position115, tokenIndex115
+					goto l202
+				l206:
+					position, tokenIndex = position206, tokenIndex206
 				}
-				add(ruleGenusWord, position112)
+				add(ruleGenusWord, position203)
 			}
 			return true
-		l111:
-			position, tokenIndex = position111, tokenIndex111
+		l202:
+			position, tokenIndex = position202, tokenIndex202
 			return false
 		},
-		/* 21 InfraspGroup <- <(InfraspEpithet (_ InfraspEpithet)? (_ InfraspEpithet)?)> */
+		/* 33 InfraspGroup <- <(InfraspEpithet (_ InfraspEpithet)? (_ InfraspEpithet)?)> */
 		func() bool {
-			position116, tokenIndex116 := position, tokenIndex
+			position207, tokenIndex207 := position, tokenIndex
 			{
-				position117 := position
+				position208 := position
 				if !_rules[ruleInfraspEpithet]() {
-					goto l116
+					goto l207
 				}
 				{
-					position118, tokenIndex118 := position, tokenIndex
+					position209, tokenIndex209 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l118
+						goto l209
 					}
 					if !_rules[ruleInfraspEpithet]() {
-						goto l118
+						goto l209
 					}
-					goto l119
-				l118:
-					position, tokenIndex = position118, tokenIndex118
+					goto l210
+				l209:
+					position, tokenIndex = position209, tokenIndex209
 				}
-			l119:
+			l210:
 				{
-					position120, tokenIndex120 := position, tokenIndex
+					position211, tokenIndex211 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l120
+						goto l211
 					}
 					if !_rules[ruleInfraspEpithet]() {
-						goto l120
+						goto l211
 					}
-					goto l121
-				l120:
-					position, tokenIndex = position120, tokenIndex120
+					goto l212
+				l211:
+					position, tokenIndex = position211, tokenIndex211
 				}
-			l121:
-				add(ruleInfraspGroup, position117)
+			l212:
+				add(ruleInfraspGroup, position208)
 			}
 			return true
-		l116:
-			position, tokenIndex = position116, tokenIndex116
+		l207:
+			position, tokenIndex = position207, tokenIndex207
 			return false
 		},
-		/* 22 InfraspEpithet <- <((Rank _?)? !AuthorEx Word (_? Authorship)?)> */
+		/* 34 InfraspEpithet <- <((Rank _?)? !AuthorEx Word (_? Authorship)?)> */
 		func() bool {
-			position122, tokenIndex122 := position, tokenIndex
+			position213, tokenIndex213 := position, tokenIndex
 			{
-				position123 := position
+				position214 := position
 				{
-					position124, tokenIndex124 := position, tokenIndex
+					position215, tokenIndex215 := position, tokenIndex
 					if !_rules[ruleRank]() {
-						goto l124
+						goto l215
 					}
 					{
-						position126, tokenIndex126 := position, tokenIndex
+						position217, tokenIndex217 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l126
+							goto l217
 						}
-						goto l127
-					l126:
-						position, tokenIndex = position126, tokenIndex126
+						goto l218
+					l217:
+						position, tokenIndex = position217, tokenIndex217
 					}
-				l127:
-					goto l125
-				l124:
-					position, tokenIndex = position124, tokenIndex124
+				l218:
+					goto l216
+				l215:
+					position, tokenIndex = position215, tokenIndex215
 				}
-			l125:
+			l216:
 				{
-					position128, tokenIndex128 := position, tokenIndex
+					position219, tokenIndex219 := position, tokenIndex
 					if !_rules[ruleAuthorEx]() {
-						goto l128
+						goto l219
 					}
-					goto l122
-				l128:
-					position, tokenIndex = position128, tokenIndex128
+					goto l213
+				l219:
+					position, tokenIndex = position219, tokenIndex219
 				}
 				if !_rules[ruleWord]() {
-					goto l122
+					goto l213
 				}
 				{
-					position129, tokenIndex129 := position, tokenIndex
+					position220, tokenIndex220 := position, tokenIndex
 					{
-						position131, tokenIndex131 := position, tokenIndex
+						position222, tokenIndex222 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l131
+							goto l222
 						}
-						goto l132
-					l131:
-						position, tokenIndex = position131, tokenIndex131
+						goto l223
+					l222:
+						position, tokenIndex = position222, tokenIndex222
 					}
-				l132:
+				l223:
 					if !_rules[ruleAuthorship]() {
-						goto l129
+						goto l220
 					}
-					goto l130
-				l129:
-					position, tokenIndex = position129, tokenIndex129
+					goto l221
+				l220:
+					position, tokenIndex = position220, tokenIndex220
 				}
-			l130:
-				add(ruleInfraspEpithet, position123)
+			l221:
+				add(ruleInfraspEpithet, position214)
 			}
 			return true
-		l122:
-			position, tokenIndex = position122, tokenIndex122
+		l213:
+			position, tokenIndex = position213, tokenIndex213
 			return false
 		},
-		/* 23 CultivarWordGroup <- <(((RankCultivar _)? CultivarApostrophe CultivarRecursive CultivarApostrophe) / (RankCultivar _ Cultivar))> */
+		/* 35 CultivarWordGroup <- <(((RankCultivar _)? CultivarApostrophe CultivarRecursive CultivarApostrophe) / (RankCultivar _ Cultivar))> */
 		func() bool {
-			position133, tokenIndex133 := position, tokenIndex
+			position224, tokenIndex224 := position, tokenIndex
 			{
-				position134 := position
+				position225 := position
 				{
-					position135, tokenIndex135 := position, tokenIndex
+					position226, tokenIndex226 := position, tokenIndex
 					{
-						position137, tokenIndex137 := position, tokenIndex
+						position228, tokenIndex228 := position, tokenIndex
 						if !_rules[ruleRankCultivar]() {
-							goto l137
+							goto l228
 						}
 						if !_rules[rule_]() {
-							goto l137
+							goto l228
 						}
-						goto l138
-					l137:
-						position, tokenIndex = position137, tokenIndex137
+						goto l229
+					l228:
+						position, tokenIndex = position228, tokenIndex228
 					}
-				l138:
+				l229:
 					if !_rules[ruleCultivarApostrophe]() {
-						goto l136
+						goto l227
 					}
 					if !_rules[ruleCultivarRecursive]() {
-						goto l136
+						goto l227
 					}
 					if !_rules[ruleCultivarApostrophe]() {
-						goto l136
+						goto l227
 					}
-					goto l135
-				l136:
-					position, tokenIndex = position135, tokenIndex135
+					goto l226
+				l227:
+					position, tokenIndex = position226, tokenIndex226
 					if !_rules[ruleRankCultivar]() {
-						goto l133
+						goto l224
 					}
 					if !_rules[rule_]() {
-						goto l133
+						goto l224
 					}
 					if !_rules[ruleCultivar]() {
-						goto l133
+						goto l224
 					}
 				}
-			l135:
-				add(ruleCultivarWordGroup, position134)
+			l226:
+				add(ruleCultivarWordGroup, position225)
 			}
 			return true
-		l133:
-			position, tokenIndex = position133, tokenIndex133
+		l224:
+			position, tokenIndex = position224, tokenIndex224
 			return false
 		},
-		/* 24 Cultivar <- <NotHybridChar+> */
+		/* 36 Cultivar <- <NotHybridChar+> */
 		func() bool {
-			position139, tokenIndex139 := position, tokenIndex
+			position230, tokenIndex230 := position, tokenIndex
 			{
-				position140 := position
+				position231 := position
 				if !_rules[ruleNotHybridChar]() {
-					goto l139
+					goto l230
 				}
-			l141:
+			l232:
 				{
-					position142, tokenIndex142 := position, tokenIndex
+					position233, tokenIndex233 := position, tokenIndex
 					if !_rules[ruleNotHybridChar]() {
-						goto l142
+						goto l233
 					}
-					goto l141
-				l142:
-					position, tokenIndex = position142, tokenIndex142
+					goto l232
+				l233:
+					position, tokenIndex = position233, tokenIndex233
 				}
-				add(ruleCultivar, position140)
+				add(ruleCultivar, position231)
 			}
 			return true
-		l139:
-			position, tokenIndex = position139, tokenIndex139
+		l230:
+			position, tokenIndex = position230, tokenIndex230
 			return false
 		},
-		/* 25 RankCultivar <- <('c' 'v' '.'?)> */
+		/* 37 RankCultivar <- <('c' 'v' '.'?)> */
 		func() bool {
-			position143, tokenIndex143 := position, tokenIndex
+			position234, tokenIndex234 := position, tokenIndex
 			{
-				position144 := position
+				position235 := position
 				if buffer[position] != rune('c') {
-					goto l143
+					goto l234
 				}
 				position++
 				if buffer[position] != rune('v') {
-					goto l143
+					goto l234
+				}
+				position++
+				{
+					position236, tokenIndex236 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l236
+					}
+					position++
+					goto l237
+				l236:
+					position, tokenIndex = position236, tokenIndex236
+				}
+			l237:
+				add(ruleRankCultivar, position235)
+			}
+			return true
+		l234:
+			position, tokenIndex = position234, tokenIndex234
+			return false
+		},
+		/* 38 NotHybridChar <- <(!(_ HybridChar) .)> */
+		func() bool {
+			position238, tokenIndex238 := position, tokenIndex
+			{
+				position239 := position
+				{
+					position240, tokenIndex240 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l240
+					}
+					if !_rules[ruleHybridChar]() {
+						goto l240
+					}
+					goto l238
+				l240:
+					position, tokenIndex = position240, tokenIndex240
+				}
+				if !matchDot() {
+					goto l238
+				}
+				add(ruleNotHybridChar, position239)
+			}
+			return true
+		l238:
+			position, tokenIndex = position238, tokenIndex238
+			return false
+		},
+		/* 39 CultivarRecursive <- <((NotHybridChar CultivarRecursive) / &CultivarApostrophe)> */
+		func() bool {
+			position241, tokenIndex241 := position, tokenIndex
+			{
+				position242 := position
+				{
+					position243, tokenIndex243 := position, tokenIndex
+					if !_rules[ruleNotHybridChar]() {
+						goto l244
+					}
+					if !_rules[ruleCultivarRecursive]() {
+						goto l244
+					}
+					goto l243
+				l244:
+					position, tokenIndex = position243, tokenIndex243
+					{
+						position245, tokenIndex245 := position, tokenIndex
+						if !_rules[ruleCultivarApostrophe]() {
+							goto l241
+						}
+						position, tokenIndex = position245, tokenIndex245
+					}
+				}
+			l243:
+				add(ruleCultivarRecursive, position242)
+			}
+			return true
+		l241:
+			position, tokenIndex = position241, tokenIndex241
+			return false
+		},
+		/* 40 CultivarApostrophe <- <('\'' / '‘' / '’' / '"' / '“' / '”')> */
+		func() bool {
+			position246, tokenIndex246 := position, tokenIndex
+			{
+				position247 := position
+				{
+					position248, tokenIndex248 := position, tokenIndex
+					if buffer[position] != rune('\'') {
+						goto l249
+					}
+					position++
+					goto l248
+				l249:
+					position, tokenIndex = position248, tokenIndex248
+					if buffer[position] != rune('‘') {
+						goto l250
+					}
+					position++
+					goto l248
+				l250:
+					position, tokenIndex = position248, tokenIndex248
+					if buffer[position] != rune('’') {
+						goto l251
+					}
+					position++
+					goto l248
+				l251:
+					position, tokenIndex = position248, tokenIndex248
+					if buffer[position] != rune('"') {
+						goto l252
+					}
+					position++
+					goto l248
+				l252:
+					position, tokenIndex = position248, tokenIndex248
+					if buffer[position] != rune('“') {
+						goto l253
+					}
+					position++
+					goto l248
+				l253:
+					position, tokenIndex = position248, tokenIndex248
+					if buffer[position] != rune('”') {
+						goto l246
+					}
+					position++
+				}
+			l248:
+				add(ruleCultivarApostrophe, position247)
+			}
+			return true
+		l246:
+			position, tokenIndex = position246, tokenIndex246
+			return false
+		},
+		/* 41 SpeciesEpithet <- <(!AuthorEx Word (_? Authorship)?)> */
+		func() bool {
+			position254, tokenIndex254 := position, tokenIndex
+			{
+				position255 := position
+				{
+					position256, tokenIndex256 := position, tokenIndex
+					if !_rules[ruleAuthorEx]() {
+						goto l256
+					}
+					goto l254
+				l256:
+					position, tokenIndex = position256, tokenIndex256
+				}
+				if !_rules[ruleWord]() {
+					goto l254
+				}
+				{
+					position257, tokenIndex257 := position, tokenIndex
+					{
+						position259, tokenIndex259 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l259
+						}
+						goto l260
+					l259:
+						position, tokenIndex = position259, tokenIndex259
+					}
+				l260:
+					if !_rules[ruleAuthorship]() {
+						goto l257
+					}
+					goto l258
+				l257:
+					position, tokenIndex = position257, tokenIndex257
+				}
+			l258:
+				add(ruleSpeciesEpithet, position255)
+			}
+			return true
+		l254:
+			position, tokenIndex = position254, tokenIndex254
+			return false
+		},
+		/* 42 Comparison <- <((((('c' 'f') / ('a' 'f' 'f') / ('n' 'r')) '.'?) / '?') &SpaceCharEOI)> */
+		func() bool {
+			position261, tokenIndex261 := position, tokenIndex
+			{
+				position262 := position
+				{
+					position263, tokenIndex263 := position, tokenIndex
+					{
+						position265, tokenIndex265 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l266
+						}
+						position++
+						if buffer[position] != rune('f') {
+							goto l266
+						}
+						position++
+						goto l265
+					l266:
+						position, tokenIndex = position265, tokenIndex265
+						if buffer[position] != rune('a') {
+							goto l267
+						}
+						position++
+						if buffer[position] != rune('f') {
+							goto l267
+						}
+						position++
+						if buffer[position] != rune('f') {
+							goto l267
+						}
+						position++
+						goto l265
+					l267:
+						position, tokenIndex = position265, tokenIndex265
+						if buffer[position] != rune('n') {
+							goto l264
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l264
+						}
+						position++
+					}
+				l265:
+					{
+						position268, tokenIndex268 := position, tokenIndex
+						if buffer[position] != rune('.') {
+							goto l268
+						}
+						position++
+						goto l269
+					l268:
+						position, tokenIndex = position268, tokenIndex268
+					}
+				l269:
+					goto l263
+				l264:
+					position, tokenIndex = position263, tokenIndex263
+					if buffer[position] != rune('?') {
+						goto l261
+					}
+					position++
+				}
+			l263:
+				{
+					position270, tokenIndex270 := position, tokenIndex
+					if !_rules[ruleSpaceCharEOI]() {
+						goto l261
+					}
+					position, tokenIndex = position270, tokenIndex270
+				}
+				add(ruleComparison, position262)
+			}
+			return true
+		l261:
+			position, tokenIndex = position261, tokenIndex261
+			return false
+		},
+		/* 43 ComparisonGenus <- <('c' 'f' '.'? &SpaceCharEOI)> */
+		func() bool {
+			position271, tokenIndex271 := position, tokenIndex
+			{
+				position272 := position
+				if buffer[position] != rune('c') {
+					goto l271
+				}
+				position++
+				if buffer[position] != rune('f') {
+					goto l271
 				}
 				position++
 				{
-					position145, tokenIndex145 := position, tokenIndex
+					position273, tokenIndex273 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l145
+						goto l273
 					}
 					position++
-					goto l146
-				l145:
-					position, tokenIndex = position145, tokenIndex145
+					goto l274
+				l273:
+					position, tokenIndex = position273, tokenIndex273
 				}
-			l146:
-				add(ruleRankCultivar, position144)
-			}
-			return true
-		l143:
-			position, tokenIndex = position143, tokenIndex143
-			return false
-		},
-		/* 26 NotHybridChar <- <(!(_ HybridChar) .)> */
-		func() bool {
-			position147, tokenIndex147 := position, tokenIndex
-			{
-				position148 := position
+			l274:
 				{
-					position149, tokenIndex149 := position, tokenIndex
-					if !_rules[rule_]() {
-						goto l149
-					}
-					if !_rules[ruleHybridChar]() {
-						goto l149
+					position275, tokenIndex275 := position, tokenIndex
+					if !_rules[ruleSpaceCharEOI]() {
+						goto l271
 					}
-					goto l147
-				l149:
-					position, tokenIndex = position149, tokenIndex149
-				}
-				if !matchDot() {
-					goto l147
+					position, tokenIndex = position275, tokenIndex275
 				}
-				add(ruleNotHybridChar, position148)
+				add(ruleComparisonGenus, position272)
 			}
 			return true
-		l147:
-			position, tokenIndex = position147, tokenIndex147
+		l271:
+			position, tokenIndex = position271, tokenIndex271
 			return false
 		},
-		/* 27 CultivarRecursive <- <((NotHybridChar CultivarRecursive) / &CultivarApostrophe)> */
+		/* 44 ComparisonTrailing <- <((ComparisonSpInc / ('v' 'e' 'l' '.'? _ ('s' 'p' '.') _? ('n' 'o' 'v' '.'))) &SpaceCharEOI)> */
 		func() bool {
-			position150, tokenIndex150 := position, tokenIndex
+			position276, tokenIndex276 := position, tokenIndex
 			{
-				position151 := position
+				position277 := position
 				{
-					position152, tokenIndex152 := position, tokenIndex
-					if !_rules[ruleNotHybridChar]() {
-						goto l153
+					position278, tokenIndex278 := position, tokenIndex
+					if !_rules[ruleComparisonSpInc]() {
+						goto l279
 					}
-					if !_rules[ruleCultivarRecursive]() {
-						goto l153
+					goto l278
+				l279:
+					position, tokenIndex = position278, tokenIndex278
+					if buffer[position] != rune('v') {
+						goto l276
 					}
-					goto l152
-				l153:
-					position, tokenIndex = position152, tokenIndex152
+					position++
+					if buffer[position] != rune('e') {
+						goto l276
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l276
+					}
+					position++
 					{
-						position154, tokenIndex154 := position, tokenIndex
-						if !_rules[ruleCultivarApostrophe]() {
-							goto l150
+						position280, tokenIndex280 := position, tokenIndex
+						if buffer[position] != rune('.') {
+							goto l280
 						}
-						position, tokenIndex = position154, tokenIndex154
+						position++
+						goto l281
+					l280:
+						position, tokenIndex = position280, tokenIndex280
 					}
-				}
-			l152:
-				add(ruleCultivarRecursive, position151)
-			}
-			return true
-		l150:
-			position, tokenIndex = position150, tokenIndex150
-			return false
-		},
-		/* 28 CultivarApostrophe <- <('\'' / '‘' / '’' / '"' / '“' / '”')> */
-		func() bool {
-			position155, tokenIndex155 := position, tokenIndex
-			{
-				position156 := position
-				{
-					position157, tokenIndex157 := position, tokenIndex
-					if buffer[position] != rune('\'') {
-						goto l158
+				l281:
+					if !_rules[rule_]() {
+						goto l276
+					}
+					if buffer[position] != rune('s') {
+						goto l276
 					}
 					position++
-					goto l157
-				l158:
-					position, tokenIndex = position157, tokenIndex157
-					if buffer[position] != rune('‘') {
-						goto l159
+					if buffer[position] != rune('p') {
+						goto l276
 					}
 					position++
-					goto l157
-				l159:
-					position, tokenIndex = position157, tokenIndex157
-					if buffer[position] != rune('’') {
-						goto l160
+					if buffer[position] != rune('.') {
+						goto l276
 					}
 					position++
-					goto l157
-				l160:
-					position, tokenIndex = position157, tokenIndex157
-					if buffer[position] != rune('"') {
-						goto l161
+					{
+						position282, tokenIndex282 := position, tokenIndex
+						if !_rules[rule_]() {
+							goto l282
+						}
+						goto l283
+					l282:
+						position, tokenIndex = position282, tokenIndex282
+					}
+				l283:
+					if buffer[position] != rune('n') {
+						goto l276
 					}
 					position++
-					goto l157
-				l161:
-					position, tokenIndex = position157, tokenIndex157
-					if buffer[position] != rune('“') {
-						goto l162
+					if buffer[position] != rune('o') {
+						goto l276
 					}
 					position++
-					goto l157
-				l162:
-					position, tokenIndex = position157, tokenIndex157
-					if buffer[position] != rune('”') {
-						goto l155
+					if buffer[position] != rune('v') {
+						goto l276
 					}
 					position++
-				}
-			l157:
-				add(ruleCultivarApostrophe, position156)
-			}
-			return true
-		l155:
-			position, tokenIndex = position155, tokenIndex155
-			return false
-		},
-		/* 29 SpeciesEpithet <- <(!AuthorEx Word (_? Authorship)?)> */
-		func() bool {
-			position163, tokenIndex163 := position, tokenIndex
-			{
-				position164 := position
-				{
-					position165, tokenIndex165 := position, tokenIndex
-					if !_rules[ruleAuthorEx]() {
-						goto l165
+					if buffer[position] != rune('.') {
+						goto l276
 					}
-					goto l163
-				l165:
-					position, tokenIndex = position165, tokenIndex165
-				}
-				if !_rules[ruleWord]() {
-					goto l163
+					position++
 				}
+			l278:
 				{
-					position166, tokenIndex166 := position, tokenIndex
-					{
-						position168, tokenIndex168 := position, tokenIndex
-						if !_rules[rule_]() {
-							goto l168
-						}
-						goto l169
-					l168:
-						position, tokenIndex = position168, tokenIndex168
-					}
-				l169:
-					if !_rules[ruleAuthorship]() {
-						goto l166
+					position284, tokenIndex284 := position, tokenIndex
+					if !_rules[ruleSpaceCharEOI]() {
+						goto l276
 					}
-					goto l167
-				l166:
-					position, tokenIndex = position166, tokenIndex166
+					position, tokenIndex = position284, tokenIndex284
 				}
-			l167:
-				add(ruleSpeciesEpithet, position164)
+				add(ruleComparisonTrailing, position277)
 			}
 			return true
-		l163:
-			position, tokenIndex = position163, tokenIndex163
+		l276:
+			position, tokenIndex = position276, tokenIndex276
 			return false
 		},
-		/* 30 Comparison <- <('c' 'f' '.'? &SpaceCharEOI)> */
+		/* 45 ComparisonSpInc <- <('s' 'p' '.' _? ('i' 'n' 'c' '.'))> */
 		func() bool {
-			position170, tokenIndex170 := position, tokenIndex
+			position285, tokenIndex285 := position, tokenIndex
 			{
-				position171 := position
-				if buffer[position] != rune('c') {
-					goto l170
+				position286 := position
+				if buffer[position] != rune('s') {
+					goto l285
 				}
 				position++
-				if buffer[position] != rune('f') {
-					goto l170
+				if buffer[position] != rune('p') {
+					goto l285
 				}
 				position++
-				{
-					position172, tokenIndex172 := position, tokenIndex
-					if buffer[position] != rune('.') {
-						goto l172
-					}
-					position++
-					goto l173
-				l172:
-					position, tokenIndex = position172, tokenIndex172
+				if buffer[position] != rune('.') {
+					goto l285
 				}
-			l173:
+				position++
 				{
-					position174, tokenIndex174 := position, tokenIndex
-					if !_rules[ruleSpaceCharEOI]() {
-						goto l170
+					position287, tokenIndex287 := position, tokenIndex
+					if !_rules[rule_]() {
+						goto l287
 					}
-					position, tokenIndex = position174, tokenIndex174
+					goto l288
+				l287:
+					position, tokenIndex = position287, tokenIndex287
+				}
+			l288:
+				if buffer[position] != rune('i') {
+					goto l285
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l285
+				}
+				position++
+				if buffer[position] != rune('c') {
+					goto l285
+				}
+				position++
+				if buffer[position] != rune('.') {
+					goto l285
 				}
-				add(ruleComparison, position171)
+				position++
+				add(ruleComparisonSpInc, position286)
 			}
 			return true
-		l170:
-			position, tokenIndex = position170, tokenIndex170
+		l285:
+			position, tokenIndex = position285, tokenIndex285
 			return false
 		},
-		/* 31 Rank <- <((RankForma / RankVar / RankSsp / RankOther / RankOtherUncommon / RankAgamo / RankNotho) (_? LowerGreek ('.' / &SpaceCharEOI))?)> */
+		/* 46 Rank <- <((RankForma / RankVar / RankSsp / RankOther / RankOtherUncommon / RankAgamo / RankNotho) (_? LowerGreek ('.' / &SpaceCharEOI))?)> */
 		func() bool {
-			position175, tokenIndex175 := position, tokenIndex
+			position289, tokenIndex289 := position, tokenIndex
 			{
-				position176 := position
+				position290 := position
 				{
-					position177, tokenIndex177 := position, tokenIndex
+					position291, tokenIndex291 := position, tokenIndex
 					if !_rules[ruleRankForma]() {
-						goto l178
+						goto l292
 					}
-					goto l177
-				l178:
-					position, tokenIndex = position177, tokenIndex177
+					goto l291
+				l292:
+					position, tokenIndex = position291, tokenIndex291
 					if !_rules[ruleRankVar]() {
-						goto l179
+						goto l293
 					}
-					goto l177
-				l179:
-					position, tokenIndex = position177, tokenIndex177
+					goto l291
+				l293:
+					position, tokenIndex = position291, tokenIndex291
 					if !_rules[ruleRankSsp]() {
-						goto l180
+						goto l294
 					}
-					goto l177
-				l180:
-					position, tokenIndex = position177, tokenIndex177
+					goto l291
+				l294:
+					position, tokenIndex = position291, tokenIndex291
 					if !_rules[ruleRankOther]() {
-						goto l181
+						goto l295
 					}
-					goto l177
-				l181:
-					position, tokenIndex = position177, tokenIndex177
+					goto l291
+				l295:
+					position, tokenIndex = position291, tokenIndex291
 					if !_rules[ruleRankOtherUncommon]() {
-						goto l182
+						goto l296
 					}
-					goto l177
-				l182:
-					position, tokenIndex = position177, tokenIndex177
+					goto l291
+				l296:
+					position, tokenIndex = position291, tokenIndex291
 					if !_rules[ruleRankAgamo]() {
-						goto l183
+						goto l297
 					}
-					goto l177
-				l183:
-					position, tokenIndex = position177, tokenIndex177
+					goto l291
+				l297:
+					position, tokenIndex = position291, tokenIndex291
 					if !_rules[ruleRankNotho]() {
-						goto l175
+						goto l289
 					}
 				}
-			l177:
+			l291:
 				{
-					position184, tokenIndex184 := position, tokenIndex
+					position298, tokenIndex298 := position, tokenIndex
 					{
-						position186, tokenIndex186 := position, tokenIndex
+						position300, tokenIndex300 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l186
+							goto l300
 						}
-						goto l187
-					l186:
-						position, tokenIndex = position186, tokenIndex186
+						goto l301
+					l300:
+						position, tokenIndex = position300, tokenIndex300
 					}
-				l187:
+				l301:
 					if !_rules[ruleLowerGreek]() {
-						goto l184
+						goto l298
 					}
 					{
-						position188, tokenIndex188 := position, tokenIndex
+						position302, tokenIndex302 := position, tokenIndex
 						if buffer[position] != rune('.') {
-							goto l189
+							goto l303
 						}
 						position++
-						goto l188
-					l189:
-						position, tokenIndex = position188, tokenIndex188
+						goto l302
+					l303:
+						position, tokenIndex = position302, tokenIndex302
 						{
-							position190, tokenIndex190 := position, tokenIndex
+							position304, tokenIndex304 := position, tokenIndex
 							if !_rules[ruleSpaceCharEOI]() {
-								goto l184
+								goto l298
 							}
-							position, tokenIndex = position190, tokenIndex190
+							position, tokenIndex = position304, tokenIndex304
 						}
 					}
-				l188:
-					goto l185
-				l184:
-					position, tokenIndex = position184, tokenIndex184
+				l302:
+					goto l299
+				l298:
+					position, tokenIndex = position298, tokenIndex298
 				}
-			l185:
-				add(ruleRank, position176)
+			l299:
+				add(ruleRank, position290)
 			}
 			return true
-		l175:
-			position, tokenIndex = position175, tokenIndex175
+		l289:
+			position, tokenIndex = position289, tokenIndex289
 			return false
 		},
-		/* 32 RankNotho <- <((('n' 'o' 't' 'h' 'o' (('v' 'a' 'r') / ('f' 'o') / 'f' / ('s' 'u' 'b' 's' 'p') / ('s' 's' 'p') / ('s' 'p') / ('m' 'o' 'r' 't' 'h') / ('s' 'u' 'p' 's' 'p') / ('s' 'u'))) / ('n' 'v' 'a' 'r')) ('.' / &SpaceCharEOI))> */
+		/* 47 RankNotho <- <((('n' 'o' 't' 'h' 'o' (('v' 'a' 'r') / ('f' 'o') / 'f' / ('s' 'u' 'b' 's' 'p') / ('s' 's' 'p') / ('s' 'p') / ('m' 'o' 'r' 't' 'h') / ('s' 'u' 'p' 's' 'p') / ('s' 'u'))) / ('n' 'v' 'a' 'r')) ('.' / &SpaceCharEOI))> */
 		func() bool {
-			position191, tokenIndex191 := position, tokenIndex
+			position305, tokenIndex305 := position, tokenIndex
 			{
-				position192 := position
+				position306 := position
 				{
-					position193, tokenIndex193 := position, tokenIndex
+					position307, tokenIndex307 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l194
+						goto l308
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l194
+						goto l308
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l194
+						goto l308
 					}
 					position++
 					if buffer[position] != rune('h') {
-						goto l194
+						goto l308
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l194
+						goto l308
 					}
 					position++
 					{
-						position195, tokenIndex195 := position, tokenIndex
+						position309, tokenIndex309 := position, tokenIndex
 						if buffer[position] != rune('v') {
-							goto l196
+							goto l310
 						}
 						position++
 						if buffer[position] != rune('a') {
-							goto l196
+							goto l310
 						}
 						position++
 						if buffer[position] != rune('r') {
-							goto l196
+							goto l310
 						}
 						position++
-						goto l195
-					l196:
-						position, tokenIndex = position195, tokenIndex195
+						goto l309
+					l310:
+						position, tokenIndex = position309, tokenIndex309
 						if buffer[position] != rune('f') {
-							goto l197
+							goto l311
 						}
 						position++
 						if buffer[position] != rune('o') {
-							goto l197
+							goto l311
 						}
 						position++
-						goto l195
-					l197:
-						position, tokenIndex = position195, tokenIndex195
+						goto l309
+					l311:
+						position, tokenIndex = position309, tokenIndex309
 						if buffer[position] != rune('f') {
-							goto l198
+							goto l312
 						}
 						position++
-						goto l195
-					l198:
-						position, tokenIndex = position195, tokenIndex195
+						goto l309
+					l312:
+						position, tokenIndex = position309, tokenIndex309
 						if buffer[position] != rune('s') {
-							goto l199
+							goto l313
 						}
 						position++
 						if buffer[position] != rune('u') {
-							goto l199
+							goto l313
 						}
 						position++
 						if buffer[position] != rune('b') {
-							goto l199
+							goto l313
 						}
 						position++
 						if buffer[position] != rune('s') {
-							goto l199
+							goto l313
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l199
+							goto l313
 						}
 						position++
-						goto l195
-					l199:
-						position, tokenIndex = position195, tokenIndex195
+						goto l309
+					l313:
+						position, tokenIndex = position309, tokenIndex309
 						if buffer[position] != rune('s') {
-							goto l200
+							goto l314
 						}
 						position++
 						if buffer[position] != rune('s') {
-							goto l200
+							goto l314
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l200
+							goto l314
 						}
 						position++
-						goto l195
-					l200:
-						position, tokenIndex = position195, tokenIndex195
+						goto l309
+					l314:
+						position, tokenIndex = position309, tokenIndex309
 						if buffer[position] != rune('s') {
-							goto l201
+							goto l315
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l201
+							goto l315
 						}
 						position++
-						goto l195
-					l201:
-						position, tokenIndex = position195, tokenIndex195
+						goto l309
+					l315:
+						position, tokenIndex = position309, tokenIndex309
 						if buffer[position] != rune('m') {
-							goto l202
+							goto l316
 						}
 						position++
 						if buffer[position] != rune('o') {
-							goto l202
+							goto l316
 						}
 						position++
 						if buffer[position] != rune('r') {
-							goto l202
+							goto l316
 						}
 						position++
 						if buffer[position] != rune('t') {
-							goto l202
+							goto l316
 						}
 						position++
 						if buffer[position] != rune('h') {
-							goto l202
+							goto l316
 						}
 						position++
-						goto l195
-					l202:
-						position, tokenIndex = position195, tokenIndex195
+						goto l309
+					l316:
+						position, tokenIndex = position309, tokenIndex309
 						if buffer[position] != rune('s') {
-							goto l203
+							goto l317
 						}
 						position++
 						if buffer[position] != rune('u') {
-							goto l203
+							goto l317
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l203
+							goto l317
 						}
 						position++
 						if buffer[position] != rune('s') {
-							goto l203
+							goto l317
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l203
+							goto l317
 						}
 						position++
-						goto l195
-					l203:
-						position, tokenIndex = position195, tokenIndex195
+						goto l309
+					l317:
+						position, tokenIndex = position309, tokenIndex309
 						if buffer[position] != rune('s') {
-							goto l194
+							goto l308
 						}
 						position++
 						if buffer[position] != rune('u') {
-							goto l194
+							goto l308
 						}
 						position++
 					}
-				l195:
-					goto l193
-				l194:
-					position, tokenIndex = position193, tokenIndex193
+				l309:
+					goto l307
+				l308:
+					position, tokenIndex = position307, tokenIndex307
 					if buffer[position] != rune('n') {
-						goto l191
+						goto l305
 					}
 					position++
 					if buffer[position] != rune('v') {
-						goto l191
+						goto l305
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l191
+						goto l305
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l191
+						goto l305
 					}
 					position++
 				}
-			l193:
+			l307:
 				{
-					position204, tokenIndex204 := position, tokenIndex
+					position318, tokenIndex318 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l205
+						goto l319
 					}
 					position++
-					goto l204
-				l205:
-					position, tokenIndex = position204, tokenIndex204
+					goto l318
+				l319:
+					position, tokenIndex = position318, tokenIndex318
 					{
-						position206, tokenIndex206 := position, tokenIndex
+						position320, tokenIndex320 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l191
+							goto l305
 						}
-						position, tokenIndex = position206, tokenIndex206
+						position, tokenIndex = position320, tokenIndex320
 					}
 				}
-			l204:
-				add(ruleRankNotho, position192)
+			l318:
+				add(ruleRankNotho, position306)
 			}
 			return true
-		l191:
-			position, tokenIndex = position191, tokenIndex191
+		l305:
+			position, tokenIndex = position305, tokenIndex305
 			return false
 		},
-		/* 33 RankOtherUncommon <- <(('*' / ('n' 'a' 't' 'i' 'o') / ('n' 'a' 't' '.') / ('n' 'a' 't') / ('f' '.' 's' 'p') / 'α' / ('β' 'β') / 'β' / 'γ' / 'δ' / 'ε' / 'φ' / 'θ' / 'μ' / ('a' '.') / ('b' '.') / ('c' '.') / ('d' '.') / ('e' '.') / ('g' '.') / ('k' '.') / ('m' 'u' 't' '.')) &SpaceCharEOI)> */
+		/* 48 RankOtherUncommon <- <(('*' / ('n' 'a' 't' 'i' 'o') / ('n' 'a' 't' '.') / ('n' 'a' 't') / ('f' '.' 's' 'p') / 'α' / ('β' 'β') / 'β' / 'γ' / 'δ' / 'ε' / 'φ' / 'θ' / 'μ' / ('a' '.') / ('b' '.') / ('c' '.') / ('d' '.') / ('e' '.') / ('g' '.') / ('k' '.') / ('m' 'u' 't' '.')) &SpaceCharEOI)> */
 		func() bool {
-			position207, tokenIndex207 := position, tokenIndex
+			position321, tokenIndex321 := position, tokenIndex
 			{
-				position208 := position
+				position322 := position
 				{
-					position209, tokenIndex209 := position, tokenIndex
+					position323, tokenIndex323 := position, tokenIndex
 					if buffer[position] != rune('*') {
-						goto l210
+						goto l324
 					}
 					position++
-					goto l209
-				l210:
-					position, tokenIndex = position209, tokenIndex209
+					goto l323
+				l324:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('n') {
-						goto l211
+						goto l325
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l211
+						goto l325
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l211
+						goto l325
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l211
+						goto l325
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l211
+						goto l325
 					}
 					position++
-					goto l209
-				l211:
-					position, tokenIndex = position209, tokenIndex209
+					goto l323
+				l325:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('n') {
-						goto l212
+						goto l326
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l212
+						goto l326
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l212
+						goto l326
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l212
+						goto l326
 					}
 					position++
-					goto l209
-				l212:
-					position, tokenIndex = position209, tokenIndex209
+					goto l323
+				l326:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('n') {
-						goto l213
+						goto l327
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l213
+						goto l327
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l213
+						goto l327
 					}
 					position++
-					goto l209
-				l213:
-					position, tokenIndex = position209, tokenIndex209
+					goto l323
+				l327:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('f') {
-						goto l214
+						goto l328
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l214
+						goto l328
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l214
+						goto l328
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l214
+						goto l328
 					}
 					position++
-					goto l209
-				l214:
-					position, tokenIndex = position209, tokenIndex209
+					goto l323
+				l328:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('α') {
-						goto l215
+						goto l329
 					}
 					position++
-					goto l209
-				l215:
-					position, tokenIndex = position209, tokenIndex209
+					goto l323
+				l329:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('β') {
-						goto l216
+						goto l330
 					}
 					position++
 					if buffer[position] != rune('β') {
-						goto l216
+						goto l330
 					}
 					position++
-					goto l209
-				l216:
-					position, tokenIndex = position209, tokenIndex209
+					goto l323
+				l330:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('β') {
-						goto l217
+						goto l331
 					}
 					position++
-					goto l209
-				l217:
-					position, tokenIndex = position209, tokenIndex209
+					goto l323
+				l331:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('γ') {
-						goto l218
+						goto l332
 					}
 					position++
-					goto l209
-				l218:
-					position, tokenIndex = position209, tokenIndex209
+					goto l323
+				l332:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('δ') {
-						goto l219
+						goto l333
 					}
 					position++
-					goto l209
-				l219:
-					position, tokenIndex = position209, tokenIndex209
+					goto l323
+				l333:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('ε') {
-						goto l220
+						goto l334
 					}
 					position++
-					goto l209
-				l220:
-					position, tokenIndex = position209, tokenIndex209
+					goto l323
+				l334:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('φ') {
-						goto l221
+						goto l335
 					}
 					position++
-					goto l209
-				l221:
-					position, tokenIndex = position209, tokenIndex209
+					goto l323
+				l335:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('θ') {
-						goto l222
+						goto l336
 					}
 					position++
-					goto l209
-				l222:
-					position, tokenIndex = position209, tokenIndex209
+					goto l323
+				l336:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('μ') {
-						goto l223
+						goto l337
 					}
 					position++
-					goto l209
-				l223:
-					position, tokenIndex = position209, tokenIndex209
+					goto l323
+				l337:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('a') {
-						goto l224
+						goto l338
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l224
+						goto l338
 					}
 					position++
-					goto l209
-				l224:
-					position, tokenIndex = position209, tokenIndex209
+					goto l323
+				l338:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('b') {
-						goto l225
+						goto l339
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l225
+						goto l339
 					}
 					position++
-					goto l209
-				l225:
-					position, tokenIndex = position209, tokenIndex209
+					goto l323
+				l339:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('c') {
-						goto l226
+						goto l340
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l226
+						goto l340
 					}
 					position++
-					goto l209
-				l226:
-					position, tokenIndex = position209, tokenIndex209
+					goto l323
+				l340:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('d') {
-						goto l227
+						goto l341
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l227
+						goto l341
 					}
 					position++
-					goto l209
-				l227:
-					position, tokenIndex = position209, tokenIndex209
+					goto l323
+				l341:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('e') {
-						goto l228
+						goto l342
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l228
+						goto l342
 					}
 					position++
-					goto l209
-				l228:
-					position, tokenIndex = position209, tokenIndex209
+					goto l323
+				l342:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('g') {
-						goto l229
+						goto l343
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l229
+						goto l343
 					}
 					position++
-					goto l209
-				l229:
-					position, tokenIndex = position209, tokenIndex209
+					goto l323
+				l343:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('k') {
-						goto l230
+						goto l344
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l230
+						goto l344
 					}
 					position++
-					goto l209
-				l230:
-					position, tokenIndex = position209, tokenIndex209
+					goto l323
+				l344:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('m') {
-						goto l207
+						goto l321
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l207
+						goto l321
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l207
+						goto l321
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l207
+						goto l321
 					}
 					position++
 				}
-			l209:
+			l323:
 				{
-					position231, tokenIndex231 := position, tokenIndex
+					position345, tokenIndex345 := position, tokenIndex
 					if !_rules[ruleSpaceCharEOI]() {
-						goto l207
+						goto l321
 					}
-					position, tokenIndex = position231, tokenIndex231
+					position, tokenIndex = position345, tokenIndex345
 				}
-				add(ruleRankOtherUncommon, position208)
+				add(ruleRankOtherUncommon, position322)
 			}
 			return true
-		l207:
-			position, tokenIndex = position207, tokenIndex207
+		l321:
+			position, tokenIndex = position321, tokenIndex321
 			return false
 		},
-		/* 34 RankOther <- <((('m' 'o' 'r' 'p' 'h') / ('c' 'o' 'n' 'v' 'a' 'r') / ('p' 's' 'e' 'u' 'd' 'o' 'v' 'a' 'r') / ('s' 'e' 'c' 't') / ('s' 'e' 'r') / ('s' 'u' 'b' 'v' 'a' 'r') / ('s' 'u' 'b' 'f') / ('r' 'a' 'c' 'e') / ('p' 'v') / ('p' 'a' 't' 'h' 'o' 'v' 'a' 'r') / ('a' 'b' '.' (_? ('n' '.'))?) / ('s' 't')) ('.' / &SpaceCharEOI))> */
+		/* 49 RankOther <- <((('m' 'o' 'r' 'p' 'h') / ('c' 'o' 'n' 'v' 'a' 'r') / ('p' 's' 'e' 'u' 'd' 'o' 'v' 'a' 'r') / ('s' 'e' 'c' 't') / ('s' 'e' 'r') / ('s' 'u' 'b' 'v' 'a' 'r') / ('s' 'u' 'b' 'f') / ('r' 'a' 'c' 'e') / ('p' 'v') / ('p' 'a' 't' 'h' 'o' 'v' 'a' 'r') / ('a' 'b' '.' (_? ('n' '.'))?) / ('s' 't')) ('.' / &SpaceCharEOI))> */
 		func() bool {
-			position232, tokenIndex232 := position, tokenIndex
+			position346, tokenIndex346 := position, tokenIndex
 			{
-				position233 := position
+				position347 := position
 				{
-					position234, tokenIndex234 := position, tokenIndex
+					position348, tokenIndex348 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l235
+						goto l349
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l235
+						goto l349
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l235
+						goto l349
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l235
+						goto l349
 					}
 					position++
 					if buffer[position] != rune('h') {
-						goto l235
+						goto l349
 					}
 					position++
-					goto l234
-				l235:
-					position, tokenIndex = position234, tokenIndex234
+					goto l348
+				l349:
+					position, tokenIndex = position348, tokenIndex348
 					if buffer[position] != rune('c') {
-						goto l236
+						goto l350
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l236
+						goto l350
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l236
+						goto l350
 					}
 					position++
 					if buffer[position] != rune('v') {
-						goto l236
+						goto l350
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l236
+						goto l350
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l236
+						goto l350
 					}
 					position++
-					goto l234
-				l236:
-					position, tokenIndex = position234, tokenIndex234
+					goto l348
+				l350:
+					position, tokenIndex = position348, tokenIndex348
 					if buffer[position] != rune('p') {
-						goto l237
+						goto l351
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l237
+						goto l351
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l237
+						goto l351
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l237
+						goto l351
 					}
 					position++
 					if buffer[position] != rune('d') {
-						goto l237
+						goto l351
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l237
+						goto l351
 					}
 					position++
 					if buffer[position] != rune('v') {
-						goto l237
+						goto l351
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l237
+						goto l351
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l237
+						goto l351
 					}
 					position++
-					goto l234
-				l237:
-					position, tokenIndex = position234, tokenIndex234
+					goto l348
+				l351:
+					position, tokenIndex = position348, tokenIndex348
 					if buffer[position] != rune('s') {
-						goto l238
+						goto l352
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l238
+						goto l352
 					}
 					position++
 					if buffer[position] != rune('c') {
-						goto l238
+						goto l352
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l238
+						goto l352
 					}
 					position++
-					goto l234
-				l238:
-					position, tokenIndex = position234, tokenIndex234
+					goto l348
+				l352:
+					position, tokenIndex = position348, tokenIndex348
 					if buffer[position] != rune('s') {
-						goto l239
+						goto l353
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l239
+						goto l353
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l239
+						goto l353
 					}
 					position++
-					goto l234
-				l239:
-					position, tokenIndex = position234, tokenIndex234
+					goto l348
+				l353:
+					position, tokenIndex = position348, tokenIndex348
 					if buffer[position] != rune('s') {
-						goto l240
+						goto l354
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l240
+						goto l354
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l240
+						goto l354
 					}
 					position++
 					if buffer[position] != rune('v') {
-						goto l240
+						goto l354
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l240
+						goto l354
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l240
+						goto l354
 					}
 					position++
-					goto l234
-				l240:
-					position, tokenIndex = position234, tokenIndex234
+					goto l348
+				l354:
+					position, tokenIndex = position348, tokenIndex348
 					if buffer[position] != rune('s') {
-						goto l241
+						goto l355
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l241
+						goto l355
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l241
+						goto l355
 					}
 					position++
 					if buffer[position] != rune('f') {
-						goto l241
+						goto l355
 					}
 					position++
-					goto l234
-				l241:
-					position, tokenIndex = position234, tokenIndex234
+					goto l348
+				l355:
+					position, tokenIndex = position348, tokenIndex348
 					if buffer[position] != rune('r') {
-						goto l242
+						goto l356
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l242
+						goto l356
 					}
 					position++
 					if buffer[position] != rune('c') {
-						goto l242
+						goto l356
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l242
+						goto l356
 					}
 					position++
-					goto l234
-				l242:
-					position, tokenIndex = position234, tokenIndex234
+					goto l348
+				l356:
+					position, tokenIndex = position348, tokenIndex348
 					if buffer[position] != rune('p') {
-						goto l243
+						goto l357
 					}
 					position++
 					if buffer[position] != rune('v') {
-						goto l243
+						goto l357
 					}
 					position++
-					goto l234
-				l243:
-					position, tokenIndex = position234, tokenIndex234
+					goto l348
+				l357:
+					position, tokenIndex = position348, tokenIndex348
 					if buffer[position] != rune('p') {
-						goto l244
+						goto l358
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l244
+						goto l358
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l244
+						goto l358
 					}
 					position++
 					if buffer[position] != rune('h') {
-						goto l244
+						goto l358
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l244
+						goto l358
 					}
 					position++
 					if buffer[position] != rune('v') {
-						goto l244
+						goto l358
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l244
+						goto l358
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l244
+						goto l358
 					}
 					position++
-					goto l234
-				l244:
-					position, tokenIndex = position234, tokenIndex234
+					goto l348
+				l358:
+					position, tokenIndex = position348, tokenIndex348
 					if buffer[position] != rune('a') {
-						goto l245
+						goto l359
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l245
+						goto l359
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l245
+						goto l359
 					}
 					position++
 					{
-						position246, tokenIndex246 := position, tokenIndex
+						position360, tokenIndex360 := position, tokenIndex
 						{
-							position248, tokenIndex248 := position, tokenIndex
+							position362, tokenIndex362 := position, tokenIndex
 							if !_rules[rule_]() {
-								goto l248
+								goto l362
 							}
-							goto l249
-						l248:
-							position, tokenIndex = position248, tokenIndex248
+							goto l363
+						l362:
+							position, tokenIndex = position362, tokenIndex362
 						}
-					l249:
+					l363:
 						if buffer[position] != rune('n') {
-							goto l246
+							goto l360
 						}
 						position++
 						if buffer[position] != rune('.') {
-							goto l246
+							goto l360
 						}
 						position++
-						goto l247
-					l246:
-						position, tokenIndex = position246, tokenIndex246
+						goto l361
+					l360:
+						position, tokenIndex = position360, tokenIndex360
 					}
-				l247:
-					goto l234
-				l245:
-					position, tokenIndex = position234, tokenIndex234
+				l361:
+					goto l348
+				l359:
+					position, tokenIndex = position348, tokenIndex348
 					if buffer[position] != rune('s') {
-						goto l232
+						goto l346
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l232
+						goto l346
 					}
 					position++
 				}
-			l234:
+			l348:
 				{
-					position250, tokenIndex250 := position, tokenIndex
+					position364, tokenIndex364 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l251
+						goto l365
 					}
 					position++
-					goto l250
-				l251:
-					position, tokenIndex = position250, tokenIndex250
+					goto l364
+				l365:
+					position, tokenIndex = position364, tokenIndex364
 					{
-						position252, tokenIndex252 := position, tokenIndex
+						position366, tokenIndex366 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l232
+							goto l346
 						}
-						position, tokenIndex = position252, tokenIndex252
+						position, tokenIndex = position366, tokenIndex366
 					}
 				}
-			l250:
-				add(ruleRankOther, position233)
+			l364:
+				add(ruleRankOther, position347)
 			}
 			return true
-		l232:
-			position, tokenIndex = position232, tokenIndex232
+		l346:
+			position, tokenIndex = position346, tokenIndex346
 			return false
 		},
-		/* 35 RankVar <- <((('v' 'a' 'r' 'i' 'e' 't' 'y') / ('[' 'v' 'a' 'r' '.' ']') / ('v' 'a' 'r')) ('.' / &SpaceCharEOI))> */
+		/* 50 RankVar <- <((('v' 'a' 'r' 'i' 'e' 't' 'y') / ('[' 'v' 'a' 'r' '.' ']') / ('v' 'a' 'r')) ('.' / &SpaceCharEOI))> */
 		func() bool {
-			position253, tokenIndex253 := position, tokenIndex
+			position367, tokenIndex367 := position, tokenIndex
 			{
-				position254 := position
+				position368 := position
 				{
-					position255, tokenIndex255 := position, tokenIndex
+					position369, tokenIndex369 := position, tokenIndex
 					if buffer[position] != rune('v') {
-						goto l256
+						goto l370
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l256
+						goto l370
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l256
+						goto l370
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l256
+						goto l370
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l256
+						goto l370
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l256
+						goto l370
 					}
 					position++
 					if buffer[position] != rune('y') {
-						goto l256
+						goto l370
 					}
 					position++
-					goto l255
-				l256:
-					position, tokenIndex = position255, tokenIndex255
+					goto l369
+				l370:
+					position, tokenIndex = position369, tokenIndex369
 					if buffer[position] != rune('[') {
-						goto l257
+						goto l371
 					}
 					position++
 					if buffer[position] != rune('v') {
-						goto l257
+						goto l371
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l257
+						goto l371
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l257
+						goto l371
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l257
+						goto l371
 					}
 					position++
 					if buffer[position] != rune(']') {
-						goto l257
+						goto l371
 					}
 					position++
-					goto l255
-				l257:
-					position, tokenIndex = position255, tokenIndex255
+					goto l369
+				l371:
+					position, tokenIndex = position369, tokenIndex369
 					if buffer[position] != rune('v') {
-						goto l253
+						goto l367
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l253
+						goto l367
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l253
+						goto l367
 					}
 					position++
 				}
-			l255:
+			l369:
 				{
-					position258, tokenIndex258 := position, tokenIndex
+					position372, tokenIndex372 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l259
+						goto l373
 					}
 					position++
-					goto l258
-				l259:
-					position, tokenIndex = position258, tokenIndex258
+					goto l372
+				l373:
+					position, tokenIndex = position372, tokenIndex372
 					{
-						position260, tokenIndex260 := position, tokenIndex
+						position374, tokenIndex374 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l253
+							goto l367
 						}
-						position, tokenIndex = position260, tokenIndex260
+						position, tokenIndex = position374, tokenIndex374
 					}
 				}
-			l258:
-				add(ruleRankVar, position254)
+			l372:
+				add(ruleRankVar, position368)
 			}
 			return true
-		l253:
-			position, tokenIndex = position253, tokenIndex253
+		l367:
+			position, tokenIndex = position367, tokenIndex367
 			return false
 		},
-		/* 36 RankForma <- <((('f' 'o' 'r' 'm' 'a') / ('f' 'm' 'a') / ('f' 'm') / ('f' 'o' 'r' 'm') / ('f' 'o') / 'f') ('.' / &SpaceCharEOI))> */
+		/* 51 RankForma <- <((('f' 'o' 'r' 'm' 'a') / ('f' 'm' 'a') / ('f' 'm') / ('f' 'o' 'r' 'm') / ('f' 'o') / 'f') ('.' / &SpaceCharEOI))> */
 		func() bool {
-			position261, tokenIndex261 := position, tokenIndex
+			position375, tokenIndex375 := position, tokenIndex
 			{
-				position262 := position
+				position376 := position
 				{
-					position263, tokenIndex263 := position, tokenIndex
+					position377, tokenIndex377 := position, tokenIndex
 					if buffer[position] != rune('f') {
-						goto l264
+						goto l378
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l264
+						goto l378
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l264
+						goto l378
 					}
 					position++
 					if buffer[position] != rune('m') {
-						goto l264
+						goto l378
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l264
+						goto l378
 					}
 					position++
-					goto l263
-				l264:
-					position, tokenIndex = position263, tokenIndex263
+					goto l377
+				l378:
+					position, tokenIndex = position377, tokenIndex377
 					if buffer[position] != rune('f') {
-						goto l265
+						goto l379
 					}
 					position++
 					if buffer[position] != rune('m') {
-						goto l265
+						goto l379
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l265
+						goto l379
 					}
 					position++
-					goto l263
-				l265:
-					position, tokenIndex = position263, tokenIndex263
+					goto l377
+				l379:
+					position, tokenIndex = position377, tokenIndex377
 					if buffer[position] != rune('f') {
-						goto l266
+						goto l380
 					}
 					position++
 					if buffer[position] != rune('m') {
-						goto l266
+						goto l380
 					}
 					position++
-					goto l263
-				l266:
-					position, tokenIndex = position263, tokenIndex263
+					goto l377
+				l380:
+					position, tokenIndex = position377, tokenIndex377
 					if buffer[position] != rune('f') {
-						goto l267
+						goto l381
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l267
+						goto l381
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l267
+						goto l381
 					}
 					position++
 					if buffer[position] != rune('m') {
-						goto l267
+						goto l381
 					}
 					position++
-					goto l263
-				l267:
-					position, tokenIndex = position263, tokenIndex263
+					goto l377
+				l381:
+					position, tokenIndex = position377, tokenIndex377
 					if buffer[position] != rune('f') {
-						goto l268
+						goto l382
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l268
+						goto l382
 					}
 					position++
-					goto l263
-				l268:
-					position, tokenIndex = position263, tokenIndex263
+					goto l377
+				l382:
+					position, tokenIndex = position377, tokenIndex377
 					if buffer[position] != rune('f') {
-						goto l261
+						goto l375
 					}
 					position++
 				}
-			l263:
+			l377:
 				{
-					position269, tokenIndex269 := position, tokenIndex
+					position383, tokenIndex383 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l270
+						goto l384
 					}
 					position++
-					goto l269
-				l270:
-					position, tokenIndex = position269, tokenIndex269
+					goto l383
+				l384:
+					position, tokenIndex = position383, tokenIndex383
 					{
-						position271, tokenIndex271 := position, tokenIndex
+						position385, tokenIndex385 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l261
+							goto l375
 						}
-						position, tokenIndex = position271, tokenIndex271
+						position, tokenIndex = position385, tokenIndex385
 					}
 				}
-			l269:
-				add(ruleRankForma, position262)
+			l383:
+				add(ruleRankForma, position376)
 			}
 			return true
-		l261:
-			position, tokenIndex = position261, tokenIndex261
+		l375:
+			position, tokenIndex = position375, tokenIndex375
 			return false
 		},
-		/* 37 RankSsp <- <((('s' 's' 'p') / ('s' 'u' 'b' 's' 'p' 'e' 'c') / ('s' 'u' 'b' 's' 'p')) ('.' / &SpaceCharEOI))> */
+		/* 52 RankSsp <- <((('s' 's' 'p') / ('s' 'u' 'b' 's' 'p' 'e' 'c') / ('s' 'u' 'b' 's' 'p')) ('.' / &SpaceCharEOI))> */
 		func() bool {
-			position272, tokenIndex272 := position, tokenIndex
+			position386, tokenIndex386 := position, tokenIndex
 			{
-				position273 := position
+				position387 := position
 				{
-					position274, tokenIndex274 := position, tokenIndex
+					position388, tokenIndex388 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l275
+						goto l389
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l275
+						goto l389
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l275
+						goto l389
 					}
 					position++
-					goto l274
-				l275:
-					position, tokenIndex = position274, tokenIndex274
+					goto l388
+				l389:
+					position, tokenIndex = position388, tokenIndex388
 					if buffer[position] != rune('s') {
-						goto l276
+						goto l390
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l276
+						goto l390
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l276
+						goto l390
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l276
+						goto l390
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l276
+						goto l390
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l276
+						goto l390
 					}
 					position++
 					if buffer[position] != rune('c') {
-						goto l276
+						goto l390
 					}
 					position++
-					goto l274
-				l276:
-					position, tokenIndex = position274, tokenIndex274
+					goto l388
+				l390:
+					position, tokenIndex = position388, tokenIndex388
 					if buffer[position] != rune('s') {
-						goto l272
+						goto l386
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l272
+						goto l386
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l272
+						goto l386
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l272
+						goto l386
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l272
+						goto l386
 					}
 					position++
 				}
-			l274:
+			l388:
 				{
-					position277, tokenIndex277 := position, tokenIndex
+					position391, tokenIndex391 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l278
+						goto l392
 					}
 					position++
-					goto l277
-				l278:
-					position, tokenIndex = position277, tokenIndex277
+					goto l391
+				l392:
+					position, tokenIndex = position391, tokenIndex391
 					{
-						position279, tokenIndex279 := position, tokenIndex
+						position393, tokenIndex393 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l272
+							goto l386
 						}
-						position, tokenIndex = position279, tokenIndex279
+						position, tokenIndex = position393, tokenIndex393
 					}
 				}
-			l277:
-				add(ruleRankSsp, position273)
+			l391:
+				add(ruleRankSsp, position387)
 			}
 			return true
-		l272:
-			position, tokenIndex = position272, tokenIndex272
+		l386:
+			position, tokenIndex = position386, tokenIndex386
 			return false
 		},
-		/* 38 RankAgamo <- <((('a' 'g' 'a' 'm' 'o' 's' 'p') / ('a' 'g' 'a' 'm' 'o' 's' 's' 'p') / ('a' 'g' 'a' 'm' 'o' 'v' 'a' 'r')) ('.' / &SpaceCharEOI))> */
+		/* 53 RankAgamo <- <((('a' 'g' 'a' 'm' 'o' 's' 'p') / ('a' 'g' 'a' 'm' 'o' 's' 's' 'p') / ('a' 'g' 'a' 'm' 'o' 'v' 'a' 'r')) ('.' / &SpaceCharEOI))> */
 		func() bool {
-			position280, tokenIndex280 := position, tokenIndex
+			position394, tokenIndex394 := position, tokenIndex
 			{
-				position281 := position
+				position395 := position
 				{
-					position282, tokenIndex282 := position, tokenIndex
+					position396, tokenIndex396 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l283
+						goto l397
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l283
+						goto l397
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l283
+						goto l397
 					}
 					position++
 					if buffer[position] != rune('m') {
-						goto l283
+						goto l397
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l283
+						goto l397
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l283
+						goto l397
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l283
+						goto l397
 					}
 					position++
-					goto l282
-				l283:
-					position, tokenIndex = position282, tokenIndex282
+					goto l396
+				l397:
+					position, tokenIndex = position396, tokenIndex396
 					if buffer[position] != rune('a') {
-						goto l284
+						goto l398
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l284
+						goto l398
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l284
+						goto l398
 					}
 					position++
 					if buffer[position] != rune('m') {
-						goto l284
+						goto l398
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l284
+						goto l398
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l284
+						goto l398
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l284
+						goto l398
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l284
+						goto l398
 					}
 					position++
-					goto l282
-				l284:
-					position, tokenIndex = position282, tokenIndex282
+					goto l396
+				l398:
+					position, tokenIndex = position396, tokenIndex396
 					if buffer[position] != rune('a') {
-						goto l280
+						goto l394
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l280
+						goto l394
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l280
+						goto l394
 					}
 					position++
 					if buffer[position] != rune('m') {
-						goto l280
+						goto l394
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l280
+						goto l394
 					}
 					position++
 					if buffer[position] != rune('v') {
-						goto l280
+						goto l394
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l280
+						goto l394
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l280
+						goto l394
 					}
 					position++
 				}
-			l282:
+			l396:
 				{
-					position285, tokenIndex285 := position, tokenIndex
+					position399, tokenIndex399 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l286
+						goto l400
 					}
 					position++
-					goto l285
-				l286:
-					position, tokenIndex = position285, tokenIndex285
+					goto l399
+				l400:
+					position, tokenIndex = position399, tokenIndex399
 					{
-						position287, tokenIndex287 := position, tokenIndex
+						position401, tokenIndex401 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l280
+							goto l394
 						}
-						position, tokenIndex = position287, tokenIndex287
+						position, tokenIndex = position401, tokenIndex401
 					}
 				}
-			l285:
-				add(ruleRankAgamo, position281)
+			l399:
+				add(ruleRankAgamo, position395)
 			}
 			return true
-		l280:
-			position, tokenIndex = position280, tokenIndex280
+		l394:
+			position, tokenIndex = position394, tokenIndex394
 			return false
 		},
-		/* 39 SubgenusOrSuperspecies <- <('(' _? NameLowerChar+ _? ')')> */
+		/* 54 SubgenusOrSuperspecies <- <('(' _? NameLowerChar+ _? ')')> */
 		func() bool {
-			position288, tokenIndex288 := position, tokenIndex
+			position402, tokenIndex402 := position, tokenIndex
 			{
-				position289 := position
+				position403 := position
 				if buffer[position] != rune('(') {
-					goto l288
+					goto l402
 				}
 				position++
 				{
-					position290, tokenIndex290 := position, tokenIndex
+					position404, tokenIndex404 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l290
+						goto l404
 					}
-					goto l291
-				l290:
-					position, tokenIndex = position290, tokenIndex290
+					goto l405
+				l404:
+					position, tokenIndex = position404, tokenIndex404
 				}
-			l291:
+			l405:
 				if !_rules[ruleNameLowerChar]() {
-					goto l288
+					goto l402
 				}
-			l292:
+			l406:
 				{
-					position293, tokenIndex293 := position, tokenIndex
+					position407, tokenIndex407 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l293
+						goto l407
 					}
-					goto l292
-				l293:
-					position, tokenIndex = position293, tokenIndex293
+					goto l406
+				l407:
+					position, tokenIndex = position407, tokenIndex407
 				}
 				{
-					position294, tokenIndex294 := position, tokenIndex
+					position408, tokenIndex408 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l294
+						goto l408
 					}
-					goto l295
-				l294:
-					position, tokenIndex = position294, tokenIndex294
+					goto l409
+				l408:
+					position, tokenIndex = position408, tokenIndex408
 				}
-			l295:
+			l409:
 				if buffer[position] != rune(')') {
-					goto l288
+					goto l402
 				}
 				position++
-				add(ruleSubgenusOrSuperspecies, position289)
+				add(ruleSubgenusOrSuperspecies, position403)
 			}
 			return true
-		l288:
-			position, tokenIndex = position288, tokenIndex288
+		l402:
+			position, tokenIndex = position402, tokenIndex402
 			return false
 		},
-		/* 40 Subgenus <- <(Subgenus2 / Subgenus1)> */
+		/* 55 Subgenus <- <(Subgenus2 / Subgenus1)> */
 		func() bool {
-			position296, tokenIndex296 := position, tokenIndex
+			position410, tokenIndex410 := position, tokenIndex
 			{
-				position297 := position
+				position411 := position
 				{
-					position298, tokenIndex298 := position, tokenIndex
+					position412, tokenIndex412 := position, tokenIndex
 					if !_rules[ruleSubgenus2]() {
-						goto l299
+						goto l413
 					}
-					goto l298
-				l299:
-					position, tokenIndex = position298, tokenIndex298
+					goto l412
+				l413:
+					position, tokenIndex = position412, tokenIndex412
 					if !_rules[ruleSubgenus1]() {
-						goto l296
+						goto l410
 					}
 				}
-			l298:
-				add(ruleSubgenus, position297)
+			l412:
+				add(ruleSubgenus, position411)
 			}
 			return true
-		l296:
-			position, tokenIndex = position296, tokenIndex296
+		l410:
+			position, tokenIndex = position410, tokenIndex410
 			return false
 		},
-		/* 41 Subgenus2 <- <('(' _? AbbrSubgenus _? ')' !(_? NameUpperChar))> */
+		/* 56 Subgenus2 <- <('(' _? AbbrSubgenus _? ')' !(_? NameUpperChar))> */
 		func() bool {
-			position300, tokenIndex300 := position, tokenIndex
+			position414, tokenIndex414 := position, tokenIndex
 			{
-				position301 := position
+				position415 := position
 				if buffer[position] != rune('(') {
-					goto l300
+					goto l414
 				}
 				position++
 				{
-					position302, tokenIndex302 := position, tokenIndex
+					position416, tokenIndex416 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l302
+						goto l416
 					}
-					goto l303
-				l302:
-					position, tokenIndex = position302, tokenIndex302
+					goto l417
+				l416:
+					position, tokenIndex = position416, tokenIndex416
 				}
-			l303:
+			l417:
 				if !_rules[ruleAbbrSubgenus]() {
-					goto l300
+					goto l414
 				}
 				{
-					position304, tokenIndex304 := position, tokenIndex
+					position418, tokenIndex418 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l304
+						goto l418
 					}
-					goto l305
-				l304:
-					position, tokenIndex = position304, tokenIndex304
+					goto l419
+				l418:
+					position, tokenIndex = position418, tokenIndex418
 				}
-			l305:
+			l419:
 				if buffer[position] != rune(')') {
-					goto l300
+					goto l414
 				}
 				position++
 				{
-					position306, tokenIndex306 := position, tokenIndex
+					position420, tokenIndex420 := position, tokenIndex
 					{
-						position307, tokenIndex307 := position, tokenIndex
+						position421, tokenIndex421 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l307
+							goto l421
 						}
-						goto l308
-					l307:
-						position, tokenIndex = position307, tokenIndex307
+						goto l422
+					l421:
+						position, tokenIndex = position421, tokenIndex421
 					}
-				l308:
+				l422:
 					if !_rules[ruleNameUpperChar]() {
-						goto l306
+						goto l420
 					}
-					goto l300
-				l306:
-					position, tokenIndex = position306, tokenIndex306
+					goto l414
+				l420:
+					position, tokenIndex = position420, tokenIndex420
 				}
-				add(ruleSubgenus2, position301)
+				add(ruleSubgenus2, position415)
 			}
 			return true
-		l300:
-			position, tokenIndex = position300, tokenIndex300
+		l414:
+			position, tokenIndex = position414, tokenIndex414
 			return false
 		},
-		/* 42 Subgenus1 <- <('(' _? UninomialWord _? ')')> */
+		/* 57 Subgenus1 <- <('(' _? UninomialWord _? ')')> */
 		func() bool {
-			position309, tokenIndex309 := position, tokenIndex
+			position423, tokenIndex423 := position, tokenIndex
 			{
-				position310 := position
+				position424 := position
 				if buffer[position] != rune('(') {
-					goto l309
+					goto l423
 				}
 				position++
 				{
-					position311, tokenIndex311 := position, tokenIndex
+					position425, tokenIndex425 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l311
+						goto l425
 					}
-					goto l312
-				l311:
-					position, tokenIndex = position311, tokenIndex311
+					goto l426
+				l425:
+					position, tokenIndex = position425, tokenIndex425
 				}
-			l312:
+			l426:
 				if !_rules[ruleUninomialWord]() {
-					goto l309
+					goto l423
 				}
 				{
-					position313, tokenIndex313 := position, tokenIndex
+					position427, tokenIndex427 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l313
+						goto l427
 					}
-					goto l314
-				l313:
-					position, tokenIndex = position313, tokenIndex313
+					goto l428
+				l427:
+					position, tokenIndex = position427, tokenIndex427
 				}
-			l314:
+			l428:
 				if buffer[position] != rune(')') {
-					goto l309
+					goto l423
 				}
 				position++
-				add(ruleSubgenus1, position310)
+				add(ruleSubgenus1, position424)
 			}
 			return true
-		l309:
-			position, tokenIndex = position309, tokenIndex309
+		l423:
+			position, tokenIndex = position423, tokenIndex423
 			return false
 		},
-		/* 43 UninomialCombo <- <(UninomialCombo1 / UninomialCombo2)> */
+		/* 58 UninomialCombo <- <(UninomialCombo1 / UninomialCombo2)> */
 		func() bool {
-			position315, tokenIndex315 := position, tokenIndex
+			position429, tokenIndex429 := position, tokenIndex
 			{
-				position316 := position
+				position430 := position
 				{
-					position317, tokenIndex317 := position, tokenIndex
+					position431, tokenIndex431 := position, tokenIndex
 					if !_rules[ruleUninomialCombo1]() {
-						goto l318
+						goto l432
 					}
-					goto l317
-				l318:
-					position, tokenIndex = position317, tokenIndex317
+					goto l431
+				l432:
+					position, tokenIndex = position431, tokenIndex431
 					if !_rules[ruleUninomialCombo2]() {
-						goto l315
+						goto l429
 					}
 				}
-			l317:
-				add(ruleUninomialCombo, position316)
+			l431:
+				add(ruleUninomialCombo, position430)
 			}
 			return true
-		l315:
-			position, tokenIndex = position315, tokenIndex315
+		l429:
+			position, tokenIndex = position429, tokenIndex429
 			return false
 		},
-		/* 44 UninomialCombo1 <- <(UninomialWord _? Subgenus (_? Authorship)?)> */
+		/* 59 UninomialCombo1 <- <(UninomialWord _? Subgenus (_? Authorship)?)> */
 		func() bool {
-			position319, tokenIndex319 := position, tokenIndex
+			position433, tokenIndex433 := position, tokenIndex
 			{
-				position320 := position
+				position434 := position
 				if !_rules[ruleUninomialWord]() {
-					goto l319
+					goto l433
 				}
 				{
-					position321, tokenIndex321 := position, tokenIndex
+					position435, tokenIndex435 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l321
+						goto l435
 					}
-					goto l322
-				l321:
-					position, tokenIndex = position321, tokenIndex321
+					goto l436
+				l435:
+					position, tokenIndex = position435, tokenIndex435
 				}
-			l322:
+			l436:
 				if !_rules[ruleSubgenus]() {
-					goto l319
+					goto l433
 				}
 				{
-					position323, tokenIndex323 := position, tokenIndex
+					position437, tokenIndex437 := position, tokenIndex
 					{
-						position325, tokenIndex325 := position, tokenIndex
+						position439, tokenIndex439 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l325
+							goto l439
 						}
-						goto l326
-					l325:
-						position, tokenIndex = position325, tokenIndex325
+						goto l440
+					l439:
+						position, tokenIndex = position439, tokenIndex439
 					}
-				l326:
+				l440:
 					if !_rules[ruleAuthorship]() {
-						goto l323
+						goto l437
 					}
-					goto l324
-				l323:
-					position, tokenIndex = position323, tokenIndex323
+					goto l438
+				l437:
+					position, tokenIndex = position437, tokenIndex437
 				}
-			l324:
-				add(ruleUninomialCombo1, position320)
+			l438:
+				add(ruleUninomialCombo1, position434)
 			}
 			return true
-		l319:
-			position, tokenIndex = position319, tokenIndex319
+		l433:
+			position, tokenIndex = position433, tokenIndex433
 			return false
 		},
-		/* 45 UninomialCombo2 <- <(Uninomial _ RankUninomial _ Uninomial)> */
+		/* 60 UninomialCombo2 <- <(Uninomial _ RankUninomial _ Uninomial)> */
 		func() bool {
-			position327, tokenIndex327 := position, tokenIndex
+			position441, tokenIndex441 := position, tokenIndex
 			{
-				position328 := position
+				position442 := position
 				if !_rules[ruleUninomial]() {
-					goto l327
+					goto l441
 				}
 				if !_rules[rule_]() {
-					goto l327
+					goto l441
 				}
 				if !_rules[ruleRankUninomial]() {
-					goto l327
+					goto l441
 				}
 				if !_rules[rule_]() {
-					goto l327
+					goto l441
 				}
 				if !_rules[ruleUninomial]() {
-					goto l327
+					goto l441
 				}
-				add(ruleUninomialCombo2, position328)
+				add(ruleUninomialCombo2, position442)
 			}
 			return true
-		l327:
-			position, tokenIndex = position327, tokenIndex327
+		l441:
+			position, tokenIndex = position441, tokenIndex441
 			return false
 		},
-		/* 46 RankUninomial <- <(RankUninomialPlain / RankUninomialNotho)> */
+		/* 61 RankUninomial <- <(RankUninomialPlain / RankUninomialNotho)> */
 		func() bool {
-			position329, tokenIndex329 := position, tokenIndex
+			position443, tokenIndex443 := position, tokenIndex
 			{
-				position330 := position
+				position444 := position
 				{
-					position331, tokenIndex331 := position, tokenIndex
+					position445, tokenIndex445 := position, tokenIndex
 					if !_rules[ruleRankUninomialPlain]() {
-						goto l332
+						goto l446
 					}
-					goto l331
-				l332:
-					position, tokenIndex = position331, tokenIndex331
+					goto l445
+				l446:
+					position, tokenIndex = position445, tokenIndex445
 					if !_rules[ruleRankUninomialNotho]() {
-						goto l329
+						goto l443
 					}
 				}
-			l331:
-				add(ruleRankUninomial, position330)
+			l445:
+				add(ruleRankUninomial, position444)
 			}
 			return true
-		l329:
-			position, tokenIndex = position329, tokenIndex329
+		l443:
+			position, tokenIndex = position443, tokenIndex443
 			return false
 		},
-		/* 47 RankUninomialPlain <- <((('s' 'e' 'c' 't') / ('s' 'u' 'b' 's' 'e' 'c' 't') / ('t' 'r' 'i' 'b') / ('s' 'u' 'b' 't' 'r' 'i' 'b') / ('s' 'u' 'b' 's' 'e' 'r') / ('s' 'e' 'r') / ('s' 'u' 'b' 'g' 'e' 'n') / ('s' 'u' 'b' 'g') / ('f' 'a' 'm') / ('s' 'u' 'b' 'f' 'a' 'm') / ('d' 'i' 'v') / ('s' 'u' 'p' 'e' 'r' 't' 'r' 'i' 'b')) ('.' / &SpaceCharEOI))> */
+		/* 62 RankUninomialPlain <- <((('s' 'e' 'c' 't') / ('s' 'u' 'b' 's' 'e' 'c' 't') / ('t' 'r' 'i' 'b') / ('s' 'u' 'b' 't' 'r' 'i' 'b') / ('s' 'u' 'b' 's' 'e' 'r') / ('s' 'e' 'r') / ('s' 'u' 'b' 'g' 'e' 'n') / ('s' 'u' 'b' 'g') / ('f' 'a' 'm') / ('s' 'u' 'b' 'f' 'a' 'm') / ('d' 'i' 'v') / ('s' 'u' 'p' 'e' 'r' 't' 'r' 'i' 'b')) ('.' / &SpaceCharEOI))> */
 		func() bool {
-			position333, tokenIndex333 := position, tokenIndex
+			position447, tokenIndex447 := position, tokenIndex
 			{
-				position334 := position
+				position448 := position
 				{
-					position335, tokenIndex335 := position, tokenIndex
+					position449, tokenIndex449 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l336
+						goto l450
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l336
+						goto l450
 					}
 					position++
 					if buffer[position] != rune('c') {
-						goto l336
+						goto l450
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l336
+						goto l450
 					}
 					position++
-					goto l335
-				l336:
-					position, tokenIndex = position335, tokenIndex335
+					goto l449
+				l450:
+					position, tokenIndex = position449, tokenIndex449
 					if buffer[position] != rune('s') {
-						goto l337
+						goto l451
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l337
+						goto l451
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l337
+						goto l451
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l337
+						goto l451
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l337
+						goto l451
 					}
 					position++
 					if buffer[position] != rune('c') {
-						goto l337
+						goto l451
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l337
+						goto l451
 					}
 					position++
-					goto l335
-				l337:
-					position, tokenIndex = position335, tokenIndex335
+					goto l449
+				l451:
+					position, tokenIndex = position449, tokenIndex449
 					if buffer[position] != rune('t') {
-						goto l338
+						goto l452
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l338
+						goto l452
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l338
+						goto l452
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l338
+						goto l452
 					}
 					position++
-					goto l335
-				l338:
-					position, tokenIndex = position335, tokenIndex335
+					goto l449
+				l452:
+					position, tokenIndex = position449, tokenIndex449
 					if buffer[position] != rune('s') {
-						goto l339
+						goto l453
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l339
+						goto l453
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l339
+						goto l453
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l339
+						goto l453
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l339
+						goto l453
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l339
+						goto l453
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l339
+						goto l453
 					}
 					position++
-					goto l335
-				l339:
-					position, tokenIndex = position335, tokenIndex335
+					goto l449
+				l453:
+					position, tokenIndex = position449, tokenIndex449
 					if buffer[position] != rune('s') {
-						goto l340
+						goto l454
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l340
+						goto l454
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l340
+						goto l454
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l340
+						goto l454
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l340
+						goto l454
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l340
+						goto l454
 					}
 					position++
-					goto l335
-				l340:
-					position, tokenIndex = position335, tokenIndex335
+					goto l449
+				l454:
+					position, tokenIndex = position449, tokenIndex449
 					if buffer[position] != rune('s') {
-						goto l341
+						goto l455
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l341
+						goto l455
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l341
+						goto l455
 					}
 					position++
-					goto l335
-				l341:
-					position, tokenIndex = position335, tokenIndex335
+					goto l449
+				l455:
+					position, tokenIndex = position449, tokenIndex449
 					if buffer[position] != rune('s') {
-						goto l342
+						goto l456
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l342
+						goto l456
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l342
+						goto l456
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l342
+						goto l456
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l342
+						goto l456
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l342
+						goto l456
 					}
 					position++
-					goto l335
-				l342:
-					position, tokenIndex = position335, tokenIndex335
+					goto l449
+				l456:
+					position, tokenIndex = position449, tokenIndex449
 					if buffer[position] != rune('s') {
-						goto l343
+						goto l457
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l343
+						goto l457
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l343
+						goto l457
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l343
+						goto l457
 					}
 					position++
-					goto l335
-				l343:
-					position, tokenIndex = position335, tokenIndex335
+					goto l449
+				l457:
+					position, tokenIndex = position449, tokenIndex449
 					if buffer[position] != rune('f') {
-						goto l344
+						goto l458
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l344
+						goto l458
 					}
 					position++
 					if buffer[position] != rune('m') {
-						goto l344
+						goto l458
 					}
 					position++
-					goto l335
-				l344:
-					position, tokenIndex = position335, tokenIndex335
+					goto l449
+				l458:
+					position, tokenIndex = position449, tokenIndex449
 					if buffer[position] != rune('s') {
-						goto l345
+						goto l459
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l345
+						goto l459
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l345
+						goto l459
 					}
 					position++
 					if buffer[position] != rune('f') {
-						goto l345
+						goto l459
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l345
+						goto l459
 					}
 					position++
 					if buffer[position] != rune('m') {
-						goto l345
+						goto l459
 					}
 					position++
-					goto l335
-				l345:
-					position, tokenIndex = position335, tokenIndex335
+					goto l449
+				l459:
+					position, tokenIndex = position449, tokenIndex449
 					if buffer[position] != rune('d') {
-						goto l346
+						goto l460
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l346
+						goto l460
 					}
 					position++
 					if buffer[position] != rune('v') {
-						goto l346
+						goto l460
 					}
 					position++
-					goto l335
-				l346:
-					position, tokenIndex = position335, tokenIndex335
+					goto l449
+				l460:
+					position, tokenIndex = position449, tokenIndex449
 					if buffer[position] != rune('s') {
-						goto l333
+						goto l447
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l333
+						goto l447
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l333
+						goto l447
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l333
+						goto l447
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l333
+						goto l447
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l333
+						goto l447
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l333
+						goto l447
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l333
+						goto l447
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l333
+						goto l447
 					}
 					position++
 				}
-			l335:
+			l449:
 				{
-					position347, tokenIndex347 := position, tokenIndex
+					position461, tokenIndex461 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l348
+						goto l462
 					}
 					position++
-					goto l347
-				l348:
-					position, tokenIndex = position347, tokenIndex347
+					goto l461
+				l462:
+					position, tokenIndex = position461, tokenIndex461
 					{
-						position349, tokenIndex349 := position, tokenIndex
+						position463, tokenIndex463 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l333
+							goto l447
 						}
-						position, tokenIndex = position349, tokenIndex349
+						position, tokenIndex = position463, tokenIndex463
 					}
 				}
-			l347:
-				add(ruleRankUninomialPlain, position334)
+			l461:
+				add(ruleRankUninomialPlain, position448)
 			}
 			return true
-		l333:
-			position, tokenIndex = position333, tokenIndex333
+		l447:
+			position, tokenIndex = position447, tokenIndex447
 			return false
 		},
-		/* 48 RankUninomialNotho <- <('n' 'o' 't' 'h' 'o' _? (('s' 'e' 'c' 't') / ('g' 'e' 'n') / ('s' 'e' 'r') / ('s' 'u' 'b' 'g' 'e' 'e' 'n') / ('s' 'u' 'b' 'g' 'e' 'n') / ('s' 'u' 'b' 'g') / ('s' 'u' 'b' 's' 'e' 'c' 't') / ('s' 'u' 'b' 't' 'r' 'i' 'b')) ('.' / &SpaceCharEOI))> */
+		/* 63 RankUninomialNotho <- <('n' 'o' 't' 'h' 'o' _? (('s' 'e' 'c' 't') / ('g' 'e' 'n') / ('s' 'e' 'r') / ('s' 'u' 'b' 'g' 'e' 'e' 'n') / ('s' 'u' 'b' 'g' 'e' 'n') / ('s' 'u' 'b' 'g') / ('s' 'u' 'b' 's' 'e' 'c' 't') / ('s' 'u' 'b' 't' 'r' 'i' 'b')) ('.' / &SpaceCharEOI))> */
 		func() bool {
-			position350, tokenIndex350 := position, tokenIndex
+			position464, tokenIndex464 := position, tokenIndex
 			{
-				position351 := position
+				position465 := position
 				if buffer[position] != rune('n') {
-					goto l350
+					goto l464
 				}
 				position++
 				if buffer[position] != rune('o') {
-					goto l350
+					goto l464
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l350
+					goto l464
 				}
 				position++
 				if buffer[position] != rune('h') {
-					goto l350
+					goto l464
 				}
 				position++
 				if buffer[position] != rune('o') {
-					goto l350
+					goto l464
 				}
 				position++
 				{
-					position352, tokenIndex352 := position, tokenIndex
+					position466, tokenIndex466 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l352
+						goto l466
 					}
-					goto l353
-				l352:
-					position, tokenIndex = position352, tokenIndex352
+					goto l467
+				l466:
+					position, tokenIndex = position466, tokenIndex466
 				}
-			l353:
+			l467:
 				{
-					position354, tokenIndex354 := position, tokenIndex
+					position468, tokenIndex468 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l355
+						goto l469
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l355
+						goto l469
 					}
 					position++
 					if buffer[position] != rune('c') {
-						goto l355
+						goto l469
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l355
+						goto l469
 					}
 					position++
-					goto l354
-				l355:
-					position, tokenIndex = position354, tokenIndex354
+					goto l468
+				l469:
+					position, tokenIndex = position468, tokenIndex468
 					if buffer[position] != rune('g') {
-						goto l356
+						goto l470
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l356
+						goto l470
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l356
+						goto l470
 					}
 					position++
-					goto l354
-				l356:
-					position, tokenIndex = position354, tokenIndex354
+					goto l468
+				l470:
+					position, tokenIndex = position468, tokenIndex468
 					if buffer[position] != rune('s') {
-						goto l357
+						goto l471
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l357
+						goto l471
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l357
+						goto l471
 					}
 					position++
-					goto l354
-				l357:
-					position, tokenIndex = position354, tokenIndex354
+					goto l468
+				l471:
+					position, tokenIndex = position468, tokenIndex468
 					if buffer[position] != rune('s') {
-						goto l358
+						goto l472
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l358
+						goto l472
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l358
+						goto l472
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l358
+						goto l472
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l358
+						goto l472
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l358
+						goto l472
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l358
+						goto l472
 					}
 					position++
-					goto l354
-				l358:
-					position, tokenIndex = position354, tokenIndex354
+					goto l468
+				l472:
+					position, tokenIndex = position468, tokenIndex468
 					if buffer[position] != rune('s') {
-						goto l359
+						goto l473
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l359
+						goto l473
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l359
+						goto l473
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l359
+						goto l473
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l359
+						goto l473
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l359
+						goto l473
 					}
 					position++
-					goto l354
-				l359:
-					position, tokenIndex = position354, tokenIndex354
+					goto l468
+				l473:
+					position, tokenIndex = position468, tokenIndex468
 					if buffer[position] != rune('s') {
-						goto l360
+						goto l474
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l360
+						goto l474
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l360
+						goto l474
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l360
+						goto l474
 					}
 					position++
-					goto l354
-				l360:
-					position, tokenIndex = position354, tokenIndex354
+					goto l468
+				l474:
+					position, tokenIndex = position468, tokenIndex468
 					if buffer[position] != rune('s') {
-						goto l361
+						goto l475
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l361
+						goto l475
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l361
+						goto l475
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l361
+						goto l475
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l361
+						goto l475
 					}
 					position++
 					if buffer[position] != rune('c') {
-						goto l361
+						goto l475
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l361
+						goto l475
 					}
 					position++
-					goto l354
-				l361:
-					position, tokenIndex = position354, tokenIndex354
+					goto l468
+				l475:
+					position, tokenIndex = position468, tokenIndex468
 					if buffer[position] != rune('s') {
-						goto l350
+						goto l464
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l350
+						goto l464
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l350
+						goto l464
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l350
+						goto l464
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l350
+						goto l464
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l350
+						goto l464
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l350
+						goto l464
 					}
 					position++
 				}
-			l354:
+			l468:
 				{
-					position362, tokenIndex362 := position, tokenIndex
+					position476, tokenIndex476 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l363
+						goto l477
 					}
 					position++
-					goto l362
-				l363:
-					position, tokenIndex = position362, tokenIndex362
+					goto l476
+				l477:
+					position, tokenIndex = position476, tokenIndex476
 					{
-						position364, tokenIndex364 := position, tokenIndex
+						position478, tokenIndex478 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l350
+							goto l464
 						}
-						position, tokenIndex = position364, tokenIndex364
+						position, tokenIndex = position478, tokenIndex478
 					}
 				}
-			l362:
-				add(ruleRankUninomialNotho, position351)
+			l476:
+				add(ruleRankUninomialNotho, position465)
 			}
 			return true
-		l350:
-			position, tokenIndex = position350, tokenIndex350
+		l464:
+			position, tokenIndex = position464, tokenIndex464
 			return false
 		},
-		/* 49 Uninomial <- <(UninomialWord (_ Authorship !(_ LowerCharExtended LowerCharExtended LowerCharExtended))?)> */
+		/* 64 Uninomial <- <(UninomialWord (_ Authorship !(_ LowerCharExtended LowerCharExtended LowerCharExtended))?)> */
 		func() bool {
-			position365, tokenIndex365 := position, tokenIndex
+			position479, tokenIndex479 := position, tokenIndex
 			{
-				position366 := position
+				position480 := position
 				if !_rules[ruleUninomialWord]() {
-					goto l365
+					goto l479
 				}
 				{
-					position367, tokenIndex367 := position, tokenIndex
+					position481, tokenIndex481 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l367
+						goto l481
 					}
 					if !_rules[ruleAuthorship]() {
-						goto l367
+						goto l481
 					}
 					{
-						position369, tokenIndex369 := position, tokenIndex
+						position483, tokenIndex483 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l369
+							goto l483
 						}
 						if !_rules[ruleLowerCharExtended]() {
-							goto l369
+							goto l483
 						}
 						if !_rules[ruleLowerCharExtended]() {
-							goto l369
+							goto l483
 						}
 						if !_rules[ruleLowerCharExtended]() {
-							goto l369
+							goto l483
 						}
-						goto l367
-					l369:
-						position, tokenIndex = position369, tokenIndex369
+						goto l481
+					l483:
+						position, tokenIndex = position483, tokenIndex483
 					}
-					goto l368
-				l367:
-					position, tokenIndex = position367, tokenIndex367
+					goto l482
+				l481:
+					position, tokenIndex = position481, tokenIndex481
 				}
-			l368:
-				add(ruleUninomial, position366)
+			l482:
+				add(ruleUninomial, position480)
 			}
 			return true
-		l365:
-			position, tokenIndex = position365, tokenIndex365
+		l479:
+			position, tokenIndex = position479, tokenIndex479
 			return false
 		},
-		/* 50 UninomialWord <- <(CapWord / TwoLetterGenus)> */
+		/* 65 UninomialWord <- <(CapWord / TwoLetterGenus)> */
 		func() bool {
-			position370, tokenIndex370 := position, tokenIndex
+			position484, tokenIndex484 := position, tokenIndex
 			{
-				position371 := position
+				position485 := position
 				{
-					position372, tokenIndex372 := position, tokenIndex
+					position486, tokenIndex486 := position, tokenIndex
 					if !_rules[ruleCapWord]() {
-						goto l373
+						goto l487
 					}
-					goto l372
-				l373:
-					position, tokenIndex = position372, tokenIndex372
+					goto l486
+				l487:
+					position, tokenIndex = position486, tokenIndex486
 					if !_rules[ruleTwoLetterGenus]() {
-						goto l370
+						goto l484
 					}
 				}
-			l372:
-				add(ruleUninomialWord, position371)
+			l486:
+				add(ruleUninomialWord, position485)
 			}
 			return true
-		l370:
-			position, tokenIndex = position370, tokenIndex370
+		l484:
+			position, tokenIndex = position484, tokenIndex484
 			return false
 		},
-		/* 51 AbbrSubgenus <- <(UpperChar LowerChar* '.')> */
+		/* 66 AbbrSubgenus <- <(UpperChar LowerChar* '.')> */
 		func() bool {
-			position374, tokenIndex374 := position, tokenIndex
+			position488, tokenIndex488 := position, tokenIndex
 			{
-				position375 := position
+				position489 := position
 				if !_rules[ruleUpperChar]() {
-					goto l374
+					goto l488
 				}
-			l376:
+			l490:
 				{
-					position377, tokenIndex377 := position, tokenIndex
+					position491, tokenIndex491 := position, tokenIndex
 					if !_rules[ruleLowerChar]() {
-						goto l377
+						goto l491
 					}
-					goto l376
-				l377:
-					position, tokenIndex = position377, tokenIndex377
+					goto l490
+				l491:
+					position, tokenIndex = position491, tokenIndex491
 				}
 				if buffer[position] != rune('.') {
-					goto l374
+					goto l488
 				}
 				position++
-				add(ruleAbbrSubgenus, position375)
+				add(ruleAbbrSubgenus, position489)
 			}
 			return true
-		l374:
-			position, tokenIndex = position374, tokenIndex374
+		l488:
+			position, tokenIndex = position488, tokenIndex488
 			return false
 		},
-		/* 52 AbbrGenus <- <(UpperChar LowerChar? '.')> */
+		/* 67 AbbrGenus <- <(UpperChar LowerChar? '.')> */
 		func() bool {
-			position378, tokenIndex378 := position, tokenIndex
+			position492, tokenIndex492 := position, tokenIndex
 			{
-				position379 := position
+				position493 := position
 				if !_rules[ruleUpperChar]() {
-					goto l378
+					goto l492
 				}
 				{
-					position380, tokenIndex380 := position, tokenIndex
+					position494, tokenIndex494 := position, tokenIndex
 					if !_rules[ruleLowerChar]() {
-						goto l380
+						goto l494
 					}
-					goto l381
-				l380:
-					position, tokenIndex = position380, tokenIndex380
+					goto l495
+				l494:
+					position, tokenIndex = position494, tokenIndex494
 				}
-			l381:
+			l495:
 				if buffer[position] != rune('.') {
-					goto l378
+					goto l492
 				}
 				position++
-				add(ruleAbbrGenus, position379)
+				add(ruleAbbrGenus, position493)
 			}
 			return true
-		l378:
-			position, tokenIndex = position378, tokenIndex378
+		l492:
+			position, tokenIndex = position492, tokenIndex492
 			return false
 		},
-		/* 53 CapWord <- <(CapWordWithDash / CapWord1)> */
+		/* 68 CapWord <- <(CapWordWithDash / CapWord1)> */
 		func() bool {
-			position382, tokenIndex382 := position, tokenIndex
+			position496, tokenIndex496 := position, tokenIndex
 			{
-				position383 := position
+				position497 := position
 				{
-					position384, tokenIndex384 := position, tokenIndex
+					position498, tokenIndex498 := position, tokenIndex
 					if !_rules[ruleCapWordWithDash]() {
-						goto l385
+						goto l499
 					}
-					goto l384
-				l385:
-					position, tokenIndex = position384, tokenIndex384
+					goto l498
+				l499:
+					position, tokenIndex = position498, tokenIndex498
 					if !_rules[ruleCapWord1]() {
-						goto l382
+						goto l496
 					}
 				}
-			l384:
-				add(ruleCapWord, position383)
+			l498:
+				add(ruleCapWord, position497)
 			}
 			return true
-		l382:
-			position, tokenIndex = position382, tokenIndex382
+		l496:
+			position, tokenIndex = position496, tokenIndex496
 			return false
 		},
-		/* 54 CapWord1 <- <(NameUpperChar NameLowerChar NameLowerChar+ '?'?)> */
+		/* 69 CapWord1 <- <(NameUpperChar NameLowerChar NameLowerChar+ '?'?)> */
 		func() bool {
-			position386, tokenIndex386 := position, tokenIndex
+			position500, tokenIndex500 := position, tokenIndex
 			{
-				position387 := position
+				position501 := position
 				if !_rules[ruleNameUpperChar]() {
-					goto l386
+					goto l500
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l386
+					goto l500
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l386
+					goto l500
 				}
-			l388:
+			l502:
 				{
-					position389, tokenIndex389 := position, tokenIndex
+					position503, tokenIndex503 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l389
+						goto l503
 					}
-					goto l388
-				l389:
-					position, tokenIndex = position389, tokenIndex389
+					goto l502
+				l503:
+					position, tokenIndex = position503, tokenIndex503
 				}
 				{
-					position390, tokenIndex390 := position, tokenIndex
+					position504, tokenIndex504 := position, tokenIndex
 					if buffer[position] != rune('?') {
-						goto l390
+						goto l504
 					}
 					position++
-					goto l391
-				l390:
-					position, tokenIndex = position390, tokenIndex390
+					goto l505
+				l504:
+					position, tokenIndex = position504, tokenIndex504
 				}
-			l391:
-				add(ruleCapWord1, position387)
+			l505:
+				add(ruleCapWord1, position501)
 			}
 			return true
-		l386:
-			position, tokenIndex = position386, tokenIndex386
+		l500:
+			position, tokenIndex = position500, tokenIndex500
 			return false
 		},
-		/* 55 CapWordWithDash <- <((CapWord1 / TwoLetterGenusDashedSegment) Dash WordAfterDash (Dash WordAfterDash)?)> */
+		/* 70 CapWordWithDash <- <((CapWord1 / TwoLetterGenusDashedSegment) Dash WordAfterDash (Dash WordAfterDash)?)> */
 		func() bool {
-			position392, tokenIndex392 := position, tokenIndex
+			position506, tokenIndex506 := position, tokenIndex
 			{
-				position393 := position
+				position507 := position
 				{
-					position394, tokenIndex394 := position, tokenIndex
+					position508, tokenIndex508 := position, tokenIndex
 					if !_rules[ruleCapWord1]() {
-						goto l395
+						goto l509
 					}
-					goto l394
-				l395:
-					position, tokenIndex = position394, tokenIndex394
+					goto l508
+				l509:
+					position, tokenIndex = position508, tokenIndex508
 					if !_rules[ruleTwoLetterGenusDashedSegment]() {
-						goto l392
+						goto l506
 					}
 				}
-			l394:
+			l508:
 				if !_rules[ruleDash]() {
-					goto l392
+					goto l506
 				}
 				if !_rules[ruleWordAfterDash]() {
-					goto l392
+					goto l506
 				}
 				{
-					position396, tokenIndex396 := position, tokenIndex
+					position510, tokenIndex510 := position, tokenIndex
 					if !_rules[ruleDash]() {
-						goto l396
+						goto l510
 					}
 					if !_rules[ruleWordAfterDash]() {
-						goto l396
+						goto l510
 					}
-					goto l397
-				l396:
-					position, tokenIndex = position396, tokenIndex396
+					goto l511
+				l510:
+					position, tokenIndex = position510, tokenIndex510
 				}
-			l397:
-				add(ruleCapWordWithDash, position393)
+			l511:
+				add(ruleCapWordWithDash, position507)
 			}
 			return true
-		l392:
-			position, tokenIndex = position392, tokenIndex392
+		l506:
+			position, tokenIndex = position506, tokenIndex506
 			return false
 		},
-		/* 56 TwoLetterGenusDashedSegment <- <(('D' 'e') / ('E' 'u') / ('L' 'e') / ('N' 'e'))> */
+		/* 71 TwoLetterGenusDashedSegment <- <(('D' 'e') / ('E' 'u') / ('L' 'e') / ('N' 'e'))> */
 		func() bool {
-			position398, tokenIndex398 := position, tokenIndex
+			position512, tokenIndex512 := position, tokenIndex
 			{
-				position399 := position
+				position513 := position
 				{
-					position400, tokenIndex400 := position, tokenIndex
+					position514, tokenIndex514 := position, tokenIndex
 					if buffer[position] != rune('D') {
-						goto l401
+						goto l515
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l401
+						goto l515
 					}
 					position++
-					goto l400
-				l401:
-					position, tokenIndex = position400, tokenIndex400
+					goto l514
+				l515:
+					position, tokenIndex = position514, tokenIndex514
 					if buffer[position] != rune('E') {
-						goto l402
+						goto l516
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l402
+						goto l516
 					}
 					position++
-					goto l400
-				l402:
-					position, tokenIndex = position400, tokenIndex400
+					goto l514
+				l516:
+					position, tokenIndex = position514, tokenIndex514
 					if buffer[position] != rune('L') {
-						goto l403
+						goto l517
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l403
+						goto l517
 					}
 					position++
-					goto l400
-				l403:
-					position, tokenIndex = position400, tokenIndex400
+					goto l514
+				l517:
+					position, tokenIndex = position514, tokenIndex514
 					if buffer[position] != rune('N') {
-						goto l398
+						goto l512
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l398
+						goto l512
 					}
 					position++
 				}
-			l400:
-				add(ruleTwoLetterGenusDashedSegment, position399)
+			l514:
+				add(ruleTwoLetterGenusDashedSegment, position513)
 			}
 			return true
-		l398:
-			position, tokenIndex = position398, tokenIndex398
+		l512:
+			position, tokenIndex = position512, tokenIndex512
 			return false
 		},
-		/* 57 WordAfterDash <- <(UpperAfterDash / LowerAfterDash)> */
+		/* 72 WordAfterDash <- <(UpperAfterDash / LowerAfterDash)> */
 		func() bool {
-			position404, tokenIndex404 := position, tokenIndex
+			position518, tokenIndex518 := position, tokenIndex
 			{
-				position405 := position
+				position519 := position
 				{
-					position406, tokenIndex406 := position, tokenIndex
+					position520, tokenIndex520 := position, tokenIndex
 					if !_rules[ruleUpperAfterDash]() {
-						goto l407
+						goto l521
 					}
-					goto l406
-				l407:
-					position, tokenIndex = position406, tokenIndex406
+					goto l520
+				l521:
+					position, tokenIndex = position520, tokenIndex520
 					if !_rules[ruleLowerAfterDash]() {
-						goto l404
+						goto l518
 					}
 				}
-			l406:
-				add(ruleWordAfterDash, position405)
+			l520:
+				add(ruleWordAfterDash, position519)
 			}
 			return true
-		l404:
-			position, tokenIndex = position404, tokenIndex404
+		l518:
+			position, tokenIndex = position518, tokenIndex518
 			return false
 		},
-		/* 58 UpperAfterDash <- <CapWord1> */
+		/* 73 UpperAfterDash <- <CapWord1> */
 		func() bool {
-			position408, tokenIndex408 := position, tokenIndex
+			position522, tokenIndex522 := position, tokenIndex
 			{
-				position409 := position
+				position523 := position
 				if !_rules[ruleCapWord1]() {
-					goto l408
+					goto l522
 				}
-				add(ruleUpperAfterDash, position409)
+				add(ruleUpperAfterDash, position523)
 			}
 			return true
-		l408:
-			position, tokenIndex = position408, tokenIndex408
+		l522:
+			position, tokenIndex = position522, tokenIndex522
 			return false
 		},
-		/* 59 LowerAfterDash <- <Word1> */
+		/* 74 LowerAfterDash <- <Word1> */
 		func() bool {
-			position410, tokenIndex410 := position, tokenIndex
+			position524, tokenIndex524 := position, tokenIndex
 			{
-				position411 := position
+				position525 := position
 				if !_rules[ruleWord1]() {
-					goto l410
+					goto l524
 				}
-				add(ruleLowerAfterDash, position411)
+				add(ruleLowerAfterDash, position525)
 			}
 			return true
-		l410:
-			position, tokenIndex = position410, tokenIndex410
+		l524:
+			position, tokenIndex = position524, tokenIndex524
 			return false
 		},
-		/* 60 TwoLetterGenus <- <(('C' 'a') / ('D' 'o') / ('E' 'a') / ('G' 'e') / ('I' 'a') / ('I' 'o') / ('I' 'x') / ('L' 'o') / ('O' 'a') / ('O' 'o') / ('N' 'u') / ('R' 'a') / ('T' 'y') / ('U' 'a') / ('A' 'a') / ('J' 'a') / ('Z' 'u') / ('L' 'a') / ('Q' 'u') / ('A' 's') / ('B' 'a'))> */
+		/* 75 TwoLetterGenus <- <(('C' 'a') / ('D' 'o') / ('E' 'a') / ('G' 'e') / ('I' 'a') / ('I' 'o') / ('I' 'x') / ('L' 'o') / ('O' 'a') / ('O' 'o') / ('N' 'u') / ('R' 'a') / ('T' 'y') / ('U' 'a') / ('A' 'a') / ('J' 'a') / ('Z' 'u') / ('L' 'a') / ('Q' 'u') / ('A' 's') / ('B' 'a'))> */
 		func() bool {
-			position412, tokenIndex412 := position, tokenIndex
+			position526, tokenIndex526 := position, tokenIndex
 			{
-				position413 := position
+				position527 := position
 				{
-					position414, tokenIndex414 := position, tokenIndex
+					position528, tokenIndex528 := position, tokenIndex
 					if buffer[position] != rune('C') {
-						goto l415
+						goto l529
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l415
+						goto l529
 					}
 					position++
-					goto l414
-				l415:
-					position, tokenIndex = position414, tokenIndex414
+					goto l528
+				l529:
+					position, tokenIndex = position528, tokenIndex528
 					if buffer[position] != rune('D') {
-						goto l416
+						goto l530
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l416
+						goto l530
 					}
 					position++
-					goto l414
-				l416:
-					position, tokenIndex = position414, tokenIndex414
+					goto l528
+				l530:
+					position, tokenIndex = position528, tokenIndex528
 					if buffer[position] != rune('E') {
-						goto l417
+						goto l531
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l417
+						goto l531
 					}
 					position++
-					goto l414
-				l417:
-					position, tokenIndex = position414, tokenIndex414
+					goto l528
+				l531:
+					position, tokenIndex = position528, tokenIndex528
 					if buffer[position] != rune('G') {
-						goto l418
+						goto l532
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l418
+						goto l532
 					}
 					position++
-					goto l414
-				l418:
-					position, tokenIndex = position414, tokenIndex414
+					goto l528
+				l532:
+					position, tokenIndex = position528, tokenIndex528
 					if buffer[position] != rune('I') {
-						goto l419
+						goto l533
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l419
+						goto l533
 					}
 					position++
-					goto l414
-				l419:
-					position, tokenIndex = position414, tokenIndex414
+					goto l528
+				l533:
+					position, tokenIndex = position528, tokenIndex528
 					if buffer[position] != rune('I') {
-						goto l420
+						goto l534
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l420
+						goto l534
 					}
 					position++
-					goto l414
-				l420:
-					position, tokenIndex = position414, tokenIndex414
+					goto l528
+				l534:
+					position, tokenIndex = position528, tokenIndex528
 					if buffer[position] != rune('I') {
-						goto l421
+						goto l535
 					}
 					position++
 					if buffer[position] != rune('x') {
-						goto l421
+						goto l535
 					}
 					position++
-					goto l414
-				l421:
-					position, tokenIndex = position414, tokenIndex414
+					goto l528
+				l535:
+					position, tokenIndex = position528, tokenIndex528
 					if buffer[position] != rune('L') {
-						goto l422
+						goto l536
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l422
+						goto l536
 					}
 					position++
-					goto l414
-				l422:
-					position, tokenIndex = position414, tokenIndex414
+					goto l528
+				l536:
+					position, tokenIndex = position528, tokenIndex528
 					if buffer[position] != rune('O') {
-						goto l423
+						goto l537
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l423
+						goto l537
 					}
 					position++
-					goto l414
-				l423:
-					position, tokenIndex = position414, tokenIndex414
+					goto l528
+				l537:
+					position, tokenIndex = position528, tokenIndex528
 					if buffer[position] != rune('O') {
-						goto l424
+						goto l538
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l424
+						goto l538
 					}
 					position++
-					goto l414
-				l424:
-					position, tokenIndex = position414, tokenIndex414
+					goto l528
+				l538:
+					position, tokenIndex = position528, tokenIndex528
 					if buffer[position] != rune('N') {
-						goto l425
+						goto l539
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l425
+						goto l539
 					}
 					position++
-					goto l414
-				l425:
-					position, tokenIndex = position414, tokenIndex414
+					goto l528
+				l539:
+					position, tokenIndex = position528, tokenIndex528
 					if buffer[position] != rune('R') {
-						goto l426
+						goto l540
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l426
+						goto l540
 					}
 					position++
-					goto l414
-				l426:
-					position, tokenIndex = position414, tokenIndex414
+					goto l528
+				l540:
+					position, tokenIndex = position528, tokenIndex528
 					if buffer[position] != rune('T') {
-						goto l427
+						goto l541
 					}
 					position++
 					if buffer[position] != rune('y') {
-						goto l427
+						goto l541
 					}
 					position++
-					goto l414
-				l427:
-					position, tokenIndex = position414, tokenIndex414
+					goto l528
+				l541:
+					position, tokenIndex = position528, tokenIndex528
 					if buffer[position] != rune('U') {
-						goto l428
+						goto l542
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l428
+						goto l542
 					}
 					position++
-					goto l414
-				l428:
-					position, tokenIndex = position414, tokenIndex414
+					goto l528
+				l542:
+					position, tokenIndex = position528, tokenIndex528
 					if buffer[position] != rune('A') {
-						goto l429
+						goto l543
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l429
+						goto l543
 					}
 					position++
-					goto l414
-				l429:
-					position, tokenIndex = position414, tokenIndex414
+					goto l528
+				l543:
+					position, tokenIndex = position528, tokenIndex528
 					if buffer[position] != rune('J') {
-						goto l430
+						goto l544
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l430
+						goto l544
 					}
 					position++
-					goto l414
-				l430:
-					position, tokenIndex = position414, tokenIndex414
+					goto l528
+				l544:
+					position, tokenIndex = position528, tokenIndex528
 					if buffer[position] != rune('Z') {
-						goto l431
+						goto l545
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l431
+						goto l545
 					}
 					position++
-					goto l414
-				l431:
-					position, tokenIndex = position414, tokenIndex414
+					goto l528
+				l545:
+					position, tokenIndex = position528, tokenIndex528
 					if buffer[position] != rune('L') {
-						goto l432
+						goto l546
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l432
+						goto l546
 					}
 					position++
-					goto l414
-				l432:
-					position, tokenIndex = position414, tokenIndex414
+					goto l528
+				l546:
+					position, tokenIndex = position528, tokenIndex528
 					if buffer[position] != rune('Q') {
-						goto l433
+						goto l547
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l433
+						goto l547
 					}
 					position++
-					goto l414
-				l433:
-					position, tokenIndex = position414, tokenIndex414
+					goto l528
+				l547:
+					position, tokenIndex = position528, tokenIndex528
 					if buffer[position] != rune('A') {
-						goto l434
+						goto l548
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l434
+						goto l548
 					}
 					position++
-					goto l414
-				l434:
-					position, tokenIndex = position414, tokenIndex414
+					goto l528
+				l548:
+					position, tokenIndex = position528, tokenIndex528
 					if buffer[position] != rune('B') {
-						goto l412
+						goto l526
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l412
+						goto l526
 					}
 					position++
 				}
-			l414:
-				add(ruleTwoLetterGenus, position413)
+			l528:
+				add(ruleTwoLetterGenus, position527)
 			}
 			return true
-		l412:
-			position, tokenIndex = position412, tokenIndex412
+		l526:
+			position, tokenIndex = position526, tokenIndex526
 			return false
 		},
-		/* 61 Word <- <(!((('e' 'x') / ('e' 't') / ('a' 'n' 'd') / ('a' 'p' 'u' 'd') / ('p' 'r' 'o') / ('c' 'v') / ('c' 'u' 'l' 't' 'i' 'v' 'a' 'r') / AuthorPrefix / RankUninomial / Approximation / Word4) SpaceCharEOI) (WordApostr / WordStartsWithDigit / MultiDashedWord / Word2 / Word1) &(SpaceCharEOI / '('))> */
+		/* 76 Word <- <(!((('e' 'x') / ('e' 't') / ('a' 'n' 'd') / ('a' 'p' 'u' 'd') / ('p' 'r' 'o') / ('c' 'v') / ('c' 'u' 'l' 't' 'i' 'v' 'a' 'r') / AuthorPrefix / RankUninomial / Approximation / Word4) SpaceCharEOI) (WordApostr / WordStartsWithDigit / MultiDashedWord / Word2 / Word1) &(SpaceCharEOI / '('))> */
 		func() bool {
-			position435, tokenIndex435 := position, tokenIndex
+			position549, tokenIndex549 := position, tokenIndex
 			{
-				position436 := position
+				position550 := position
 				{
-					position437, tokenIndex437 := position, tokenIndex
+					position551, tokenIndex551 := position, tokenIndex
 					{
-						position438, tokenIndex438 := position, tokenIndex
+						position552, tokenIndex552 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l439
+							goto l553
 						}
 						position++
 						if buffer[position] != rune('x') {
-							goto l439
+							goto l553
 						}
 						position++
-						goto l438
-					l439:
-						position, tokenIndex = position438, tokenIndex438
+						goto l552
+					l553:
+						position, tokenIndex = position552, tokenIndex552
 						if buffer[position] != rune('e') {
-							goto l440
+							goto l554
 						}
 						position++
 						if buffer[position] != rune('t') {
-							goto l440
+							goto l554
 						}
 						position++
-						goto l438
-					l440:
-						position, tokenIndex = position438, tokenIndex438
+						goto l552
+					l554:
+						position, tokenIndex = position552, tokenIndex552
 						if buffer[position] != rune('a') {
-							goto l441
+							goto l555
 						}
 						position++
 						if buffer[position] != rune('n') {
-							goto l441
+							goto l555
 						}
 						position++
 						if buffer[position] != rune('d') {
-							goto l441
+							goto l555
 						}
 						position++
-						goto l438
-					l441:
-						position, tokenIndex = position438, tokenIndex438
+						goto l552
+					l555:
+						position, tokenIndex = position552, tokenIndex552
 						if buffer[position] != rune('a') {
-							goto l442
+							goto l556
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l442
+							goto l556
 						}
 						position++
 						if buffer[position] != rune('u') {
-							goto l442
+							goto l556
 						}
 						position++
 						if buffer[position] != rune('d') {
-							goto l442
+							goto l556
 						}
 						position++
-						goto l438
-					l442:
-						position, tokenIndex = position438, tokenIndex438
+						goto l552
+					l556:
+						position, tokenIndex = position552, tokenIndex552
 						if buffer[position] != rune('p') {
-							goto l443
+							goto l557
 						}
 						position++
 						if buffer[position] != rune('r') {
-							goto l443
+							goto l557
 						}
 						position++
 						if buffer[position] != rune('o') {
-							goto l443
+							goto l557
 						}
 						position++
-						goto l438
-					l443:
-						position, tokenIndex = position438, tokenIndex438
+						goto l552
+					l557:
+						position, tokenIndex = position552, tokenIndex552
 						if buffer[position] != rune('c') {
-							goto l444
+							goto l558
 						}
 						position++
 						if buffer[position] != rune('v') {
-							goto l444
+							goto l558
 						}
 						position++
-						goto l438
-					l444:
-						position, tokenIndex = position438, tokenIndex438
+						goto l552
+					l558:
+						position, tokenIndex = position552, tokenIndex552
 						if buffer[position] != rune('c') {
-							goto l445
+							goto l559
 						}
 						position++
 						if buffer[position] != rune('u') {
-							goto l445
+							goto l559
 						}
 						position++
 						if buffer[position] != rune('l') {
-							goto l445
+							goto l559
 						}
 						position++
 						if buffer[position] != rune('t') {
-							goto l445
+							goto l559
 						}
 						position++
 						if buffer[position] != rune('i') {
-							goto l445
+							goto l559
 						}
 						position++
 						if buffer[position] != rune('v') {
-							goto l445
+							goto l559
 						}
 						position++
 						if buffer[position] != rune('a') {
-							goto l445
+							goto l559
 						}
 						position++
 						if buffer[position] != rune('r') {
-							goto l445
+							goto l559
 						}
 						position++
-						goto l438
-					l445:
-						position, tokenIndex = position438, tokenIndex438
+						goto l552
+					l559:
+						position, tokenIndex = position552, tokenIndex552
 						if !_rules[ruleAuthorPrefix]() {
-							goto l446
+							goto l560
 						}
-						goto l438
-					l446:
-						position, tokenIndex = position438, tokenIndex438
+						goto l552
+					l560:
+						position, tokenIndex = position552, tokenIndex552
 						if !_rules[ruleRankUninomial]() {
-							goto l447
+							goto l561
 						}
-						goto l438
-					l447:
-						position, tokenIndex = position438, tokenIndex438
+						goto l552
+					l561:
+						position, tokenIndex = position552, tokenIndex552
 						if !_rules[ruleApproximation]() {
-							goto l448
+							goto l562
 						}
-						goto l438
-					l448:
-						position, tokenIndex = position438, tokenIndex438
+						goto l552
+					l562:
+						position, tokenIndex = position552, tokenIndex552
 						if !_rules[ruleWord4]() {
-							goto l437
+							goto l551
 						}
 					}
-				l438:
+				l552:
 					if !_rules[ruleSpaceCharEOI]() {
-						goto l437
+						goto l551
 					}
-					goto l435
-				l437:
-					position, tokenIndex = position437, tokenIndex437
+					goto l549
+				l551:
+					position, tokenIndex = position551, tokenIndex551
 				}
 				{
-					position449, tokenIndex449 := position, tokenIndex
+					position563, tokenIndex563 := position, tokenIndex
 					if !_rules[ruleWordApostr]() {
-						goto l450
+						goto l564
 					}
-					goto l449
-				l450:
-					position, tokenIndex = position449, tokenIndex449
+					goto l563
+				l564:
+					position, tokenIndex = position563, tokenIndex563
 					if !_rules[ruleWordStartsWithDigit]() {
-						goto l451
+						goto l565
 					}
-					goto l449
-				l451:
-					position, tokenIndex = position449, tokenIndex449
+					goto l563
+				l565:
+					position, tokenIndex = position563, tokenIndex563
 					if !_rules[ruleMultiDashedWord]() {
-						goto l452
+						goto l566
 					}
-					goto l449
-				l452:
-					position, tokenIndex = position449, tokenIndex449
+					goto l563
+				l566:
+					position, tokenIndex = position563, tokenIndex563
 					if !_rules[ruleWord2]() {
-						goto l453
+						goto l567
 					}
-					goto l449
-				l453:
-					position, tokenIndex = position449, tokenIndex449
+					goto l563
+				l567:
+					position, tokenIndex = position563, tokenIndex563
 					if !_rules[ruleWord1]() {
-						goto l435
+						goto l549
 					}
 				}
-			l449:
+			l563:
 				{
-					position454, tokenIndex454 := position, tokenIndex
+					position568, tokenIndex568 := position, tokenIndex
 					{
-						position455, tokenIndex455 := position, tokenIndex
+						position569, tokenIndex569 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l456
+							goto l570
 						}
-						goto l455
-					l456:
-						position, tokenIndex = position455, tokenIndex455
+						goto l569
+					l570:
+						position, tokenIndex = position569, tokenIndex569
 						if buffer[position] != rune('(') {
-							goto l435
+							goto l549
 						}
 						position++
 					}
-				l455:
-					position, tokenIndex = position454, tokenIndex454
+				l569:
+					position, tokenIndex = position568, tokenIndex568
 				}
-				add(ruleWord, position436)
+				add(ruleWord, position550)
 			}
 			return true
-		l435:
-			position, tokenIndex = position435, tokenIndex435
+		l549:
+			position, tokenIndex = position549, tokenIndex549
 			return false
 		},
-		/* 62 Word1 <- <(((DotPrefix / LowerASCII) Dash)? NameLowerChar NameLowerChar+)> */
+		/* 77 Word1 <- <(((DotPrefix / LowerASCII) Dash)? NameLowerChar NameLowerChar+)> */
 		func() bool {
-			position457, tokenIndex457 := position, tokenIndex
+			position571, tokenIndex571 := position, tokenIndex
 			{
-				position458 := position
+				position572 := position
 				{
-					position459, tokenIndex459 := position, tokenIndex
+					position573, tokenIndex573 := position, tokenIndex
 					{
-						position461, tokenIndex461 := position, tokenIndex
+						position575, tokenIndex575 := position, tokenIndex
 						if !_rules[ruleDotPrefix]() {
-							goto l462
+							goto l576
 						}
-						goto l461
-					l462:
-						position, tokenIndex = position461, tokenIndex461
+						goto l575
+					l576:
+						position, tokenIndex = position575, tokenIndex575
 						if !_rules[ruleLowerASCII]() {
-							goto l459
+							goto l573
 						}
 					}
-				l461:
+				l575:
 					if !_rules[ruleDash]() {
-						goto l459
+						goto l573
 					}
-					goto l460
-				l459:
-					position, tokenIndex = position459, tokenIndex459
+					goto l574
+				l573:
+					position, tokenIndex = position573, tokenIndex573
 				}
-			l460:
+			l574:
 				if !_rules[ruleNameLowerChar]() {
-					goto l457
+					goto l571
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l457
+					goto l571
 				}
-			l463:
+			l577:
 				{
-					position464, tokenIndex464 := position, tokenIndex
+					position578, tokenIndex578 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l464
+						goto l578
 					}
-					goto l463
-				l464:
-					position, tokenIndex = position464, tokenIndex464
+					goto l577
+				l578:
+					position, tokenIndex = position578, tokenIndex578
 				}
-				add(ruleWord1, position458)
+				add(ruleWord1, position572)
 			}
 			return true
-		l457:
-			position, tokenIndex = position457, tokenIndex457
+		l571:
+			position, tokenIndex = position571, tokenIndex571
 			return false
 		},
-		/* 63 WordStartsWithDigit <- <(('1' / '2' / '3' / '4' / '5' / '6' / '7' / '8' / '9') Nums? ('.' / Dash)? NameLowerChar NameLowerChar NameLowerChar NameLowerChar+)> */
+		/* 78 WordStartsWithDigit <- <(('1' / '2' / '3' / '4' / '5' / '6' / '7' / '8' / '9') Nums? ('.' / Dash)? NameLowerChar NameLowerChar NameLowerChar NameLowerChar+)> */
 		func() bool {
-			position465, tokenIndex465 := position, tokenIndex
+			position579, tokenIndex579 := position, tokenIndex
 			{
-				position466 := position
+				position580 := position
 				{
-					position467, tokenIndex467 := position, tokenIndex
+					position581, tokenIndex581 := position, tokenIndex
 					if buffer[position] != rune('1') {
-						goto l468
+						goto l582
 					}
 					position++
-					goto l467
-				l468:
-					position, tokenIndex = position467, tokenIndex467
+					goto l581
+				l582:
+					position, tokenIndex = position581, tokenIndex581
 					if buffer[position] != rune('2') {
-						goto l469
+						goto l583
 					}
 					position++
-					goto l467
-				l469:
-					position, tokenIndex = position467, tokenIndex467
+					goto l581
+				l583:
+					position, tokenIndex = position581, tokenIndex581
 					if buffer[position] != rune('3') {
-						goto l470
+						goto l584
 					}
 					position++
-					goto l467
-				l470:
-					position, tokenIndex = position467, tokenIndex467
+					goto l581
+				l584:
+					position, tokenIndex = position581, tokenIndex581
 					if buffer[position] != rune('4') {
-						goto l471
+						goto l585
 					}
 					position++
-					goto l467
-				l471:
-					position, tokenIndex = position467, tokenIndex467
+					goto l581
+				l585:
+					position, tokenIndex = position581, tokenIndex581
 					if buffer[position] != rune('5') {
-						goto l472
+						goto l586
 					}
 					position++
-					goto l467
-				l472:
-					position, tokenIndex = position467, tokenIndex467
+					goto l581
+				l586:
+					position, tokenIndex = position581, tokenIndex581
 					if buffer[position] != rune('6') {
-						goto l473
+						goto l587
 					}
 					position++
-					goto l467
-				l473:
-					position, tokenIndex = position467, tokenIndex467
+					goto l581
+				l587:
+					position, tokenIndex = position581, tokenIndex581
 					if buffer[position] != rune('7') {
-						goto l474
+						goto l588
 					}
 					position++
-					goto l467
-				l474:
-					position, tokenIndex = position467, tokenIndex467
+					goto l581
+				l588:
+					position, tokenIndex = position581, tokenIndex581
 					if buffer[position] != rune('8') {
-						goto l475
+						goto l589
 					}
 					position++
-					goto l467
-				l475:
-					position, tokenIndex = position467, tokenIndex467
+					goto l581
+				l589:
+					position, tokenIndex = position581, tokenIndex581
 					if buffer[position] != rune('9') {
-						goto l465
+						goto l579
 					}
 					position++
 				}
-			l467:
+			l581:
 				{
-					position476, tokenIndex476 := position, tokenIndex
+					position590, tokenIndex590 := position, tokenIndex
 					if !_rules[ruleNums]() {
-						goto l476
+						goto l590
 					}
-					goto l477
-				l476:
-					position, tokenIndex = position476, tokenIndex476
+					goto l591
+				l590:
+					position, tokenIndex = position590, tokenIndex590
 				}
-			l477:
+			l591:
 				{
-					position478, tokenIndex478 := position, tokenIndex
+					position592, tokenIndex592 := position, tokenIndex
 					{
-						position480, tokenIndex480 := position, tokenIndex
+						position594, tokenIndex594 := position, tokenIndex
 						if buffer[position] != rune('.') {
-							goto l481
+							goto l595
 						}
 						position++
-						goto l480
-					l481:
-						position, tokenIndex = position480, tokenIndex480
+						goto l594
+					l595:
+						position, tokenIndex = position594, tokenIndex594
 						if !_rules[ruleDash]() {
-							goto l478
+							goto l592
 						}
 					}
-				l480:
-					goto l479
-				l478:
-					position, tokenIndex = position478, tokenIndex478
+				l594:
+					goto l593
+				l592:
+					position, tokenIndex = position592, tokenIndex592
 				}
-			l479:
+			l593:
 				if !_rules[ruleNameLowerChar]() {
-					goto l465
+					goto l579
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l465
+					goto l579
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l465
+					goto l579
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l465
+					goto l579
 				}
-			l482:
+			l596:
 				{
-					position483, tokenIndex483 := position, tokenIndex
+					position597, tokenIndex597 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l483
+						goto l597
 					}
-					goto l482
-				l483:
-					position, tokenIndex = position483, tokenIndex483
+					goto l596
+				l597:
+					position, tokenIndex = position597, tokenIndex597
 				}
-				add(ruleWordStartsWithDigit, position466)
+				add(ruleWordStartsWithDigit, position580)
 			}
 			return true
-		l465:
-			position, tokenIndex = position465, tokenIndex465
+		l579:
+			position, tokenIndex = position579, tokenIndex579
 			return false
 		},
-		/* 64 Word2 <- <(NameLowerChar+ Dash? (WordApostr / NameLowerChar+))> */
+		/* 79 Word2 <- <(NameLowerChar+ Dash? (WordApostr / NameLowerChar+))> */
 		func() bool {
-			position484, tokenIndex484 := position, tokenIndex
+			position598, tokenIndex598 := position, tokenIndex
 			{
-				position485 := position
+				position599 := position
 				if !_rules[ruleNameLowerChar]() {
-					goto l484
+					goto l598
 				}
-			l486:
+			l600:
 				{
-					position487, tokenIndex487 := position, tokenIndex
+					position601, tokenIndex601 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l487
+						goto l601
 					}
-					goto l486
-				l487:
-					position, tokenIndex = position487, tokenIndex487
+					goto l600
+				l601:
+					position, tokenIndex = position601, tokenIndex601
 				}
 				{
-					position488, tokenIndex488 := position, tokenIndex
+					position602, tokenIndex602 := position, tokenIndex
 					if !_rules[ruleDash]() {
-						goto l488
+						goto l602
 					}
-					goto l489
-				l488:
-					position, tokenIndex = position488, tokenIndex488
+					goto l603
+				l602:
+					position, tokenIndex = position602, tokenIndex602
 				}
-			l489:
+			l603:
 				{
-					position490, tokenIndex490 := position, tokenIndex
+					position604, tokenIndex604 := position, tokenIndex
 					if !_rules[ruleWordApostr]() {
-						goto l491
+						goto l605
 					}
-					goto l490
-				l491:
-					position, tokenIndex = position490, tokenIndex490
+					goto l604
+				l605:
+					position, tokenIndex = position604, tokenIndex604
 					if !_rules[ruleNameLowerChar]() {
-						goto l484
+						goto l598
 					}
-				l492:
+				l606:
 					{
-						position493, tokenIndex493 := position, tokenIndex
+						position607, tokenIndex607 := position, tokenIndex
 						if !_rules[ruleNameLowerChar]() {
-							goto l493
+							goto l607
 						}
-						goto l492
-					l493:
-						position, tokenIndex = position493, tokenIndex493
+						goto l606
+					l607:
+						position, tokenIndex = position607, tokenIndex607
 					}
 				}
-			l490:
-				add(ruleWord2, position485)
+			l604:
+				add(ruleWord2, position599)
 			}
 			return true
-		l484:
-			position, tokenIndex = position484, tokenIndex484
+		l598:
+			position, tokenIndex = position598, tokenIndex598
 			return false
 		},
-		/* 65 WordApostr <- <(NameLowerChar NameLowerChar* Apostrophe Word1)> */
+		/* 80 WordApostr <- <(NameLowerChar NameLowerChar* Apostrophe Word1)> */
 		func() bool {
-			position494, tokenIndex494 := position, tokenIndex
+			position608, tokenIndex608 := position, tokenIndex
 			{
-				position495 := position
+				position609 := position
 				if !_rules[ruleNameLowerChar]() {
-					goto l494
+					goto l608
 				}
-			l496:
+			l610:
 				{
-					position497, tokenIndex497 := position, tokenIndex
+					position611, tokenIndex611 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l497
+						goto l611
 					}
-					goto l496
-				l497:
-					position, tokenIndex = position497, tokenIndex497
+					goto l610
+				l611:
+					position, tokenIndex = position611, tokenIndex611
 				}
 				if !_rules[ruleApostrophe]() {
-					goto l494
+					goto l608
 				}
 				if !_rules[ruleWord1]() {
-					goto l494
+					goto l608
 				}
-				add(ruleWordApostr, position495)
+				add(ruleWordApostr, position609)
 			}
 			return true
-		l494:
-			position, tokenIndex = position494, tokenIndex494
+		l608:
+			position, tokenIndex = position608, tokenIndex608
 			return false
 		},
-		/* 66 Word4 <- <(NameLowerChar+ '.' NameLowerChar)> */
+		/* 81 Word4 <- <(NameLowerChar+ '.' NameLowerChar)> */
 		func() bool {
-			position498, tokenIndex498 := position, tokenIndex
+			position612, tokenIndex612 := position, tokenIndex
 			{
-				position499 := position
+				position613 := position
 				if !_rules[ruleNameLowerChar]() {
-					goto l498
+					goto l612
 				}
-			l500:
+			l614:
 				{
-					position501, tokenIndex501 := position, tokenIndex
+					position615, tokenIndex615 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l501
+						goto l615
 					}
-					goto l500
-				l501:
-					position, tokenIndex = position501, tokenIndex501
+					goto l614
+				l615:
+					position, tokenIndex = position615, tokenIndex615
 				}
 				if buffer[position] != rune('.') {
-					goto l498
+					goto l612
 				}
 				position++
 				if !_rules[ruleNameLowerChar]() {
-					goto l498
+					goto l612
 				}
-				add(ruleWord4, position499)
+				add(ruleWord4, position613)
 			}
 			return true
-		l498:
-			position, tokenIndex = position498, tokenIndex498
+		l612:
+			position, tokenIndex = position612, tokenIndex612
 			return false
 		},
-		/* 67 DotPrefix <- <('s' 't' '.')> */
+		/* 82 DotPrefix <- <('s' 't' '.')> */
 		func() bool {
-			position502, tokenIndex502 := position, tokenIndex
+			position616, tokenIndex616 := position, tokenIndex
 			{
-				position503 := position
+				position617 := position
 				if buffer[position] != rune('s') {
-					goto l502
+					goto l616
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l502
+					goto l616
 				}
 				position++
 				if buffer[position] != rune('.') {
-					goto l502
+					goto l616
 				}
 				position++
-				add(ruleDotPrefix, position503)
+				add(ruleDotPrefix, position617)
 			}
 			return true
-		l502:
-			position, tokenIndex = position502, tokenIndex502
+		l616:
+			position, tokenIndex = position616, tokenIndex616
 			return false
 		},
-		/* 68 MultiDashedWord <- <(NameLowerChar+ Dash NameLowerChar+ Dash NameLowerChar+ (Dash NameLowerChar+)?)> */
+		/* 83 MultiDashedWord <- <(NameLowerChar+ Dash NameLowerChar+ Dash NameLowerChar+ (Dash NameLowerChar+)?)> */
 		func() bool {
-			position504, tokenIndex504 := position, tokenIndex
+			position618, tokenIndex618 := position, tokenIndex
 			{
-				position505 := position
+				position619 := position
 				if !_rules[ruleNameLowerChar]() {
-					goto l504
+					goto l618
 				}
-			l506:
+			l620:
 				{
-					position507, tokenIndex507 := position, tokenIndex
+					position621, tokenIndex621 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l507
+						goto l621
 					}
-					goto l506
-				l507:
-					position, tokenIndex = position507, tokenIndex507
+					goto l620
+				l621:
+					position, tokenIndex = position621, tokenIndex621
 				}
 				if !_rules[ruleDash]() {
-					goto l504
+					goto l618
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l504
+					goto l618
 				}
-			l508:
+			l622:
 				{
-					position509, tokenIndex509 := position, tokenIndex
+					position623, tokenIndex623 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l509
+						goto l623
 					}
-					goto l508
-				l509:
-					position, tokenIndex = position509, tokenIndex509
+					goto l622
+				l623:
+					position, tokenIndex = position623, tokenIndex623
 				}
 				if !_rules[ruleDash]() {
-					goto l504
+					goto l618
 				}
 				if !_rules[ruleNameLowerChar]() {
-					goto l504
+					goto l618
 				}
-			l510:
+			l624:
 				{
-					position511, tokenIndex511 := position, tokenIndex
+					position625, tokenIndex625 := position, tokenIndex
 					if !_rules[ruleNameLowerChar]() {
-						goto l511
+						goto l625
 					}
-					goto l510
-				l511:
-					position, tokenIndex = position511, tokenIndex511
+					goto l624
+				l625:
+					position, tokenIndex = position625, tokenIndex625
 				}
 				{
-					position512, tokenIndex512 := position, tokenIndex
+					position626, tokenIndex626 := position, tokenIndex
 					if !_rules[ruleDash]() {
-						goto l512
+						goto l626
 					}
 					if !_rules[ruleNameLowerChar]() {
-						goto l512
+						goto l626
 					}
-				l514:
+				l628:
 					{
-						position515, tokenIndex515 := position, tokenIndex
+						position629, tokenIndex629 := position, tokenIndex
 						if !_rules[ruleNameLowerChar]() {
-							goto l515
+							goto l629
 						}
-						goto l514
-					l515:
-						position, tokenIndex = position515, tokenIndex515
+						goto l628
+					l629:
+						position, tokenIndex = position629, tokenIndex629
 					}
-					goto l513
-				l512:
-					position, tokenIndex = position512, tokenIndex512
+					goto l627
+				l626:
+					position, tokenIndex = position626, tokenIndex626
 				}
-			l513:
-				add(ruleMultiDashedWord, position505)
+			l627:
+				add(ruleMultiDashedWord, position619)
 			}
 			return true
-		l504:
-			position, tokenIndex = position504, tokenIndex504
+		l618:
+			position, tokenIndex = position618, tokenIndex618
 			return false
 		},
-		/* 69 HybridChar <- <('×' / (('x' / 'X') &_) / (('x' / 'X') &UninomialWord) / (('x' / 'X') &END))> */
+		/* 84 HybridChar <- <('×' / (('x' / 'X') &_) / (('x' / 'X') &UninomialWord) / (('x' / 'X') &END))> */
 		func() bool {
-			position516, tokenIndex516 := position, tokenIndex
+			position630, tokenIndex630 := position, tokenIndex
 			{
-				position517 := position
+				position631 := position
 				{
-					position518, tokenIndex518 := position, tokenIndex
+					position632, tokenIndex632 := position, tokenIndex
 					if buffer[position] != rune('×') {
-						goto l519
+						goto l633
 					}
 					position++
-					goto l518
-				l519:
-					position, tokenIndex = position518, tokenIndex518
+					goto l632
+				l633:
+					position, tokenIndex = position632, tokenIndex632
 					{
-						position521, tokenIndex521 := position, tokenIndex
+						position635, tokenIndex635 := position, tokenIndex
 						if buffer[position] != rune('x') {
-							goto l522
+							goto l636
 						}
 						position++
-						goto l521
-					l522:
-						position, tokenIndex = position521, tokenIndex521
+						goto l635
+					l636:
+						position, tokenIndex = position635, tokenIndex635
 						if buffer[position] != rune('X') {
-							goto l520
+							goto l634
 						}
 						position++
 					}
-				l521:
+				l635:
 					{
-						position523, tokenIndex523 := position, tokenIndex
+						position637, tokenIndex637 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l520
+							goto l634
 						}
-						position, tokenIndex = position523, tokenIndex523
+						position, tokenIndex = position637, tokenIndex637
 					}
-					goto l518
-				l520:
-					position, tokenIndex = position518, tokenIndex518
+					goto l632
+				l634:
+					position, tokenIndex = position632, tokenIndex632
 					{
-						position525, tokenIndex525 := position, tokenIndex
+						position639, tokenIndex639 := position, tokenIndex
 						if buffer[position] != rune('x') {
-							goto l526
+							goto l640
 						}
 						position++
-						goto l525
-					l526:
-						position, tokenIndex = position525, tokenIndex525
+						goto l639
+					l640:
+						position, tokenIndex = position639, tokenIndex639
 						if buffer[position] != rune('X') {
-							goto l524
+							goto l638
 						}
 						position++
 					}
-				l525:
+				l639:
 					{
-						position527, tokenIndex527 := position, tokenIndex
+						position641, tokenIndex641 := position, tokenIndex
 						if !_rules[ruleUninomialWord]() {
-							goto l524
+							goto l638
 						}
-						position, tokenIndex = position527, tokenIndex527
+						position, tokenIndex = position641, tokenIndex641
 					}
-					goto l518
-				l524:
-					position, tokenIndex = position518, tokenIndex518
+					goto l632
+				l638:
+					position, tokenIndex = position632, tokenIndex632
 					{
-						position528, tokenIndex528 := position, tokenIndex
+						position642, tokenIndex642 := position, tokenIndex
 						if buffer[position] != rune('x') {
-							goto l529
+							goto l643
 						}
 						position++
-						goto l528
-					l529:
-						position, tokenIndex = position528, tokenIndex528
+						goto l642
+					l643:
+						position, tokenIndex = position642, tokenIndex642
 						if buffer[position] != rune('X') {
-							goto l516
+							goto l630
 						}
 						position++
 					}
-				l528:
+				l642:
 					{
-						position530, tokenIndex530 := position, tokenIndex
+						position644, tokenIndex644 := position, tokenIndex
 						if !_rules[ruleEND]() {
-							goto l516
+							goto l630
 						}
-						position, tokenIndex = position530, tokenIndex530
+						position, tokenIndex = position644, tokenIndex644
 					}
 				}
-			l518:
-				add(ruleHybridChar, position517)
+			l632:
+				add(ruleHybridChar, position631)
 			}
 			return true
-		l516:
-			position, tokenIndex = position516, tokenIndex516
+		l630:
+			position, tokenIndex = position630, tokenIndex630
 			return false
 		},
-		/* 70 GraftChimeraChar <- <'+'> */
+		/* 85 GraftChimeraChar <- <'+'> */
 		func() bool {
-			position531, tokenIndex531 := position, tokenIndex
+			position645, tokenIndex645 := position, tokenIndex
 			{
-				position532 := position
+				position646 := position
 				if buffer[position] != rune('+') {
-					goto l531
+					goto l645
 				}
 				position++
-				add(ruleGraftChimeraChar, position532)
+				add(ruleGraftChimeraChar, position646)
 			}
 			return true
-		l531:
-			position, tokenIndex = position531, tokenIndex531
+		l645:
+			position, tokenIndex = position645, tokenIndex645
 			return false
 		},
-		/* 71 ApproxNameIgnored <- <.*> */
+		/* 86 ApproxNameIgnored <- <.*> */
 		func() bool {
 			{
-				position534 := position
-			l535:
+				position648 := position
+			l649:
 				{
-					position536, tokenIndex536 := position, tokenIndex
+					position650, tokenIndex650 := position, tokenIndex
 					if !matchDot() {
-						goto l536
+						goto l650
 					}
-					goto l535
-				l536:
-					position, tokenIndex = position536, tokenIndex536
+					goto l649
+				l650:
+					position, tokenIndex = position650, tokenIndex650
 				}
-				add(ruleApproxNameIgnored, position534)
+				add(ruleApproxNameIgnored, position648)
 			}
 			return true
 		},
-		/* 72 Approximation <- <(('s' 'p' '.' _? ('n' 'r' '.')) / ('s' 'p' '.' _? ('a' 'f' 'f' '.')) / ('m' 'o' 'n' 's' 't' '.') / '?' / ((('s' 'p' 'p') / ('n' 'r') / ('s' 'p') / ('a' 'f' 'f') / ('s' 'p' 'e' 'c' 'i' 'e' 's')) (&SpaceCharEOI / '.')))> */
+		/* 87 Approximation <- <(('s' 'p' '.' _? ('n' 'r' '.')) / ('s' 'p' '.' _? ('a' 'f' 'f' '.')) / ('m' 'o' 'n' 's' 't' '.') / '?' / ((('s' 'p' 'p') / ('n' 'r') / ('s' 'p') / ('a' 'f' 'f') / ('s' 'p' 'e' 'c' 'i' 'e' 's')) (&SpaceCharEOI / '.')))> */
 		func() bool {
-			position537, tokenIndex537 := position, tokenIndex
+			position651, tokenIndex651 := position, tokenIndex
 			{
-				position538 := position
+				position652 := position
 				{
-					position539, tokenIndex539 := position, tokenIndex
+					position653, tokenIndex653 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l540
+						goto l654
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l540
+						goto l654
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l540
+						goto l654
 					}
 					position++
 					{
-						position541, tokenIndex541 := position, tokenIndex
+						position655, tokenIndex655 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l541
+							goto l655
 						}
-						goto l542
-					l541:
-						position, tokenIndex = position541, tokenIndex541
+						goto l656
+					l655:
+						position, tokenIndex = position655, tokenIndex655
 					}
-				l542:
+				l656:
 					if buffer[position] != rune('n') {
-						goto l540
+						goto l654
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l540
+						goto l654
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l540
+						goto l654
 					}
 					position++
-					goto l539
-				l540:
-					position, tokenIndex = position539, tokenIndex539
+					goto l653
+				l654:
+					position, tokenIndex = position653, tokenIndex653
 					if buffer[position] != rune('s') {
-						goto l543
+						goto l657
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l543
+						goto l657
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l543
+						goto l657
 					}
 					position++
 					{
-						position544, tokenIndex544 := position, tokenIndex
+						position658, tokenIndex658 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l544
+							goto l658
 						}
-						goto l545
-					l544:
-						position, tokenIndex = position544, tokenIndex544
+						goto l659
+					l658:
+						position, tokenIndex = position658, tokenIndex658
 					}
-				l545:
+				l659:
 					if buffer[position] != rune('a') {
-						goto l543
+						goto l657
 					}
 					position++
 					if buffer[position] != rune('f') {
-						goto l543
+						goto l657
 					}
 					position++
 					if buffer[position] != rune('f') {
-						goto l543
+						goto l657
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l543
+						goto l657
 					}
 					position++
-					goto l539
-				l543:
-					position, tokenIndex = position539, tokenIndex539
+					goto l653
+				l657:
+					position, tokenIndex = position653, tokenIndex653
 					if buffer[position] != rune('m') {
-						goto l546
+						goto l660
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l546
+						goto l660
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l546
+						goto l660
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l546
+						goto l660
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l546
+						goto l660
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l546
+						goto l660
 					}
 					position++
-					goto l539
-				l546:
-					position, tokenIndex = position539, tokenIndex539
+					goto l653
+				l660:
+					position, tokenIndex = position653, tokenIndex653
 					if buffer[position] != rune('?') {
-						goto l547
+						goto l661
 					}
 					position++
-					goto l539
-				l547:
-					position, tokenIndex = position539, tokenIndex539
+					goto l653
+				l661:
+					position, tokenIndex = position653, tokenIndex653
 					{
-						position548, tokenIndex548 := position, tokenIndex
+						position662, tokenIndex662 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l549
+							goto l663
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l549
+							goto l663
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l549
+							goto l663
 						}
 						position++
-						goto l548
-					l549:
-						position, tokenIndex = position548, tokenIndex548
+						goto l662
+					l663:
+						position, tokenIndex = position662, tokenIndex662
 						if buffer[position] != rune('n') {
-							goto l550
+							goto l664
 						}
 						position++
 						if buffer[position] != rune('r') {
-							goto l550
+							goto l664
 						}
 						position++
-						goto l548
-					l550:
-						position, tokenIndex = position548, tokenIndex548
+						goto l662
+					l664:
+						position, tokenIndex = position662, tokenIndex662
 						if buffer[position] != rune('s') {
-							goto l551
+							goto l665
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l551
+							goto l665
 						}
 						position++
-						goto l548
-					l551:
-						position, tokenIndex = position548, tokenIndex548
+						goto l662
+					l665:
+						position, tokenIndex = position662, tokenIndex662
 						if buffer[position] != rune('a') {
-							goto l552
+							goto l666
 						}
 						position++
 						if buffer[position] != rune('f') {
-							goto l552
+							goto l666
 						}
 						position++
 						if buffer[position] != rune('f') {
-							goto l552
+							goto l666
 						}
 						position++
-						goto l548
-					l552:
-						position, tokenIndex = position548, tokenIndex548
+						goto l662
+					l666:
+						position, tokenIndex = position662, tokenIndex662
 						if buffer[position] != rune('s') {
-							goto l537
+							goto l651
 						}
 						position++
 						if buffer[position] != rune('p') {
-							goto l537
+							goto l651
 						}
 						position++
 						if buffer[position] != rune('e') {
-							goto l537
+							goto l651
 						}
 						position++
 						if buffer[position] != rune('c') {
-							goto l537
+							goto l651
 						}
 						position++
 						if buffer[position] != rune('i') {
-							goto l537
+							goto l651
 						}
 						position++
 						if buffer[position] != rune('e') {
-							goto l537
+							goto l651
 						}
 						position++
 						if buffer[position] != rune('s') {
-							goto l537
+							goto l651
 						}
 						position++
 					}
-				l548:
+				l662:
 					{
-						position553, tokenIndex553 := position, tokenIndex
+						position667, tokenIndex667 := position, tokenIndex
 						{
-							position555, tokenIndex555 := position, tokenIndex
+							position669, tokenIndex669 := position, tokenIndex
 							if !_rules[ruleSpaceCharEOI]() {
-								goto l554
+								goto l668
 							}
-							position, tokenIndex = position555, tokenIndex555
+							position, tokenIndex = position669, tokenIndex669
 						}
-						goto l553
-					l554:
-						position, tokenIndex = position553, tokenIndex553
+						goto l667
+					l668:
+						position, tokenIndex = position667, tokenIndex667
 						if buffer[position] != rune('.') {
-							goto l537
+							goto l651
 						}
 						position++
 					}
-				l553:
+				l667:
 				}
-			l539:
-				add(ruleApproximation, position538)
+			l653:
+				add(ruleApproximation, position652)
 			}
 			return true
-		l537:
-			position, tokenIndex = position537, tokenIndex537
+		l651:
+			position, tokenIndex = position651, tokenIndex651
 			return false
 		},
-		/* 73 Authorship <- <((AuthorshipCombo / OriginalAuthorship) &(SpaceCharEOI / ';' / ','))> */
+		/* 88 Authorship <- <((AuthorshipCombo / OriginalAuthorship) &(SpaceCharEOI / ';' / ','))> */
 		func() bool {
-			position556, tokenIndex556 := position, tokenIndex
+			position670, tokenIndex670 := position, tokenIndex
 			{
-				position557 := position
+				position671 := position
 				{
-					position558, tokenIndex558 := position, tokenIndex
+					position672, tokenIndex672 := position, tokenIndex
 					if !_rules[ruleAuthorshipCombo]() {
-						goto l559
+						goto l673
 					}
-					goto l558
-				l559:
-					position, tokenIndex = position558, tokenIndex558
+					goto l672
+				l673:
+					position, tokenIndex = position672, tokenIndex672
 					if !_rules[ruleOriginalAuthorship]() {
-						goto l556
+						goto l670
 					}
 				}
-			l558:
+			l672:
 				{
-					position560, tokenIndex560 := position, tokenIndex
+					position674, tokenIndex674 := position, tokenIndex
 					{
-						position561, tokenIndex561 := position, tokenIndex
+						position675, tokenIndex675 := position, tokenIndex
 						if !_rules[ruleSpaceCharEOI]() {
-							goto l562
+							goto l676
 						}
-						goto l561
-					l562:
-						position, tokenIndex = position561, tokenIndex561
+						goto l675
+					l676:
+						position, tokenIndex = position675, tokenIndex675
 						if buffer[position] != rune(';') {
-							goto l563
+							goto l677
 						}
 						position++
-						goto l561
-					l563:
-						position, tokenIndex = position561, tokenIndex561
+						goto l675
+					l677:
+						position, tokenIndex = position675, tokenIndex675
 						if buffer[position] != rune(',') {
-							goto l556
+							goto l670
 						}
 						position++
 					}
-				l561:
-					position, tokenIndex = position560, tokenIndex560
+				l675:
+					position, tokenIndex = position674, tokenIndex674
 				}
-				add(ruleAuthorship, position557)
+				add(ruleAuthorship, position671)
 			}
 			return true
-		l556:
-			position, tokenIndex = position556, tokenIndex556
+		l670:
+			position, tokenIndex = position670, tokenIndex670
 			return false
 		},
-		/* 74 AuthorshipCombo <- <(OriginalAuthorshipComb (_? CombinationAuthorship)?)> */
+		/* 89 AuthorshipCombo <- <(OriginalAuthorshipComb (_? CombinationAuthorship)?)> */
 		func() bool {
-			position564, tokenIndex564 := position, tokenIndex
+			position678, tokenIndex678 := position, tokenIndex
 			{
-				position565 := position
+				position679 := position
 				if !_rules[ruleOriginalAuthorshipComb]() {
-					goto l564
+					goto l678
 				}
 				{
-					position566, tokenIndex566 := position, tokenIndex
+					position680, tokenIndex680 := position, tokenIndex
 					{
-						position568, tokenIndex568 := position, tokenIndex
+						position682, tokenIndex682 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l568
+							goto l682
 						}
-						goto l569
-					l568:
-						position, tokenIndex = position568, tokenIndex568
+						goto l683
+					l682:
+						position, tokenIndex = position682, tokenIndex682
 					}
-				l569:
+				l683:
 					if !_rules[ruleCombinationAuthorship]() {
-						goto l566
+						goto l680
 					}
-					goto l567
-				l566:
-					position, tokenIndex = position566, tokenIndex566
+					goto l681
+				l680:
+					position, tokenIndex = position680, tokenIndex680
 				}
-			l567:
-				add(ruleAuthorshipCombo, position565)
+			l681:
+				add(ruleAuthorshipCombo, position679)
 			}
 			return true
-		l564:
-			position, tokenIndex = position564, tokenIndex564
+		l678:
+			position, tokenIndex = position678, tokenIndex678
 			return false
 		},
-		/* 75 OriginalAuthorship <- <AuthorsGroup> */
+		/* 90 OriginalAuthorship <- <AuthorsGroup> */
 		func() bool {
-			position570, tokenIndex570 := position, tokenIndex
+			position684, tokenIndex684 := position, tokenIndex
 			{
-				position571 := position
+				position685 := position
 				if !_rules[ruleAuthorsGroup]() {
-					goto l570
+					goto l684
 				}
-				add(ruleOriginalAuthorship, position571)
+				add(ruleOriginalAuthorship, position685)
 			}
 			return true
-		l570:
-			position, tokenIndex = position570, tokenIndex570
+		l684:
+			position, tokenIndex = position684, tokenIndex684
 			return false
 		},
-		/* 76 OriginalAuthorshipComb <- <(BasionymAuthorshipYearMisformed / BasionymAuthorship / BasionymAuthorshipMissingParens)> */
+		/* 91 OriginalAuthorshipComb <- <(BasionymAuthorshipYearMisformed / BasionymAuthorship / BasionymAuthorshipMissingParens)> */
 		func() bool {
-			position572, tokenIndex572 := position, tokenIndex
+			position686, tokenIndex686 := position, tokenIndex
 			{
-				position573 := position
+				position687 := position
 				{
-					position574, tokenIndex574 := position, tokenIndex
+					position688, tokenIndex688 := position, tokenIndex
 					if !_rules[ruleBasionymAuthorshipYearMisformed]() {
-						goto l575
+						goto l689
 					}
-					goto l574
-				l575:
-					position, tokenIndex = position574, tokenIndex574
+					goto l688
+				l689:
+					position, tokenIndex = position688, tokenIndex688
 					if !_rules[ruleBasionymAuthorship]() {
-						goto l576
+						goto l690
 					}
-					goto l574
-				l576:
-					position, tokenIndex = position574, tokenIndex574
+					goto l688
+				l690:
+					position, tokenIndex = position688, tokenIndex688
 					if !_rules[ruleBasionymAuthorshipMissingParens]() {
-						goto l572
+						goto l686
 					}
 				}
-			l574:
-				add(ruleOriginalAuthorshipComb, position573)
+			l688:
+				add(ruleOriginalAuthorshipComb, position687)
 			}
 			return true
-		l572:
-			position, tokenIndex = position572, tokenIndex572
+		l686:
+			position, tokenIndex = position686, tokenIndex686
 			return false
 		},
-		/* 77 CombinationAuthorship <- <AuthorsGroup> */
+		/* 92 CombinationAuthorship <- <AuthorsGroup> */
 		func() bool {
-			position577, tokenIndex577 := position, tokenIndex
+			position691, tokenIndex691 := position, tokenIndex
 			{
-				position578 := position
+				position692 := position
 				if !_rules[ruleAuthorsGroup]() {
-					goto l577
+					goto l691
 				}
-				add(ruleCombinationAuthorship, position578)
+				add(ruleCombinationAuthorship, position692)
 			}
 			return true
-		l577:
-			position, tokenIndex = position577, tokenIndex577
+		l691:
+			position, tokenIndex = position691, tokenIndex691
 			return false
 		},
-		/* 78 BasionymAuthorshipMissingParens <- <(MissingParensStart / MissingParensEnd)> */
+		/* 93 BasionymAuthorshipMissingParens <- <(MissingParensStart / MissingParensEnd)> */
 		func() bool {
-			position579, tokenIndex579 := position, tokenIndex
+			position693, tokenIndex693 := position, tokenIndex
 			{
-				position580 := position
+				position694 := position
 				{
-					position581, tokenIndex581 := position, tokenIndex
+					position695, tokenIndex695 := position, tokenIndex
 					if !_rules[ruleMissingParensStart]() {
-						goto l582
+						goto l696
 					}
-					goto l581
-				l582:
-					position, tokenIndex = position581, tokenIndex581
+					goto l695
+				l696:
+					position, tokenIndex = position695, tokenIndex695
 					if !_rules[ruleMissingParensEnd]() {
-						goto l579
+						goto l693
 					}
 				}
-			l581:
-				add(ruleBasionymAuthorshipMissingParens, position580)
+			l695:
+				add(ruleBasionymAuthorshipMissingParens, position694)
 			}
 			return true
-		l579:
-			position, tokenIndex = position579, tokenIndex579
+		l693:
+			position, tokenIndex = position693, tokenIndex693
 			return false
 		},
-		/* 79 MissingParensStart <- <('(' _? AuthorsGroup)> */
+		/* 94 MissingParensStart <- <('(' _? AuthorsGroup)> */
 		func() bool {
-			position583, tokenIndex583 := position, tokenIndex
+			position697, tokenIndex697 := position, tokenIndex
 			{
-				position584 := position
+				position698 := position
 				if buffer[position] != rune('(') {
-					goto l583
+					goto l697
 				}
 				position++
 				{
-					position585, tokenIndex585 := position, tokenIndex
+					position699, tokenIndex699 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l585
+						goto l699
 					}
-					goto l586
-				l585:
-					position, tokenIndex = position585, tokenIndex585
+					goto l700
+				l699:
+					position, tokenIndex = position699, tokenIndex699
 				}
-			l586:
+			l700:
 				if !_rules[ruleAuthorsGroup]() {
-					goto l583
+					goto l697
 				}
-				add(ruleMissingParensStart, position584)
+				add(ruleMissingParensStart, position698)
 			}
 			return true
-		l583:
-			position, tokenIndex = position583, tokenIndex583
+		l697:
+			position, tokenIndex = position697, tokenIndex697
 			return false
 		},
-		/* 80 MissingParensEnd <- <(AuthorsGroup _? ')')> */
+		/* 95 MissingParensEnd <- <(AuthorsGroup _? ')')> */
 		func() bool {
-			position587, tokenIndex587 := position, tokenIndex
+			position701, tokenIndex701 := position, tokenIndex
 			{
-				position588 := position
+				position702 := position
 				if !_rules[ruleAuthorsGroup]() {
-					goto l587
+					goto l701
 				}
 				{
-					position589, tokenIndex589 := position, tokenIndex
+					position703, tokenIndex703 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l589
+						goto l703
 					}
-					goto l590
-				l589:
-					position, tokenIndex = position589, tokenIndex589
+					goto l704
+				l703:
+					position, tokenIndex = position703, tokenIndex703
 				}
-			l590:
+			l704:
 				if buffer[position] != rune(')') {
-					goto l587
+					goto l701
 				}
 				position++
-				add(ruleMissingParensEnd, position588)
+				add(ruleMissingParensEnd, position702)
 			}
 			return true
-		l587:
-			position, tokenIndex = position587, tokenIndex587
+		l701:
+			position, tokenIndex = position701, tokenIndex701
 			return false
 		},
-		/* 81 BasionymAuthorshipYearMisformed <- <('(' _? AuthorsGroup _? ')' (_? ',')? _? Year)> */
+		/* 96 BasionymAuthorshipYearMisformed <- <('(' _? AuthorsGroup _? ')' (_? ',')? _? Year)> */
 		func() bool {
-			position591, tokenIndex591 := position, tokenIndex
+			position705, tokenIndex705 := position, tokenIndex
 			{
-				position592 := position
+				position706 := position
 				if buffer[position] != rune('(') {
-					goto l591
+					goto l705
 				}
 				position++
 				{
-					position593, tokenIndex593 := position, tokenIndex
+					position707, tokenIndex707 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l593
+						goto l707
 					}
-					goto l594
-				l593:
-					position, tokenIndex = position593, tokenIndex593
+					goto l708
+				l707:
+					position, tokenIndex = position707, tokenIndex707
 				}
-			l594:
+			l708:
 				if !_rules[ruleAuthorsGroup]() {
-					goto l591
+					goto l705
 				}
 				{
-					position595, tokenIndex595 := position, tokenIndex
+					position709, tokenIndex709 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l595
+						goto l709
 					}
-					goto l596
-				l595:
-					position, tokenIndex = position595, tokenIndex595
+					goto l710
+				l709:
+					position, tokenIndex = position709, tokenIndex709
 				}
-			l596:
+			l710:
 				if buffer[position] != rune(')') {
-					goto l591
+					goto l705
 				}
 				position++
 				{
-					position597, tokenIndex597 := position, tokenIndex
+					position711, tokenIndex711 := position, tokenIndex
 					{
-						position599, tokenIndex599 := position, tokenIndex
+						position713, tokenIndex713 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l599
+							goto l713
 						}
-						goto l600
-					l599:
-						position, tokenIndex = position599, tokenIndex599
+						goto l714
+					l713:
+						position, tokenIndex = position713, tokenIndex713
 					}
-				l600:
+				l714:
 					if buffer[position] != rune(',') {
-						goto l597
+						goto l711
 					}
 					position++
-					goto l598
-				l597:
-					position, tokenIndex = position597, tokenIndex597
+					goto l712
+				l711:
+					position, tokenIndex = position711, tokenIndex711
 				}
-			l598:
+			l712:
 				{
-					position601, tokenIndex601 := position, tokenIndex
+					position715, tokenIndex715 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l601
+						goto l715
 					}
-					goto l602
-				l601:
-					position, tokenIndex = position601, tokenIndex601
+					goto l716
+				l715:
+					position, tokenIndex = position715, tokenIndex715
 				}
-			l602:
+			l716:
 				if !_rules[ruleYear]() {
-					goto l591
+					goto l705
 				}
-				add(ruleBasionymAuthorshipYearMisformed, position592)
+				add(ruleBasionymAuthorshipYearMisformed, position706)
 			}
 			return true
-		l591:
-			position, tokenIndex = position591, tokenIndex591
+		l705:
+			position, tokenIndex = position705, tokenIndex705
 			return false
 		},
-		/* 82 BasionymAuthorship <- <(BasionymAuthorship1 / BasionymAuthorship2Parens)> */
+		/* 97 BasionymAuthorship <- <(BasionymAuthorship1 / BasionymAuthorship2Parens)> */
 		func() bool {
-			position603, tokenIndex603 := position, tokenIndex
+			position717, tokenIndex717 := position, tokenIndex
 			{
-				position604 := position
+				position718 := position
 				{
-					position605, tokenIndex605 := position, tokenIndex
+					position719, tokenIndex719 := position, tokenIndex
 					if !_rules[ruleBasionymAuthorship1]() {
-						goto l606
+						goto l720
 					}
-					goto l605
-				l606:
-					position, tokenIndex = position605, tokenIndex605
+					goto l719
+				l720:
+					position, tokenIndex = position719, tokenIndex719
 					if !_rules[ruleBasionymAuthorship2Parens]() {
-						goto l603
+						goto l717
 					}
 				}
-			l605:
-				add(ruleBasionymAuthorship, position604)
+			l719:
+				add(ruleBasionymAuthorship, position718)
 			}
 			return true
-		l603:
-			position, tokenIndex = position603, tokenIndex603
+		l717:
+			position, tokenIndex = position717, tokenIndex717
 			return false
 		},
-		/* 83 BasionymAuthorship1 <- <('(' _? AuthorsGroup _? ')')> */
+		/* 98 BasionymAuthorship1 <- <('(' _? AuthorsGroup _? ')')> */
 		func() bool {
-			position607, tokenIndex607 := position, tokenIndex
+			position721, tokenIndex721 := position, tokenIndex
 			{
-				position608 := position
+				position722 := position
 				if buffer[position] != rune('(') {
-					goto l607
+					goto l721
 				}
 				position++
 				{
-					position609, tokenIndex609 := position, tokenIndex
+					position723, tokenIndex723 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l609
+						goto l723
 					}
-					goto l610
-				l609:
-					position, tokenIndex = position609, tokenIndex609
+					goto l724
+				l723:
+					position, tokenIndex = position723, tokenIndex723
 				}
-			l610:
+			l724:
 				if !_rules[ruleAuthorsGroup]() {
-					goto l607
+					goto l721
 				}
 				{
-					position611, tokenIndex611 := position, tokenIndex
+					position725, tokenIndex725 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l611
+						goto l725
 					}
-					goto l612
-				l611:
-					position, tokenIndex = position611, tokenIndex611
+					goto l726
+				l725:
+					position, tokenIndex = position725, tokenIndex725
 				}
-			l612:
+			l726:
 				if buffer[position] != rune(')') {
-					goto l607
+					goto l721
 				}
 				position++
-				add(ruleBasionymAuthorship1, position608)
+				add(ruleBasionymAuthorship1, position722)
 			}
 			return true
-		l607:
-			position, tokenIndex = position607, tokenIndex607
+		l721:
+			position, tokenIndex = position721, tokenIndex721
 			return false
 		},
-		/* 84 BasionymAuthorship2Parens <- <('(' _? '(' _? AuthorsGroup _? ')' _? ')')> */
+		/* 99 BasionymAuthorship2Parens <- <('(' _? '(' _? AuthorsGroup _? ')' _? ')')> */
 		func() bool {
-			position613, tokenIndex613 := position, tokenIndex
+			position727, tokenIndex727 := position, tokenIndex
 			{
-				position614 := position
+				position728 := position
 				if buffer[position] != rune('(') {
-					goto l613
+					goto l727
 				}
 				position++
 				{
-					position615, tokenIndex615 := position, tokenIndex
+					position729, tokenIndex729 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l615
+						goto l729
 					}
-					goto l616
-				l615:
-					position, tokenIndex = position615, tokenIndex615
+					goto l730
+				l729:
+					position, tokenIndex = position729, tokenIndex729
 				}
-			l616:
+			l730:
 				if buffer[position] != rune('(') {
-					goto l613
+					goto l727
 				}
 				position++
 				{
-					position617, tokenIndex617 := position, tokenIndex
+					position731, tokenIndex731 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l617
+						goto l731
 					}
-					goto l618
-				l617:
-					position, tokenIndex = position617, tokenIndex617
+					goto l732
+				l731:
+					position, tokenIndex = position731, tokenIndex731
 				}
-			l618:
+			l732:
 				if !_rules[ruleAuthorsGroup]() {
-					goto l613
+					goto l727
 				}
 				{
-					position619, tokenIndex619 := position, tokenIndex
+					position733, tokenIndex733 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l619
+						goto l733
 					}
-					goto l620
-				l619:
-					position, tokenIndex = position619, tokenIndex619
+					goto l734
+				l733:
+					position, tokenIndex = position733, tokenIndex733
 				}
-			l620:
+			l734:
 				if buffer[position] != rune(')') {
-					goto l613
+					goto l727
 				}
 				position++
 				{
-					position621, tokenIndex621 := position, tokenIndex
+					position735, tokenIndex735 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l621
+						goto l735
 					}
-					goto l622
-				l621:
-					position, tokenIndex = position621, tokenIndex621
+					goto l736
+				l735:
+					position, tokenIndex = position735, tokenIndex735
 				}
-			l622:
+			l736:
 				if buffer[position] != rune(')') {
-					goto l613
+					goto l727
 				}
 				position++
-				add(ruleBasionymAuthorship2Parens, position614)
+				add(ruleBasionymAuthorship2Parens, position728)
 			}
 			return true
-		l613:
-			position, tokenIndex = position613, tokenIndex613
+		l727:
+			position, tokenIndex = position727, tokenIndex727
 			return false
 		},
-		/* 85 AuthorsGroup <- <(AuthorsTeam (_ (AuthorEmend / AuthorEx) AuthorsTeam)?)> */
+		/* 100 AuthorsGroup <- <(AuthorsTeam (_ (AuthorEmend / AuthorEx) AuthorsTeam)?)> */
 		func() bool {
-			position623, tokenIndex623 := position, tokenIndex
+			position737, tokenIndex737 := position, tokenIndex
 			{
-				position624 := position
+				position738 := position
 				if !_rules[ruleAuthorsTeam]() {
-					goto l623
+					goto l737
 				}
 				{
-					position625, tokenIndex625 := position, tokenIndex
+					position739, tokenIndex739 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l625
+						goto l739
 					}
 					{
-						position627, tokenIndex627 := position, tokenIndex
+						position741, tokenIndex741 := position, tokenIndex
 						if !_rules[ruleAuthorEmend]() {
-							goto l628
+							goto l742
 						}
-						goto l627
-					l628:
-						position, tokenIndex = position627, tokenIndex627
+						goto l741
+					l742:
+						position, tokenIndex = position741, tokenIndex741
 						if !_rules[ruleAuthorEx]() {
-							goto l625
+							goto l739
 						}
 					}
-				l627:
+				l741:
 					if !_rules[ruleAuthorsTeam]() {
-						goto l625
+						goto l739
 					}
-					goto l626
-				l625:
-					position, tokenIndex = position625, tokenIndex625
+					goto l740
+				l739:
+					position, tokenIndex = position739, tokenIndex739
 				}
-			l626:
-				add(ruleAuthorsGroup, position624)
+			l740:
+				add(ruleAuthorsGroup, position738)
 			}
 			return true
-		l623:
-			position, tokenIndex = position623, tokenIndex623
+		l737:
+			position, tokenIndex = position737, tokenIndex737
 			return false
 		},
-		/* 86 AuthorsTeam <- <(Author (AuthorSep Author)* (_? ','? _? Year)?)> */
+		/* 101 AuthorsTeam <- <(Author (AuthorSep Author)* (_? ','? _? Year)?)> */
 		func() bool {
-			position629, tokenIndex629 := position, tokenIndex
+			position743, tokenIndex743 := position, tokenIndex
 			{
-				position630 := position
+				position744 := position
 				if !_rules[ruleAuthor]() {
-					goto l629
+					goto l743
 				}
-			l631:
+			l745:
 				{
-					position632, tokenIndex632 := position, tokenIndex
+					position746, tokenIndex746 := position, tokenIndex
 					if !_rules[ruleAuthorSep]() {
-						goto l632
+						goto l746
 					}
 					if !_rules[ruleAuthor]() {
-						goto l632
+						goto l746
 					}
-					goto l631
-				l632:
-					position, tokenIndex = position632, tokenIndex632
+					goto l745
+				l746:
+					position, tokenIndex = position746, tokenIndex746
 				}
 				{
-					position633, tokenIndex633 := position, tokenIndex
+					position747, tokenIndex747 := position, tokenIndex
 					{
-						position635, tokenIndex635 := position, tokenIndex
+						position749, tokenIndex749 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l635
+							goto l749
 						}
-						goto l636
-					l635:
-						position, tokenIndex = position635, tokenIndex635
+						goto l750
+					l749:
+						position, tokenIndex = position749, tokenIndex749
 					}
-				l636:
+				l750:
 					{
-						position637, tokenIndex637 := position, tokenIndex
+						position751, tokenIndex751 := position, tokenIndex
 						if buffer[position] != rune(',') {
-							goto l637
+							goto l751
 						}
 						position++
-						goto l638
-					l637:
-						position, tokenIndex = position637, tokenIndex637
+						goto l752
+					l751:
+						position, tokenIndex = position751, tokenIndex751
 					}
-				l638:
+				l752:
 					{
-						position639, tokenIndex639 := position, tokenIndex
+						position753, tokenIndex753 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l639
+							goto l753
 						}
-						goto l640
-					l639:
-						position, tokenIndex = position639, tokenIndex639
+						goto l754
+					l753:
+						position, tokenIndex = position753, tokenIndex753
 					}
-				l640:
+				l754:
 					if !_rules[ruleYear]() {
-						goto l633
+						goto l747
 					}
-					goto l634
-				l633:
-					position, tokenIndex = position633, tokenIndex633
+					goto l748
+				l747:
+					position, tokenIndex = position747, tokenIndex747
 				}
-			l634:
-				add(ruleAuthorsTeam, position630)
+			l748:
+				add(ruleAuthorsTeam, position744)
 			}
 			return true
-		l629:
-			position, tokenIndex = position629, tokenIndex629
+		l743:
+			position, tokenIndex = position743, tokenIndex743
 			return false
 		},
-		/* 87 AuthorSep <- <(AuthorSep1 / AuthorSep2)> */
+		/* 102 AuthorSep <- <(AuthorSep1 / AuthorSep2)> */
 		func() bool {
-			position641, tokenIndex641 := position, tokenIndex
+			position755, tokenIndex755 := position, tokenIndex
 			{
-				position642 := position
+				position756 := position
 				{
-					position643, tokenIndex643 := position, tokenIndex
+					position757, tokenIndex757 := position, tokenIndex
 					if !_rules[ruleAuthorSep1]() {
-						goto l644
+						goto l758
 					}
-					goto l643
-				l644:
-					position, tokenIndex = position643, tokenIndex643
+					goto l757
+				l758:
+					position, tokenIndex = position757, tokenIndex757
 					if !_rules[ruleAuthorSep2]() {
-						goto l641
+						goto l755
 					}
 				}
-			l643:
-				add(ruleAuthorSep, position642)
+			l757:
+				add(ruleAuthorSep, position756)
 			}
 			return true
-		l641:
-			position, tokenIndex = position641, tokenIndex641
+		l755:
+			position, tokenIndex = position755, tokenIndex755
 			return false
 		},
-		/* 88 AuthorSep1 <- <(_? (',' _)? ('&' / AuthorSepSpanish / ('e' 't') / ('a' 'n' 'd') / ('a' 'p' 'u' 'd')) _?)> */
+		/* 103 AuthorSep1 <- <(_? (',' _)? ('&' / AuthorSepSpanish / ('e' 't') / ('a' 'n' 'd') / ('a' 'p' 'u' 'd')) _?)> */
 		func() bool {
-			position645, tokenIndex645 := position, tokenIndex
+			position759, tokenIndex759 := position, tokenIndex
 			{
-				position646 := position
+				position760 := position
 				{
-					position647, tokenIndex647 := position, tokenIndex
+					position761, tokenIndex761 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l647
+						goto l761
 					}
-					goto l648
-				l647:
-					position, tokenIndex = position647, tokenIndex647
+					goto l762
+				l761:
+					position, tokenIndex = position761, tokenIndex761
 				}
-			l648:
+			l762:
 				{
-					position649, tokenIndex649 := position, tokenIndex
+					position763, tokenIndex763 := position, tokenIndex
 					if buffer[position] != rune(',') {
-						goto l649
+						goto l763
 					}
 					position++
 					if !_rules[rule_]() {
-						goto l649
+						goto l763
 					}
-					goto l650
-				l649:
-					position, tokenIndex = position649, tokenIndex649
+					goto l764
+				l763:
+					position, tokenIndex = position763, tokenIndex763
 				}
-			l650:
+			l764:
 				{
-					position651, tokenIndex651 := position, tokenIndex
+					position765, tokenIndex765 := position, tokenIndex
 					if buffer[position] != rune('&') {
-						goto l652
+						goto l766
 					}
 					position++
-					goto l651
-				l652:
-					position, tokenIndex = position651, tokenIndex651
+					goto l765
+				l766:
+					position, tokenIndex = position765, tokenIndex765
 					if !_rules[ruleAuthorSepSpanish]() {
-						goto l653
+						goto l767
 					}
-					goto l651
-				l653:
-					position, tokenIndex = position651, tokenIndex651
+					goto l765
+				l767:
+					position, tokenIndex = position765, tokenIndex765
 					if buffer[position] != rune('e') {
-						goto l654
+						goto l768
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l654
+						goto l768
 					}
 					position++
-					goto l651
-				l654:
-					position, tokenIndex = position651, tokenIndex651
+					goto l765
+				l768:
+					position, tokenIndex = position765, tokenIndex765
 					if buffer[position] != rune('a') {
-						goto l655
+						goto l769
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l655
+						goto l769
 					}
 					position++
 					if buffer[position] != rune('d') {
-						goto l655
+						goto l769
 					}
 					position++
-					goto l651
-				l655:
-					position, tokenIndex = position651, tokenIndex651
+					goto l765
+				l769:
+					position, tokenIndex = position765, tokenIndex765
 					if buffer[position] != rune('a') {
-						goto l645
+						goto l759
 					}
 					position++
 					if buffer[position] != rune('p') {
-						goto l645
+						goto l759
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l645
+						goto l759
 					}
 					position++
 					if buffer[position] != rune('d') {
-						goto l645
+						goto l759
 					}
 					position++
 				}
-			l651:
+			l765:
 				{
-					position656, tokenIndex656 := position, tokenIndex
+					position770, tokenIndex770 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l656
+						goto l770
 					}
-					goto l657
-				l656:
-					position, tokenIndex = position656, tokenIndex656
+					goto l771
+				l770:
+					position, tokenIndex = position770, tokenIndex770
 				}
-			l657:
-				add(ruleAuthorSep1, position646)
+			l771:
+				add(ruleAuthorSep1, position760)
 			}
 			return true
-		l645:
-			position, tokenIndex = position645, tokenIndex645
+		l759:
+			position, tokenIndex = position759, tokenIndex759
 			return false
 		},
-		/* 89 AuthorSep2 <- <(_? ',' _?)> */
+		/* 104 AuthorSep2 <- <(_? ',' _?)> */
 		func() bool {
-			position658, tokenIndex658 := position, tokenIndex
+			position772, tokenIndex772 := position, tokenIndex
 			{
-				position659 := position
+				position773 := position
 				{
-					position660, tokenIndex660 := position, tokenIndex
+					position774, tokenIndex774 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l660
+						goto l774
 					}
-					goto l661
-				l660:
-					position, tokenIndex = position660, tokenIndex660
+					goto l775
+				l774:
+					position, tokenIndex = position774, tokenIndex774
 				}
-			l661:
+			l775:
 				if buffer[position] != rune(',') {
-					goto l658
+					goto l772
 				}
 				position++
 				{
-					position662, tokenIndex662 := position, tokenIndex
+					position776, tokenIndex776 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l662
+						goto l776
 					}
-					goto l663
-				l662:
-					position, tokenIndex = position662, tokenIndex662
+					goto l777
+				l776:
+					position, tokenIndex = position776, tokenIndex776
 				}
-			l663:
-				add(ruleAuthorSep2, position659)
+			l777:
+				add(ruleAuthorSep2, position773)
 			}
 			return true
-		l658:
-			position, tokenIndex = position658, tokenIndex658
+		l772:
+			position, tokenIndex = position772, tokenIndex772
 			return false
 		},
-		/* 90 AuthorSepSpanish <- <(_? 'y' _?)> */
+		/* 105 AuthorSepSpanish <- <(_? 'y' _?)> */
 		func() bool {
-			position664, tokenIndex664 := position, tokenIndex
+			position778, tokenIndex778 := position, tokenIndex
 			{
-				position665 := position
+				position779 := position
 				{
-					position666, tokenIndex666 := position, tokenIndex
+					position780, tokenIndex780 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l666
+						goto l780
 					}
-					goto l667
-				l666:
-					position, tokenIndex = position666, tokenIndex666
+					goto l781
+				l780:
+					position, tokenIndex = position780, tokenIndex780
 				}
-			l667:
+			l781:
 				if buffer[position] != rune('y') {
-					goto l664
+					goto l778
 				}
 				position++
 				{
-					position668, tokenIndex668 := position, tokenIndex
+					position782, tokenIndex782 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l668
+						goto l782
 					}
-					goto l669
-				l668:
-					position, tokenIndex = position668, tokenIndex668
+					goto l783
+				l782:
+					position, tokenIndex = position782, tokenIndex782
 				}
-			l669:
-				add(ruleAuthorSepSpanish, position665)
+			l783:
+				add(ruleAuthorSepSpanish, position779)
 			}
 			return true
-		l664:
-			position, tokenIndex = position664, tokenIndex664
+		l778:
+			position, tokenIndex = position778, tokenIndex778
 			return false
 		},
-		/* 91 AuthorEx <- <((('e' 'x' '.'?) / ('m' 's' _ ('i' 'n')) / ('i' 'n')) _)> */
+		/* 106 AuthorEx <- <((('e' 'x' '.'?) / ('m' 's' _ ('i' 'n')) / ('i' 'n')) _)> */
 		func() bool {
-			position670, tokenIndex670 := position, tokenIndex
+			position784, tokenIndex784 := position, tokenIndex
 			{
-				position671 := position
+				position785 := position
 				{
-					position672, tokenIndex672 := position, tokenIndex
+					position786, tokenIndex786 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l673
+						goto l787
 					}
 					position++
 					if buffer[position] != rune('x') {
-						goto l673
+						goto l787
 					}
 					position++
 					{
-						position674, tokenIndex674 := position, tokenIndex
+						position788, tokenIndex788 := position, tokenIndex
 						if buffer[position] != rune('.') {
-							goto l674
+							goto l788
 						}
 						position++
-						goto l675
-					l674:
-						position, tokenIndex = position674, tokenIndex674
+						goto l789
+					l788:
+						position, tokenIndex = position788, tokenIndex788
 					}
-				l675:
-					goto l672
-				l673:
-					position, tokenIndex = position672, tokenIndex672
+				l789:
+					goto l786
+				l787:
+					position, tokenIndex = position786, tokenIndex786
 					if buffer[position] != rune('m') {
-						goto l676
+						goto l790
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l676
+						goto l790
 					}
 					position++
 					if !_rules[rule_]() {
-						goto l676
+						goto l790
 					}
 					if buffer[position] != rune('i') {
-						goto l676
+						goto l790
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l676
+						goto l790
 					}
 					position++
-					goto l672
-				l676:
-					position, tokenIndex = position672, tokenIndex672
+					goto l786
+				l790:
+					position, tokenIndex = position786, tokenIndex786
 					if buffer[position] != rune('i') {
-						goto l670
+						goto l784
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l670
+						goto l784
 					}
 					position++
 				}
-			l672:
+			l786:
 				if !_rules[rule_]() {
-					goto l670
+					goto l784
 				}
-				add(ruleAuthorEx, position671)
+				add(ruleAuthorEx, position785)
 			}
 			return true
-		l670:
-			position, tokenIndex = position670, tokenIndex670
+		l784:
+			position, tokenIndex = position784, tokenIndex784
 			return false
 		},
-		/* 92 AuthorEmend <- <('e' 'm' 'e' 'n' 'd' '.'? _)> */
+		/* 107 AuthorEmend <- <('e' 'm' 'e' 'n' 'd' '.'? _)> */
 		func() bool {
-			position677, tokenIndex677 := position, tokenIndex
+			position791, tokenIndex791 := position, tokenIndex
 			{
-				position678 := position
+				position792 := position
 				if buffer[position] != rune('e') {
-					goto l677
+					goto l791
 				}
 				position++
 				if buffer[position] != rune('m') {
-					goto l677
+					goto l791
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l677
+					goto l791
 				}
 				position++
 				if buffer[position] != rune('n') {
-					goto l677
+					goto l791
 				}
 				position++
 				if buffer[position] != rune('d') {
-					goto l677
+					goto l791
 				}
 				position++
 				{
-					position679, tokenIndex679 := position, tokenIndex
+					position793, tokenIndex793 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l679
+						goto l793
 					}
 					position++
-					goto l680
-				l679:
-					position, tokenIndex = position679, tokenIndex679
+					goto l794
+				l793:
+					position, tokenIndex = position793, tokenIndex793
 				}
-			l680:
+			l794:
 				if !_rules[rule_]() {
-					goto l677
+					goto l791
 				}
-				add(ruleAuthorEmend, position678)
+				add(ruleAuthorEmend, position792)
 			}
 			return true
-		l677:
-			position, tokenIndex = position677, tokenIndex677
+		l791:
+			position, tokenIndex = position791, tokenIndex791
 			return false
 		},
-		/* 93 Author <- <((Author0 / Author1 / Author2 / UnknownAuthor) (_ AuthorEtAl)?)> */
+		/* 108 Author <- <((Author0 / Author1 / Author2 / UnknownAuthor) (_ AuthorEtAl)?)> */
 		func() bool {
-			position681, tokenIndex681 := position, tokenIndex
+			position795, tokenIndex795 := position, tokenIndex
 			{
-				position682 := position
+				position796 := position
 				{
-					position683, tokenIndex683 := position, tokenIndex
+					position797, tokenIndex797 := position, tokenIndex
 					if !_rules[ruleAuthor0]() {
-						goto l684
+						goto l798
 					}
-					goto l683
-				l684:
-					position, tokenIndex = position683, tokenIndex683
+					goto l797
+				l798:
+					position, tokenIndex = position797, tokenIndex797
 					if !_rules[ruleAuthor1]() {
-						goto l685
+						goto l799
 					}
-					goto l683
-				l685:
-					position, tokenIndex = position683, tokenIndex683
+					goto l797
+				l799:
+					position, tokenIndex = position797, tokenIndex797
 					if !_rules[ruleAuthor2]() {
-						goto l686
+						goto l800
 					}
-					goto l683
-				l686:
-					position, tokenIndex = position683, tokenIndex683
+					goto l797
+				l800:
+					position, tokenIndex = position797, tokenIndex797
 					if !_rules[ruleUnknownAuthor]() {
-						goto l681
+						goto l795
 					}
 				}
-			l683:
+			l797:
 				{
-					position687, tokenIndex687 := position, tokenIndex
+					position801, tokenIndex801 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l687
+						goto l801
 					}
 					if !_rules[ruleAuthorEtAl]() {
-						goto l687
+						goto l801
 					}
-					goto l688
-				l687:
-					position, tokenIndex = position687, tokenIndex687
+					goto l802
+				l801:
+					position, tokenIndex = position801, tokenIndex801
 				}
-			l688:
-				add(ruleAuthor, position682)
+			l802:
+				add(ruleAuthor, position796)
 			}
 			return true
-		l681:
-			position, tokenIndex = position681, tokenIndex681
+		l795:
+			position, tokenIndex = position795, tokenIndex795
 			return false
 		},
-		/* 94 Author0 <- <(Author2 FiliusFNoSpace)> */
+		/* 109 Author0 <- <(Author2 FiliusFNoSpace)> */
 		func() bool {
-			position689, tokenIndex689 := position, tokenIndex
+			position803, tokenIndex803 := position, tokenIndex
 			{
-				position690 := position
+				position804 := position
 				if !_rules[ruleAuthor2]() {
-					goto l689
+					goto l803
 				}
 				if !_rules[ruleFiliusFNoSpace]() {
-					goto l689
+					goto l803
 				}
-				add(ruleAuthor0, position690)
+				add(ruleAuthor0, position804)
 			}
 			return true
-		l689:
-			position, tokenIndex = position689, tokenIndex689
+		l803:
+			position, tokenIndex = position803, tokenIndex803
 			return false
 		},
-		/* 95 Author1 <- <(Author2 _? (Filius / AuthorSuffix))> */
+		/* 110 Author1 <- <(Author2 _? (Filius / AuthorSuffix))> */
 		func() bool {
-			position691, tokenIndex691 := position, tokenIndex
+			position805, tokenIndex805 := position, tokenIndex
 			{
-				position692 := position
+				position806 := position
 				if !_rules[ruleAuthor2]() {
-					goto l691
+					goto l805
 				}
 				{
-					position693, tokenIndex693 := position, tokenIndex
+					position807, tokenIndex807 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l693
+						goto l807
 					}
-					goto l694
-				l693:
-					position, tokenIndex = position693, tokenIndex693
+					goto l808
+				l807:
+					position, tokenIndex = position807, tokenIndex807
 				}
-			l694:
+			l808:
 				{
-					position695, tokenIndex695 := position, tokenIndex
+					position809, tokenIndex809 := position, tokenIndex
 					if !_rules[ruleFilius]() {
-						goto l696
+						goto l810
 					}
-					goto l695
-				l696:
-					position, tokenIndex = position695, tokenIndex695
+					goto l809
+				l810:
+					position, tokenIndex = position809, tokenIndex809
 					if !_rules[ruleAuthorSuffix]() {
-						goto l691
+						goto l805
 					}
 				}
-			l695:
-				add(ruleAuthor1, position692)
+			l809:
+				add(ruleAuthor1, position806)
 			}
 			return true
-		l691:
-			position, tokenIndex = position691, tokenIndex691
+		l805:
+			position, tokenIndex = position805, tokenIndex805
 			return false
 		},
-		/* 96 Author2 <- <(AuthorWord (_? AuthorWord)*)> */
+		/* 111 Author2 <- <(AuthorWord (_? AuthorWord)*)> */
 		func() bool {
-			position697, tokenIndex697 := position, tokenIndex
+			position811, tokenIndex811 := position, tokenIndex
 			{
-				position698 := position
+				position812 := position
 				if !_rules[ruleAuthorWord]() {
-					goto l697
+					goto l811
 				}
-			l699:
+			l813:
 				{
-					position700, tokenIndex700 := position, tokenIndex
+					position814, tokenIndex814 := position, tokenIndex
 					{
-						position701, tokenIndex701 := position, tokenIndex
+						position815, tokenIndex815 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l701
+							goto l815
 						}
-						goto l702
-					l701:
-						position, tokenIndex = position701, tokenIndex701
+						goto l816
+					l815:
+						position, tokenIndex = position815, tokenIndex815
 					}
-				l702:
+				l816:
 					if !_rules[ruleAuthorWord]() {
-						goto l700
+						goto l814
 					}
-					goto l699
-				l700:
-					position, tokenIndex = position700, tokenIndex700
+					goto l813
+				l814:
+					position, tokenIndex = position814, tokenIndex814
 				}
-				add(ruleAuthor2, position698)
+				add(ruleAuthor2, position812)
 			}
 			return true
-		l697:
-			position, tokenIndex = position697, tokenIndex697
+		l811:
+			position, tokenIndex = position811, tokenIndex811
 			return false
 		},
-		/* 97 UnknownAuthor <- <('?' / ((('a' 'u' 'c' 't') / ('a' 'n' 'o' 'n')) (&SpaceCharEOI / '.')))> */
+		/* 112 UnknownAuthor <- <('?' / ((('a' 'u' 'c' 't') / ('a' 'n' 'o' 'n')) (&SpaceCharEOI / '.')))> */
 		func() bool {
-			position703, tokenIndex703 := position, tokenIndex
+			position817, tokenIndex817 := position, tokenIndex
 			{
-				position704 := position
+				position818 := position
 				{
-					position705, tokenIndex705 := position, tokenIndex
+					position819, tokenIndex819 := position, tokenIndex
 					if buffer[position] != rune('?') {
-						goto l706
+						goto l820
 					}
 					position++
-					goto l705
-				l706:
-					position, tokenIndex = position705, tokenIndex705
+					goto l819
+				l820:
+					position, tokenIndex = position819, tokenIndex819
 					{
-						position707, tokenIndex707 := position, tokenIndex
+						position821, tokenIndex821 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l708
+							goto l822
 						}
 						position++
 						if buffer[position] != rune('u') {
-							goto l708
+							goto l822
 						}
 						position++
 						if buffer[position] != rune('c') {
-							goto l708
+							goto l822
 						}
 						position++
 						if buffer[position] != rune('t') {
-							goto l708
+							goto l822
 						}
 						position++
-						goto l707
-					l708:
-						position, tokenIndex = position707, tokenIndex707
+						goto l821
+					l822:
+						position, tokenIndex = position821, tokenIndex821
 						if buffer[position] != rune('a') {
-							goto l703
+							goto l817
 						}
 						position++
 						if buffer[position] != rune('n') {
-							goto l703
+							goto l817
 						}
 						position++
 						if buffer[position] != rune('o') {
-							goto l703
+							goto l817
 						}
 						position++
 						if buffer[position] != rune('n') {
-							goto l703
+							goto l817
 						}
 						position++
 					}
-				l707:
+				l821:
 					{
-						position709, tokenIndex709 := position, tokenIndex
+						position823, tokenIndex823 := position, tokenIndex
 						{
-							position711, tokenIndex711 := position, tokenIndex
+							position825, tokenIndex825 := position, tokenIndex
 							if !_rules[ruleSpaceCharEOI]() {
-								goto l710
+								goto l824
 							}
-							position, tokenIndex = position711, tokenIndex711
+							position, tokenIndex = position825, tokenIndex825
 						}
-						goto l709
-					l710:
-						position, tokenIndex = position709, tokenIndex709
+						goto l823
+					l824:
+						position, tokenIndex = position823, tokenIndex823
 						if buffer[position] != rune('.') {
-							goto l703
+							goto l817
 						}
 						position++
 					}
-				l709:
+				l823:
 				}
-			l705:
-				add(ruleUnknownAuthor, position704)
+			l819:
+				add(ruleUnknownAuthor, position818)
 			}
 			return true
-		l703:
-			position, tokenIndex = position703, tokenIndex703
+		l817:
+			position, tokenIndex = position817, tokenIndex817
 			return false
 		},
-		/* 98 AuthorWord <- <(!(HybridChar / (('b' / 'B') ('o' / 'O') ('l' / 'L') ('d' / 'D') ':')) (AuthorDashInitials / AuthorWord1 / AuthorWord2 / AuthorWord3 / AuthorWord4 / AuthorPrefix))> */
+		/* 113 AuthorWord <- <(!(HybridChar / (('b' / 'B') ('o' / 'O') ('l' / 'L') ('d' / 'D') ':')) (AuthorDashInitials / AuthorWord1 / AuthorWord2 / AuthorWord3 / AuthorWord4 / AuthorPrefix))> */
 		func() bool {
-			position712, tokenIndex712 := position, tokenIndex
+			position826, tokenIndex826 := position, tokenIndex
 			{
-				position713 := position
+				position827 := position
 				{
-					position714, tokenIndex714 := position, tokenIndex
+					position828, tokenIndex828 := position, tokenIndex
 					{
-						position715, tokenIndex715 := position, tokenIndex
+						position829, tokenIndex829 := position, tokenIndex
 						if !_rules[ruleHybridChar]() {
-							goto l716
+							goto l830
 						}
-						goto l715
-					l716:
-						position, tokenIndex = position715, tokenIndex715
+						goto l829
+					l830:
+						position, tokenIndex = position829, tokenIndex829
 						{
-							position717, tokenIndex717 := position, tokenIndex
+							position831, tokenIndex831 := position, tokenIndex
 							if buffer[position] != rune('b') {
-								goto l718
+								goto l832
 							}
 							position++
-							goto l717
-						l718:
-							position, tokenIndex = position717, tokenIndex717
+							goto l831
+						l832:
+							position, tokenIndex = position831, tokenIndex831
 							if buffer[position] != rune('B') {
-								goto l714
+								goto l828
 							}
 							position++
 						}
-					l717:
+					l831:
 						{
-							position719, tokenIndex719 := position, tokenIndex
+							position833, tokenIndex833 := position, tokenIndex
 							if buffer[position] != rune('o') {
-								goto l720
+								goto l834
 							}
 							position++
-							goto l719
-						l720:
-							position, tokenIndex = position719, tokenIndex719
+							goto l833
+						l834:
+							position, tokenIndex = position833, tokenIndex833
 							if buffer[position] != rune('O') {
-								goto l714
+								goto l828
 							}
 							position++
 						}
-					l719:
+					l833:
 						{
-							position721, tokenIndex721 := position, tokenIndex
+							position835, tokenIndex835 := position, tokenIndex
 							if buffer[position] != rune('l') {
-								goto l722
+								goto l836
 							}
 							position++
-							goto l721
-						l722:
-							position, tokenIndex = position721, tokenIndex721
+							goto l835
+						l836:
+							position, tokenIndex = position835, tokenIndex835
 							if buffer[position] != rune('L') {
-								goto l714
+								goto l828
 							}
 							position++
 						}
-					l721:
+					l835:
 						{
-							position723, tokenIndex723 := position, tokenIndex
+							position837, tokenIndex837 := position, tokenIndex
 							if buffer[position] != rune('d') {
-								goto l724
+								goto l838
 							}
 							position++
-							goto l723
-						l724:
-							position, tokenIndex = position723, tokenIndex723
+							goto l837
+						l838:
+							position, tokenIndex = position837, tokenIndex837
 							if buffer[position] != rune('D') {
-								goto l714
+								goto l828
 							}
 							position++
 						}
-					l723:
+					l837:
 						if buffer[position] != rune(':') {
-							goto l714
+							goto l828
 						}
 						position++
 					}
-				l715:
-					goto l712
-				l714:
-					position, tokenIndex = position714, tokenIndex714
+				l829:
+					goto l826
+				l828:
+					position, tokenIndex = position828, tokenIndex828
 				}
 				{
-					position725, tokenIndex725 := position, tokenIndex
+					position839, tokenIndex839 := position, tokenIndex
 					if !_rules[ruleAuthorDashInitials]() {
-						goto l726
+						goto l840
 					}
-					goto l725
-				l726:
-					position, tokenIndex = position725, tokenIndex725
+					goto l839
+				l840:
+					position, tokenIndex = position839, tokenIndex839
 					if !_rules[ruleAuthorWord1]() {
-						goto l727
+						goto l841
 					}
-					goto l725
-				l727:
-					position, tokenIndex = position725, tokenIndex725
+					goto l839
+				l841:
+					position, tokenIndex = position839, tokenIndex839
 					if !_rules[ruleAuthorWord2]() {
-						goto l728
+						goto l842
 					}
-					goto l725
-				l728:
-					position, tokenIndex = position725, tokenIndex725
+					goto l839
+				l842:
+					position, tokenIndex = position839, tokenIndex839
 					if !_rules[ruleAuthorWord3]() {
-						goto l729
+						goto l843
 					}
-					goto l725
-				l729:
-					position, tokenIndex = position725, tokenIndex725
+					goto l839
+				l843:
+					position, tokenIndex = position839, tokenIndex839
 					if !_rules[ruleAuthorWord4]() {
-						goto l730
+						goto l844
 					}
-					goto l725
-				l730:
-					position, tokenIndex = position725, tokenIndex725
+					goto l839
+				l844:
+					position, tokenIndex = position839, tokenIndex839
 					if !_rules[ruleAuthorPrefix]() {
-						goto l712
+						goto l826
 					}
 				}
-			l725:
-				add(ruleAuthorWord, position713)
+			l839:
+				add(ruleAuthorWord, position827)
 			}
 			return true
-		l712:
-			position, tokenIndex = position712, tokenIndex712
+		l826:
+			position, tokenIndex = position826, tokenIndex826
 			return false
 		},
-		/* 99 AuthorEtAl <- <(('a' 'r' 'g' '.') / ('e' 't' ' ' 'a' 'l' '.' '{' '?' '}') / ((('e' 't') / '&') (' ' 'a' 'l') '.'?))> */
+		/* 114 AuthorEtAl <- <(('a' 'r' 'g' '.') / ('e' 't' ' ' 'a' 'l' '.' '{' '?' '}') / ((('e' 't') / '&') (' ' 'a' 'l') '.'?))> */
 		func() bool {
-			position731, tokenIndex731 := position, tokenIndex
+			position845, tokenIndex845 := position, tokenIndex
 			{
-				position732 := position
+				position846 := position
 				{
-					position733, tokenIndex733 := position, tokenIndex
+					position847, tokenIndex847 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l734
+						goto l848
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l734
+						goto l848
 					}
 					position++
 					if buffer[position] != rune('g') {
-						goto l734
+						goto l848
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l734
+						goto l848
 					}
 					position++
-					goto l733
-				l734:
-					position, tokenIndex = position733, tokenIndex733
+					goto l847
+				l848:
+					position, tokenIndex = position847, tokenIndex847
 					if buffer[position] != rune('e') {
-						goto l735
+						goto l849
 					}
 					position++
 					if buffer[position] != rune('t') {
-						goto l735
+						goto l849
 					}
 					position++
 					if buffer[position] != rune(' ') {
-						goto l735
+						goto l849
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l735
+						goto l849
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l735
+						goto l849
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l735
+						goto l849
 					}
 					position++
 					if buffer[position] != rune('{') {
-						goto l735
+						goto l849
 					}
 					position++
 					if buffer[position] != rune('?') {
-						goto l735
+						goto l849
 					}
 					position++
 					if buffer[position] != rune('}') {
-						goto l735
+						goto l849
 					}
 					position++
-					goto l733
-				l735:
-					position, tokenIndex = position733, tokenIndex733
+					goto l847
+				l849:
+					position, tokenIndex = position847, tokenIndex847
 					{
-						position736, tokenIndex736 := position, tokenIndex
+						position850, tokenIndex850 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l737
+							goto l851
 						}
 						position++
 						if buffer[position] != rune('t') {
-							goto l737
+							goto l851
 						}
 						position++
-						goto l736
-					l737:
-						position, tokenIndex = position736, tokenIndex736
+						goto l850
+					l851:
+						position, tokenIndex = position850, tokenIndex850
 						if buffer[position] != rune('&') {
-							goto l731
+							goto l845
 						}
 						position++
 					}
-				l736:
+				l850:
 					if buffer[position] != rune(' ') {
-						goto l731
+						goto l845
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l731
+						goto l845
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l731
+						goto l845
 					}
 					position++
 					{
-						position738, tokenIndex738 := position, tokenIndex
+						position852, tokenIndex852 := position, tokenIndex
 						if buffer[position] != rune('.') {
-							goto l738
+							goto l852
 						}
 						position++
-						goto l739
-					l738:
-						position, tokenIndex = position738, tokenIndex738
+						goto l853
+					l852:
+						position, tokenIndex = position852, tokenIndex852
 					}
-				l739:
+				l853:
 				}
-			l733:
-				add(ruleAuthorEtAl, position732)
+			l847:
+				add(ruleAuthorEtAl, position846)
 			}
 			return true
-		l731:
-			position, tokenIndex = position731, tokenIndex731
+		l845:
+			position, tokenIndex = position845, tokenIndex845
 			return false
 		},
-		/* 100 AuthorWord1 <- <('d' 'u' 'P' 'o' 'n' 't')> */
+		/* 115 AuthorWord1 <- <('d' 'u' 'P' 'o' 'n' 't')> */
 		func() bool {
-			position740, tokenIndex740 := position, tokenIndex
+			position854, tokenIndex854 := position, tokenIndex
 			{
-				position741 := position
+				position855 := position
 				if buffer[position] != rune('d') {
-					goto l740
+					goto l854
 				}
 				position++
 				if buffer[position] != rune('u') {
-					goto l740
+					goto l854
 				}
 				position++
 				if buffer[position] != rune('P') {
-					goto l740
+					goto l854
 				}
 				position++
 				if buffer[position] != rune('o') {
-					goto l740
+					goto l854
 				}
 				position++
 				if buffer[position] != rune('n') {
-					goto l740
+					goto l854
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l740
+					goto l854
 				}
 				position++
-				add(ruleAuthorWord1, position741)
+				add(ruleAuthorWord1, position855)
 			}
 			return true
-		l740:
-			position, tokenIndex = position740, tokenIndex740
+		l854:
+			position, tokenIndex = position854, tokenIndex854
 			return false
 		},
-		/* 101 AuthorWord2 <- <((AuthorWord3 / AuthorWord4) Dash (AuthorWordSoft / AuthorInitial))> */
+		/* 116 AuthorWord2 <- <((AuthorWord3 / AuthorWord4) Dash (AuthorWordSoft / AuthorInitial))> */
 		func() bool {
-			position742, tokenIndex742 := position, tokenIndex
+			position856, tokenIndex856 := position, tokenIndex
 			{
-				position743 := position
+				position857 := position
 				{
-					position744, tokenIndex744 := position, tokenIndex
+					position858, tokenIndex858 := position, tokenIndex
 					if !_rules[ruleAuthorWord3]() {
-						goto l745
+						goto l859
 					}
-					goto l744
-				l745:
-					position, tokenIndex = position744, tokenIndex744
+					goto l858
+				l859:
+					position, tokenIndex = position858, tokenIndex858
 					if !_rules[ruleAuthorWord4]() {
-						goto l742
+						goto l856
 					}
 				}
-			l744:
+			l858:
 				if !_rules[ruleDash]() {
-					goto l742
+					goto l856
 				}
 				{
-					position746, tokenIndex746 := position, tokenIndex
+					position860, tokenIndex860 := position, tokenIndex
 					if !_rules[ruleAuthorWordSoft]() {
-						goto l747
+						goto l861
 					}
-					goto l746
-				l747:
-					position, tokenIndex = position746, tokenIndex746
+					goto l860
+				l861:
+					position, tokenIndex = position860, tokenIndex860
 					if !_rules[ruleAuthorInitial]() {
-						goto l742
+						goto l856
 					}
 				}
-			l746:
-				add(ruleAuthorWord2, position743)
+			l860:
+				add(ruleAuthorWord2, position857)
 			}
 			return true
-		l742:
-			position, tokenIndex = position742, tokenIndex742
+		l856:
+			position, tokenIndex = position856, tokenIndex856
 			return false
 		},
-		/* 102 AuthorWord3 <- <(AuthorPrefixGlued2 (CapAuthorWord / AuthorLowerChar+) '.'?)> */
+		/* 117 AuthorWord3 <- <(AuthorPrefixGlued2 (CapAuthorWord / AuthorLowerChar+) '.'?)> */
 		func() bool {
-			position748, tokenIndex748 := position, tokenIndex
+			position862, tokenIndex862 := position, tokenIndex
 			{
-				position749 := position
+				position863 := position
 				if !_rules[ruleAuthorPrefixGlued2]() {
-					goto l748
+					goto l862
 				}
 				{
-					position750, tokenIndex750 := position, tokenIndex
+					position864, tokenIndex864 := position, tokenIndex
 					if !_rules[ruleCapAuthorWord]() {
-						goto l751
+						goto l865
 					}
-					goto l750
-				l751:
-					position, tokenIndex = position750, tokenIndex750
+					goto l864
+				l865:
+					position, tokenIndex = position864, tokenIndex864
 					if !_rules[ruleAuthorLowerChar]() {
-						goto l748
+						goto l862
 					}
-				l752:
+				l866:
 					{
-						position753, tokenIndex753 := position, tokenIndex
+						position867, tokenIndex867 := position, tokenIndex
 						if !_rules[ruleAuthorLowerChar]() {
-							goto l753
+							goto l867
 						}
-						goto l752
-					l753:
-						position, tokenIndex = position753, tokenIndex753
+						goto l866
+					l867:
+						position, tokenIndex = position867, tokenIndex867
 					}
 				}
-			l750:
+			l864:
 				{
-					position754, tokenIndex754 := position, tokenIndex
+					position868, tokenIndex868 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l754
+						goto l868
 					}
 					position++
-					goto l755
-				l754:
-					position, tokenIndex = position754, tokenIndex754
+					goto l869
+				l868:
+					position, tokenIndex = position868, tokenIndex868
 				}
-			l755:
-				add(ruleAuthorWord3, position749)
+			l869:
+				add(ruleAuthorWord3, position863)
 			}
 			return true
-		l748:
-			position, tokenIndex = position748, tokenIndex748
+		l862:
+			position, tokenIndex = position862, tokenIndex862
 			return false
 		},
-		/* 103 AuthorWord4 <- <(AuthorPrefixGlued1? (AllCapsAuthorWord / CapAuthorWord) '.'?)> */
+		/* 118 AuthorWord4 <- <(AuthorPrefixGlued1? (AllCapsAuthorWord / CapAuthorWord) '.'?)> */
 		func() bool {
-			position756, tokenIndex756 := position, tokenIndex
+			position870, tokenIndex870 := position, tokenIndex
 			{
-				position757 := position
+				position871 := position
 				{
-					position758, tokenIndex758 := position, tokenIndex
+					position872, tokenIndex872 := position, tokenIndex
 					if !_rules[ruleAuthorPrefixGlued1]() {
-						goto l758
+						goto l872
 					}
-					goto l759
-				l758:
-					position, tokenIndex = position758, tokenIndex758
+					goto l873
+				l872:
+					position, tokenIndex = position872, tokenIndex872
 				}
-			l759:
+			l873:
 				{
-					position760, tokenIndex760 := position, tokenIndex
+					position874, tokenIndex874 := position, tokenIndex
 					if !_rules[ruleAllCapsAuthorWord]() {
-						goto l761
+						goto l875
 					}
-					goto l760
-				l761:
-					position, tokenIndex = position760, tokenIndex760
+					goto l874
+				l875:
+					position, tokenIndex = position874, tokenIndex874
 					if !_rules[ruleCapAuthorWord]() {
-						goto l756
+						goto l870
 					}
 				}
-			l760:
+			l874:
 				{
-					position762, tokenIndex762 := position, tokenIndex
+					position876, tokenIndex876 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l762
+						goto l876
 					}
 					position++
-					goto l763
-				l762:
-					position, tokenIndex = position762, tokenIndex762
+					goto l877
+				l876:
+					position, tokenIndex = position876, tokenIndex876
 				}
-			l763:
-				add(ruleAuthorWord4, position757)
+			l877:
+				add(ruleAuthorWord4, position871)
 			}
 			return true
-		l756:
-			position, tokenIndex = position756, tokenIndex756
+		l870:
+			position, tokenIndex = position870, tokenIndex870
 			return false
 		},
-		/* 104 AuthorDashInitials <- <(AuthorUpperChar '.'? Dash AuthorUpperChar '.'?)> */
+		/* 119 AuthorDashInitials <- <(AuthorUpperChar '.'? Dash AuthorUpperChar '.'?)> */
 		func() bool {
-			position764, tokenIndex764 := position, tokenIndex
+			position878, tokenIndex878 := position, tokenIndex
 			{
-				position765 := position
+				position879 := position
 				if !_rules[ruleAuthorUpperChar]() {
-					goto l764
+					goto l878
 				}
 				{
-					position766, tokenIndex766 := position, tokenIndex
+					position880, tokenIndex880 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l766
+						goto l880
 					}
 					position++
-					goto l767
-				l766:
-					position, tokenIndex = position766, tokenIndex766
+					goto l881
+				l880:
+					position, tokenIndex = position880, tokenIndex880
 				}
-			l767:
+			l881:
 				if !_rules[ruleDash]() {
-					goto l764
+					goto l878
 				}
 				if !_rules[ruleAuthorUpperChar]() {
-					goto l764
+					goto l878
 				}
 				{
-					position768, tokenIndex768 := position, tokenIndex
+					position882, tokenIndex882 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l768
+						goto l882
 					}
 					position++
-					goto l769
-				l768:
-					position, tokenIndex = position768, tokenIndex768
+					goto l883
+				l882:
+					position, tokenIndex = position882, tokenIndex882
 				}
-			l769:
-				add(ruleAuthorDashInitials, position765)
+			l883:
+				add(ruleAuthorDashInitials, position879)
 			}
 			return true
-		l764:
-			position, tokenIndex = position764, tokenIndex764
+		l878:
+			position, tokenIndex = position878, tokenIndex878
 			return false
 		},
-		/* 105 AuthorInitial <- <(AuthorUpperChar '.'?)> */
+		/* 120 AuthorInitial <- <(AuthorUpperChar '.'?)> */
 		func() bool {
-			position770, tokenIndex770 := position, tokenIndex
+			position884, tokenIndex884 := position, tokenIndex
 			{
-				position771 := position
+				position885 := position
 				if !_rules[ruleAuthorUpperChar]() {
-					goto l770
+					goto l884
 				}
 				{
-					position772, tokenIndex772 := position, tokenIndex
+					position886, tokenIndex886 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l772
+						goto l886
 					}
 					position++
-					goto l773
-				l772:
-					position, tokenIndex = position772, tokenIndex772
+					goto l887
+				l886:
+					position, tokenIndex = position886, tokenIndex886
 				}
-			l773:
-				add(ruleAuthorInitial, position771)
+			l887:
+				add(ruleAuthorInitial, position885)
 			}
 			return true
-		l770:
-			position, tokenIndex = position770, tokenIndex770
+		l884:
+			position, tokenIndex = position884, tokenIndex884
 			return false
 		},
-		/* 106 AuthorWordSoft <- <(((AuthorUpperChar (AuthorUpperChar+ / AuthorLowerChar+)) / AuthorLowerChar+) '.'?)> */
+		/* 121 AuthorWordSoft <- <(((AuthorUpperChar (AuthorUpperChar+ / AuthorLowerChar+)) / AuthorLowerChar+) '.'?)> */
 		func() bool {
-			position774, tokenIndex774 := position, tokenIndex
+			position888, tokenIndex888 := position, tokenIndex
 			{
-				position775 := position
+				position889 := position
 				{
-					position776, tokenIndex776 := position, tokenIndex
+					position890, tokenIndex890 := position, tokenIndex
 					if !_rules[ruleAuthorUpperChar]() {
-						goto l777
+						goto l891
 					}
 					{
-						position778, tokenIndex778 := position, tokenIndex
+						position892, tokenIndex892 := position, tokenIndex
 						if !_rules[ruleAuthorUpperChar]() {
-							goto l779
+							goto l893
 						}
-					l780:
+					l894:
 						{
-							position781, tokenIndex781 := position, tokenIndex
+							position895, tokenIndex895 := position, tokenIndex
 							if !_rules[ruleAuthorUpperChar]() {
-								goto l781
+								goto l895
 							}
-							goto l780
-						l781:
-							position, tokenIndex = position781, tokenIndex781
+							goto l894
+						l895:
+							position, tokenIndex = position895, tokenIndex895
 						}
-						goto l778
-					l779:
-						position, tokenIndex = position778, tokenIndex778
+						goto l892
+					l893:
+						position, tokenIndex = position892, tokenIndex892
 						if !_rules[ruleAuthorLowerChar]() {
-							goto l777
+							goto l891
 						}
-					l782:
+					l896:
 						{
-							position783, tokenIndex783 := position, tokenIndex
+							position897, tokenIndex897 := position, tokenIndex
 							if !_rules[ruleAuthorLowerChar]() {
-								goto l783
+								goto l897
 							}
-							goto l782
-						l783:
-							position, tokenIndex = position783, tokenIndex783
+							goto l896
+						l897:
+							position, tokenIndex = position897, tokenIndex897
 						}
 					}
-				l778:
-					goto l776
-				l777:
-					position, tokenIndex = position776, tokenIndex776
+				l892:
+					goto l890
+				l891:
+					position, tokenIndex = position890, tokenIndex890
 					if !_rules[ruleAuthorLowerChar]() {
-						goto l774
+						goto l888
 					}
-				l784:
+				l898:
 					{
-						position785, tokenIndex785 := position, tokenIndex
+						position899, tokenIndex899 := position, tokenIndex
 						if !_rules[ruleAuthorLowerChar]() {
-							goto l785
+							goto l899
 						}
-						goto l784
-					l785:
-						position, tokenIndex = position785, tokenIndex785
+						goto l898
+					l899:
+						position, tokenIndex = position899, tokenIndex899
 					}
 				}
-			l776:
+			l890:
 				{
-					position786, tokenIndex786 := position, tokenIndex
+					position900, tokenIndex900 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l786
+						goto l900
 					}
 					position++
-					goto l787
-				l786:
-					position, tokenIndex = position786, tokenIndex786
+					goto l901
+				l900:
+					position, tokenIndex = position900, tokenIndex900
 				}
-			l787:
-				add(ruleAuthorWordSoft, position775)
+			l901:
+				add(ruleAuthorWordSoft, position889)
 			}
 			return true
-		l774:
-			position, tokenIndex = position774, tokenIndex774
+		l888:
+			position, tokenIndex = position888, tokenIndex888
 			return false
 		},
-		/* 107 CapAuthorWord <- <(AuthorUpperChar AuthorLowerChar*)> */
+		/* 122 CapAuthorWord <- <(AuthorUpperChar AuthorLowerChar*)> */
 		func() bool {
-			position788, tokenIndex788 := position, tokenIndex
+			position902, tokenIndex902 := position, tokenIndex
 			{
-				position789 := position
+				position903 := position
 				if !_rules[ruleAuthorUpperChar]() {
-					goto l788
+					goto l902
 				}
-			l790:
+			l904:
 				{
-					position791, tokenIndex791 := position, tokenIndex
+					position905, tokenIndex905 := position, tokenIndex
 					if !_rules[ruleAuthorLowerChar]() {
-						goto l791
+						goto l905
 					}
-					goto l790
-				l791:
-					position, tokenIndex = position791, tokenIndex791
+					goto l904
+				l905:
+					position, tokenIndex = position905, tokenIndex905
 				}
-				add(ruleCapAuthorWord, position789)
+				add(ruleCapAuthorWord, position903)
 			}
 			return true
-		l788:
-			position, tokenIndex = position788, tokenIndex788
+		l902:
+			position, tokenIndex = position902, tokenIndex902
 			return false
 		},
-		/* 108 AllCapsAuthorWord <- <(AuthorUpperChar AuthorUpperChar+)> */
+		/* 123 AllCapsAuthorWord <- <(AuthorUpperChar AuthorUpperChar+)> */
 		func() bool {
-			position792, tokenIndex792 := position, tokenIndex
+			position906, tokenIndex906 := position, tokenIndex
 			{
-				position793 := position
+				position907 := position
 				if !_rules[ruleAuthorUpperChar]() {
-					goto l792
+					goto l906
 				}
 				if !_rules[ruleAuthorUpperChar]() {
-					goto l792
+					goto l906
 				}
-			l794:
+			l908:
 				{
-					position795, tokenIndex795 := position, tokenIndex
+					position909, tokenIndex909 := position, tokenIndex
 					if !_rules[ruleAuthorUpperChar]() {
-						goto l795
+						goto l909
 					}
-					goto l794
-				l795:
-					position, tokenIndex = position795, tokenIndex795
+					goto l908
+				l909:
+					position, tokenIndex = position909, tokenIndex909
 				}
-				add(ruleAllCapsAuthorWord, position793)
+				add(ruleAllCapsAuthorWord, position907)
 			}
 			return true
-		l792:
-			position, tokenIndex = position792, tokenIndex792
+		l906:
+			position, tokenIndex = position906, tokenIndex906
 			return false
 		},
-		/* 109 Filius <- <(FiliusF / ('f' 'i' 'l' '.') / ('f' 'i' 'l' 'i' 'u' 's'))> */
+		/* 124 Filius <- <(FiliusF / ('f' 'i' 'l' '.') / ('f' 'i' 'l' 'i' 'u' 's'))> */
 		func() bool {
-			position796, tokenIndex796 := position, tokenIndex
+			position910, tokenIndex910 := position, tokenIndex
 			{
-				position797 := position
+				position911 := position
 				{
-					position798, tokenIndex798 := position, tokenIndex
+					position912, tokenIndex912 := position, tokenIndex
 					if !_rules[ruleFiliusF]() {
-						goto l799
+						goto l913
 					}
-					goto l798
-				l799:
-					position, tokenIndex = position798, tokenIndex798
+					goto l912
+				l913:
+					position, tokenIndex = position912, tokenIndex912
 					if buffer[position] != rune('f') {
-						goto l800
+						goto l914
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l800
+						goto l914
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l800
+						goto l914
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l800
+						goto l914
 					}
 					position++
-					goto l798
-				l800:
-					position, tokenIndex = position798, tokenIndex798
+					goto l912
+				l914:
+					position, tokenIndex = position912, tokenIndex912
 					if buffer[position] != rune('f') {
-						goto l796
+						goto l910
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l796
+						goto l910
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l796
+						goto l910
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l796
+						goto l910
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l796
+						goto l910
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l796
+						goto l910
 					}
 					position++
 				}
-			l798:
-				add(ruleFilius, position797)
+			l912:
+				add(ruleFilius, position911)
 			}
 			return true
-		l796:
-			position, tokenIndex = position796, tokenIndex796
+		l910:
+			position, tokenIndex = position910, tokenIndex910
 			return false
 		},
-		/* 110 FiliusF <- <('f' '.' !(_ Word))> */
+		/* 125 FiliusF <- <('f' '.' !(_ Word))> */
 		func() bool {
-			position801, tokenIndex801 := position, tokenIndex
+			position915, tokenIndex915 := position, tokenIndex
 			{
-				position802 := position
+				position916 := position
 				if buffer[position] != rune('f') {
-					goto l801
+					goto l915
 				}
 				position++
 				if buffer[position] != rune('.') {
-					goto l801
+					goto l915
 				}
 				position++
 				{
-					position803, tokenIndex803 := position, tokenIndex
+					position917, tokenIndex917 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l803
+						goto l917
 					}
 					if !_rules[ruleWord]() {
-						goto l803
+						goto l917
 					}
-					goto l801
-				l803:
-					position, tokenIndex = position803, tokenIndex803
+					goto l915
+				l917:
+					position, tokenIndex = position917, tokenIndex917
 				}
-				add(ruleFiliusF, position802)
+				add(ruleFiliusF, position916)
 			}
 			return true
-		l801:
-			position, tokenIndex = position801, tokenIndex801
+		l915:
+			position, tokenIndex = position915, tokenIndex915
 			return false
 		},
-		/* 111 FiliusFNoSpace <- <('f' '.')> */
+		/* 126 FiliusFNoSpace <- <('f' '.')> */
 		func() bool {
-			position804, tokenIndex804 := position, tokenIndex
+			position918, tokenIndex918 := position, tokenIndex
 			{
-				position805 := position
+				position919 := position
 				if buffer[position] != rune('f') {
-					goto l804
+					goto l918
 				}
 				position++
 				if buffer[position] != rune('.') {
-					goto l804
+					goto l918
 				}
 				position++
-				add(ruleFiliusFNoSpace, position805)
+				add(ruleFiliusFNoSpace, position919)
 			}
 			return true
-		l804:
-			position, tokenIndex = position804, tokenIndex804
+		l918:
+			position, tokenIndex = position918, tokenIndex918
 			return false
 		},
-		/* 112 AuthorSuffix <- <(('b' 'i' 's') / ('t' 'e' 'r'))> */
+		/* 127 AuthorSuffix <- <(('b' 'i' 's') / ('t' 'e' 'r'))> */
 		func() bool {
-			position806, tokenIndex806 := position, tokenIndex
+			position920, tokenIndex920 := position, tokenIndex
 			{
-				position807 := position
+				position921 := position
 				{
-					position808, tokenIndex808 := position, tokenIndex
+					position922, tokenIndex922 := position, tokenIndex
 					if buffer[position] != rune('b') {
-						goto l809
+						goto l923
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l809
+						goto l923
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l809
+						goto l923
 					}
 					position++
-					goto l808
-				l809:
-					position, tokenIndex = position808, tokenIndex808
+					goto l922
+				l923:
+					position, tokenIndex = position922, tokenIndex922
 					if buffer[position] != rune('t') {
-						goto l806
+						goto l920
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l806
+						goto l920
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l806
+						goto l920
 					}
 					position++
 				}
-			l808:
-				add(ruleAuthorSuffix, position807)
+			l922:
+				add(ruleAuthorSuffix, position921)
 			}
 			return true
-		l806:
-			position, tokenIndex = position806, tokenIndex806
+		l920:
+			position, tokenIndex = position920, tokenIndex920
 			return false
 		},
-		/* 113 AuthorPrefixGlued1 <- <(('d' / 'O' / 'L' / 'M') Apostrophe)> */
+		/* 128 AuthorPrefixGlued1 <- <(('d' / 'O' / 'L' / 'M') Apostrophe)> */
 		func() bool {
-			position810, tokenIndex810 := position, tokenIndex
+			position924, tokenIndex924 := position, tokenIndex
 			{
-				position811 := position
+				position925 := position
 				{
-					position812, tokenIndex812 := position, tokenIndex
+					position926, tokenIndex926 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l813
+						goto l927
 					}
 					position++
-					goto l812
-				l813:
-					position, tokenIndex = position812, tokenIndex812
+					goto l926
+				l927:
+					position, tokenIndex = position926, tokenIndex926
 					if buffer[position] != rune('O') {
-						goto l814
+						goto l928
 					}
 					position++
-					goto l812
-				l814:
-					position, tokenIndex = position812, tokenIndex812
+					goto l926
+				l928:
+					position, tokenIndex = position926, tokenIndex926
 					if buffer[position] != rune('L') {
-						goto l815
+						goto l929
 					}
 					position++
-					goto l812
-				l815:
-					position, tokenIndex = position812, tokenIndex812
+					goto l926
+				l929:
+					position, tokenIndex = position926, tokenIndex926
 					if buffer[position] != rune('M') {
-						goto l810
+						goto l924
 					}
 					position++
 				}
-			l812:
+			l926:
 				if !_rules[ruleApostrophe]() {
-					goto l810
+					goto l924
 				}
-				add(ruleAuthorPrefixGlued1, position811)
+				add(ruleAuthorPrefixGlued1, position925)
 			}
 			return true
-		l810:
-			position, tokenIndex = position810, tokenIndex810
+		l924:
+			position, tokenIndex = position924, tokenIndex924
 			return false
 		},
-		/* 114 AuthorPrefixGlued2 <- <((('M' 'c') / ('M' 'a' 'c')) Apostrophe?)> */
+		/* 129 AuthorPrefixGlued2 <- <((('M' 'c') / ('M' 'a' 'c')) Apostrophe?)> */
 		func() bool {
-			position816, tokenIndex816 := position, tokenIndex
+			position930, tokenIndex930 := position, tokenIndex
 			{
-				position817 := position
+				position931 := position
 				{
-					position818, tokenIndex818 := position, tokenIndex
+					position932, tokenIndex932 := position, tokenIndex
 					if buffer[position] != rune('M') {
-						goto l819
+						goto l933
 					}
 					position++
 					if buffer[position] != rune('c') {
-						goto l819
+						goto l933
 					}
 					position++
-					goto l818
-				l819:
-					position, tokenIndex = position818, tokenIndex818
+					goto l932
+				l933:
+					position, tokenIndex = position932, tokenIndex932
 					if buffer[position] != rune('M') {
-						goto l816
+						goto l930
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l816
+						goto l930
 					}
 					position++
 					if buffer[position] != rune('c') {
-						goto l816
+						goto l930
 					}
 					position++
 				}
-			l818:
+			l932:
 				{
-					position820, tokenIndex820 := position, tokenIndex
+					position934, tokenIndex934 := position, tokenIndex
 					if !_rules[ruleApostrophe]() {
-						goto l820
+						goto l934
 					}
-					goto l821
-				l820:
-					position, tokenIndex = position820, tokenIndex820
+					goto l935
+				l934:
+					position, tokenIndex = position934, tokenIndex934
 				}
-			l821:
-				add(ruleAuthorPrefixGlued2, position817)
+			l935:
+				add(ruleAuthorPrefixGlued2, position931)
 			}
 			return true
-		l816:
-			position, tokenIndex = position816, tokenIndex816
+		l930:
+			position, tokenIndex = position930, tokenIndex930
 			return false
 		},
-		/* 115 AuthorPrefix <- <(AuthorPrefix1 / AuthorPrefix2)> */
+		/* 130 AuthorPrefix <- <(AuthorPrefix1 / AuthorPrefix2)> */
 		func() bool {
-			position822, tokenIndex822 := position, tokenIndex
+			position936, tokenIndex936 := position, tokenIndex
 			{
-				position823 := position
+				position937 := position
 				{
-					position824, tokenIndex824 := position, tokenIndex
+					position938, tokenIndex938 := position, tokenIndex
 					if !_rules[ruleAuthorPrefix1]() {
-						goto l825
+						goto l939
 					}
-					goto l824
-				l825:
-					position, tokenIndex = position824, tokenIndex824
+					goto l938
+				l939:
+					position, tokenIndex = position938, tokenIndex938
 					if !_rules[ruleAuthorPrefix2]() {
-						goto l822
+						goto l936
 					}
 				}
-			l824:
-				add(ruleAuthorPrefix, position823)
+			l938:
+				add(ruleAuthorPrefix, position937)
 			}
 			return true
-		l822:
-			position, tokenIndex = position822, tokenIndex822
+		l936:
+			position, tokenIndex = position936, tokenIndex936
 			return false
 		},
-		/* 116 AuthorPrefix2 <- <(('v' '.' (_? ('d' '.'))?) / (Apostrophe 't'))> */
+		/* 131 AuthorPrefix2 <- <(('v' '.' (_? ('d' '.'))?) / (Apostrophe 't'))> */
 		func() bool {
-			position826, tokenIndex826 := position, tokenIndex
+			position940, tokenIndex940 := position, tokenIndex
 			{
-				position827 := position
+				position941 := position
 				{
-					position828, tokenIndex828 := position, tokenIndex
+					position942, tokenIndex942 := position, tokenIndex
 					if buffer[position] != rune('v') {
-						goto l829
+						goto l943
 					}
 					position++
 					if buffer[position] != rune('.') {
-						goto l829
+						goto l943
 					}
 					position++
 					{
-						position830, tokenIndex830 := position, tokenIndex
+						position944, tokenIndex944 := position, tokenIndex
 						{
-							position832, tokenIndex832 := position, tokenIndex
+							position946, tokenIndex946 := position, tokenIndex
 							if !_rules[rule_]() {
-								goto l832
+								goto l946
 							}
-							goto l833
-						l832:
-							position, tokenIndex = position832, tokenIndex832
+							goto l947
+						l946:
+							position, tokenIndex = position946, tokenIndex946
 						}
-					l833:
+					l947:
 						if buffer[position] != rune('d') {
-							goto l830
+							goto l944
 						}
 						position++
 						if buffer[position] != rune('.') {
-							goto l830
+							goto l944
 						}
 						position++
-						goto l831
-					l830:
-						position, tokenIndex = position830, tokenIndex830
+						goto l945
+					l944:
+						position, tokenIndex = position944, tokenIndex944
 					}
-				l831:
-					goto l828
-				l829:
-					position, tokenIndex = position828, tokenIndex828
+				l945:
+					goto l942
+				l943:
+					position, tokenIndex = position942, tokenIndex942
 					if !_rules[ruleApostrophe]() {
-						goto l826
+						goto l940
 					}
 					if buffer[position] != rune('t') {
-						goto l826
+						goto l940
 					}
 					position++
 				}
-			l828:
-				add(ruleAuthorPrefix2, position827)
+			l942:
+				add(ruleAuthorPrefix2, position941)
 			}
 			return true
-		l826:
-			position, tokenIndex = position826, tokenIndex826
+		l940:
+			position, tokenIndex = position940, tokenIndex940
 			return false
 		},
-		/* 117 AuthorPrefix1 <- <((('a' 'b') / ('a' 'f') / ('b' 'i' 's') / ('d' 'a') / ('d' 'e' 'r') / ('d' 'e' 's') / ('d' 'e' 'n') / ('d' 'e' 'l' 'l' 'a') / ('d' 'e' 'l' 'a') / ('d' 'e' 'l' 'l' 'e') / ('d' 'e' 'l') / ('d' 'e' ' ' 'l' 'o' 's') / ('d' 'e') / ('d' 'i') / ('d' 'o' 's') / ('d' 'u') / ('d' 'o') / ('e' 'l') / ('l' 'a') / ('l' 'e') / ('t' 'e' 'n') / ('t' 'e' 'r') / ('v' 'a' 'n') / ('v' 'e' 'r') / ('d' Apostrophe) / ('i' 'n' Apostrophe 't') / ('z' 'u' 'r') / ('z' 'u') / ('v' 'o' 'n' (_ (('d' '.') / ('d' 'e' 'm')))?) / ('v' (_ 'd')?)) &_)> */
+		/* 132 AuthorPrefix1 <- <((('a' 'b') / ('a' 'f') / ('b' 'i' 's') / ('d' 'a') / ('d' 'e' 'r') / ('d' 'e' 's') / ('d' 'e' 'n') / ('d' 'e' 'l' 'l' 'a') / ('d' 'e' 'l' 'a') / ('d' 'e' 'l' 'l' 'e') / ('d' 'e' 'l') / ('d' 'e' ' ' 'l' 'o' 's') / ('d' 'e') / ('d' 'i') / ('d' 'o' 's') / ('d' 'u') / ('d' 'o') / ('e' 'l') / ('l' 'a') / ('l' 'e') / ('t' 'e' 'n') / ('t' 'e' 'r') / ('v' 'a' 'n') / ('v' 'e' 'r') / ('d' Apostrophe) / ('i' 'n' Apostrophe 't') / ('z' 'u' 'r') / ('z' 'u') / ('v' 'o' 'n' (_ (('d' '.') / ('d' 'e' 'm')))?) / ('v' (_ 'd')?)) &_)> */
 		func() bool {
-			position834, tokenIndex834 := position, tokenIndex
+			position948, tokenIndex948 := position, tokenIndex
 			{
-				position835 := position
+				position949 := position
 				{
-					position836, tokenIndex836 := position, tokenIndex
+					position950, tokenIndex950 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l837
+						goto l951
 					}
 					position++
 					if buffer[position] != rune('b') {
-						goto l837
+						goto l951
 					}
 					position++
-					goto l836
-				l837:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l951:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('a') {
-						goto l838
+						goto l952
 					}
 					position++
 					if buffer[position] != rune('f') {
-						goto l838
+						goto l952
 					}
 					position++
-					goto l836
-				l838:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l952:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('b') {
-						goto l839
+						goto l953
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l839
+						goto l953
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l839
+						goto l953
 					}
 					position++
-					goto l836
-				l839:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l953:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('d') {
-						goto l840
+						goto l954
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l840
+						goto l954
 					}
 					position++
-					goto l836
-				l840:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l954:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('d') {
-						goto l841
+						goto l955
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l841
+						goto l955
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l841
+						goto l955
 					}
 					position++
-					goto l836
-				l841:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l955:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('d') {
-						goto l842
+						goto l956
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l842
+						goto l956
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l842
+						goto l956
 					}
 					position++
-					goto l836
-				l842:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l956:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('d') {
-						goto l843
+						goto l957
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l843
+						goto l957
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l843
+						goto l957
 					}
 					position++
-					goto l836
-				l843:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l957:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('d') {
-						goto l844
+						goto l958
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l844
+						goto l958
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l844
+						goto l958
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l844
+						goto l958
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l844
+						goto l958
 					}
 					position++
-					goto l836
-				l844:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l958:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('d') {
-						goto l845
+						goto l959
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l845
+						goto l959
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l845
+						goto l959
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l845
+						goto l959
 					}
 					position++
-					goto l836
-				l845:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l959:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('d') {
-						goto l846
+						goto l960
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l846
+						goto l960
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l846
+						goto l960
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l846
+						goto l960
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l846
+						goto l960
 					}
 					position++
-					goto l836
-				l846:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l960:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('d') {
-						goto l847
+						goto l961
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l847
+						goto l961
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l847
+						goto l961
 					}
 					position++
-					goto l836
-				l847:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l961:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('d') {
-						goto l848
+						goto l962
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l848
+						goto l962
 					}
 					position++
 					if buffer[position] != rune(' ') {
-						goto l848
+						goto l962
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l848
+						goto l962
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l848
+						goto l962
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l848
+						goto l962
 					}
 					position++
-					goto l836
-				l848:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l962:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('d') {
-						goto l849
+						goto l963
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l849
+						goto l963
 					}
 					position++
-					goto l836
-				l849:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l963:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('d') {
-						goto l850
+						goto l964
 					}
 					position++
 					if buffer[position] != rune('i') {
-						goto l850
+						goto l964
 					}
 					position++
-					goto l836
-				l850:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l964:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('d') {
-						goto l851
+						goto l965
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l851
+						goto l965
 					}
 					position++
 					if buffer[position] != rune('s') {
-						goto l851
+						goto l965
 					}
 					position++
-					goto l836
-				l851:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l965:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('d') {
-						goto l852
+						goto l966
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l852
+						goto l966
 					}
 					position++
-					goto l836
-				l852:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l966:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('d') {
-						goto l853
+						goto l967
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l853
+						goto l967
 					}
 					position++
-					goto l836
-				l853:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l967:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('e') {
-						goto l854
+						goto l968
 					}
 					position++
 					if buffer[position] != rune('l') {
-						goto l854
+						goto l968
 					}
 					position++
-					goto l836
-				l854:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l968:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('l') {
-						goto l855
+						goto l969
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l855
+						goto l969
 					}
 					position++
-					goto l836
-				l855:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l969:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('l') {
-						goto l856
+						goto l970
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l856
+						goto l970
 					}
 					position++
-					goto l836
-				l856:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l970:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('t') {
-						goto l857
+						goto l971
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l857
+						goto l971
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l857
+						goto l971
 					}
 					position++
-					goto l836
-				l857:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l971:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('t') {
-						goto l858
+						goto l972
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l858
+						goto l972
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l858
+						goto l972
 					}
 					position++
-					goto l836
-				l858:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l972:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('v') {
-						goto l859
+						goto l973
 					}
 					position++
 					if buffer[position] != rune('a') {
-						goto l859
+						goto l973
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l859
+						goto l973
 					}
 					position++
-					goto l836
-				l859:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l973:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('v') {
-						goto l860
+						goto l974
 					}
 					position++
 					if buffer[position] != rune('e') {
-						goto l860
+						goto l974
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l860
+						goto l974
 					}
 					position++
-					goto l836
-				l860:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l974:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('d') {
-						goto l861
+						goto l975
 					}
 					position++
 					if !_rules[ruleApostrophe]() {
-						goto l861
+						goto l975
 					}
-					goto l836
-				l861:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l975:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('i') {
-						goto l862
+						goto l976
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l862
+						goto l976
 					}
 					position++
 					if !_rules[ruleApostrophe]() {
-						goto l862
+						goto l976
 					}
 					if buffer[position] != rune('t') {
-						goto l862
+						goto l976
 					}
 					position++
-					goto l836
-				l862:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l976:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('z') {
-						goto l863
+						goto l977
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l863
+						goto l977
 					}
 					position++
 					if buffer[position] != rune('r') {
-						goto l863
+						goto l977
 					}
 					position++
-					goto l836
-				l863:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l977:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('z') {
-						goto l864
+						goto l978
 					}
 					position++
 					if buffer[position] != rune('u') {
-						goto l864
+						goto l978
 					}
 					position++
-					goto l836
-				l864:
-					position, tokenIndex = position836, tokenIndex836
+					goto l950
+				l978:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('v') {
-						goto l865
+						goto l979
 					}
 					position++
 					if buffer[position] != rune('o') {
-						goto l865
+						goto l979
 					}
 					position++
 					if buffer[position] != rune('n') {
-						goto l865
+						goto l979
 					}
 					position++
 					{
-						position866, tokenIndex866 := position, tokenIndex
+						position980, tokenIndex980 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l866
+							goto l980
 						}
 						{
-							position868, tokenIndex868 := position, tokenIndex
+							position982, tokenIndex982 := position, tokenIndex
 							if buffer[position] != rune('d') {
-								goto l869
+								goto l983
 							}
 							position++
 							if buffer[position] != rune('.') {
-								goto l869
+								goto l983
 							}
 							position++
-							goto l868
-						l869:
-							position, tokenIndex = position868, tokenIndex868
+							goto l982
+						l983:
+							position, tokenIndex = position982, tokenIndex982
 							if buffer[position] != rune('d') {
-								goto l866
+								goto l980
 							}
 							position++
 							if buffer[position] != rune('e') {
-								goto l866
+								goto l980
 							}
 							position++
 							if buffer[position] != rune('m') {
-								goto l866
+								goto l980
 							}
 							position++
 						}
-					l868:
-						goto l867
-					l866:
-						position, tokenIndex = position866, tokenIndex866
+					l982:
+						goto l981
+					l980:
+						position, tokenIndex = position980, tokenIndex980
 					}
-				l867:
-					goto l836
-				l865:
-					position, tokenIndex = position836, tokenIndex836
+				l981:
+					goto l950
+				l979:
+					position, tokenIndex = position950, tokenIndex950
 					if buffer[position] != rune('v') {
-						goto l834
+						goto l948
 					}
 					position++
 					{
-						position870, tokenIndex870 := position, tokenIndex
+						position984, tokenIndex984 := position, tokenIndex
 						if !_rules[rule_]() {
-							goto l870
+							goto l984
 						}
 						if buffer[position] != rune('d') {
-							goto l870
+							goto l984
 						}
 						position++
-						goto l871
-					l870:
-						position, tokenIndex = position870, tokenIndex870
+						goto l985
+					l984:
+						position, tokenIndex = position984, tokenIndex984
 					}
-				l871:
+				l985:
 				}
-			l836:
+			l950:
 				{
-					position872, tokenIndex872 := position, tokenIndex
+					position986, tokenIndex986 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l834
+						goto l948
 					}
-					position, tokenIndex = position872, tokenIndex872
+					position, tokenIndex = position986, tokenIndex986
 				}
-				add(ruleAuthorPrefix1, position835)
+				add(ruleAuthorPrefix1, position949)
 			}
 			return true
-		l834:
-			position, tokenIndex = position834, tokenIndex834
+		l948:
+			position, tokenIndex = position948, tokenIndex948
 			return false
 		},
-		/* 118 AuthorUpperChar <- <(UpperASCII / MiscodedChar / ('À' / 'Á' / 'Â' / 'Ã' / 'Ä' / 'Å' / 'Æ' / 'Ç' / 'È' / 'É' / 'Ê' / 'Ë' / 'Ì' / 'Í' / 'Î' / 'Ï' / 'Ð' / 'Ñ' / 'Ò' / 'Ó' / 'Ô' / 'Õ' / 'Ö' / 'Ø' / 'Ù' / 'Ú' / 'Û' / 'Ü' / 'Ý' / 'Ć' / 'Č' / 'Ď' / 'İ' / 'Ķ' / 'Ĺ' / 'ĺ' / 'Ľ' / 'ľ' / 'Ł' / 'ł' / 'Ņ' / 'Ō' / 'Ő' / 'Œ' / 'Ř' / 'Ś' / 'Ŝ' / 'Ş' / 'Š' / 'Ÿ' / 'Ź' / 'Ż' / 'Ž' / 'ƒ' / 'Ǿ' / 'Ș' / 'Ț'))> */
+		/* 133 AuthorUpperChar <- <(UpperASCII / MiscodedChar / ('À' / 'Á' / 'Â' / 'Ã' / 'Ä' / 'Å' / 'Æ' / 'Ç' / 'È' / 'É' / 'Ê' / 'Ë' / 'Ì' / 'Í' / 'Î' / 'Ï' / 'Ð' / 'Ñ' / 'Ò' / 'Ó' / 'Ô' / 'Õ' / 'Ö' / 'Ø' / 'Ù' / 'Ú' / 'Û' / 'Ü' / 'Ý' / 'Ć' / 'Č' / 'Ď' / 'İ' / 'Ķ' / 'Ĺ' / 'ĺ' / 'Ľ' / 'ľ' / 'Ł' / 'ł' / 'Ņ' / 'Ō' / 'Ő' / 'Œ' / 'Ř' / 'Ś' / 'Ŝ' / 'Ş' / 'Š' / 'Ÿ' / 'Ź' / 'Ż' / 'Ž' / 'ƒ' / 'Ǿ' / 'Ș' / 'Ț'))> */
 		func() bool {
-			position873, tokenIndex873 := position, tokenIndex
+			position987, tokenIndex987 := position, tokenIndex
 			{
-				position874 := position
+				position988 := position
 				{
-					position875, tokenIndex875 := position, tokenIndex
+					position989, tokenIndex989 := position, tokenIndex
 					if !_rules[ruleUpperASCII]() {
-						goto l876
+						goto l990
 					}
-					goto l875
-				l876:
-					position, tokenIndex = position875, tokenIndex875
+					goto l989
+				l990:
+					position, tokenIndex = position989, tokenIndex989
 					if !_rules[ruleMiscodedChar]() {
-						goto l877
+						goto l991
 					}
-					goto l875
-				l877:
-					position, tokenIndex = position875, tokenIndex875
+					goto l989
+				l991:
+					position, tokenIndex = position989, tokenIndex989
 					{
-						position878, tokenIndex878 := position, tokenIndex
+						position992, tokenIndex992 := position, tokenIndex
 						if buffer[position] != rune('À') {
-							goto l879
+							goto l993
 						}
 						position++
-						goto l878
-					l879:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l993:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Á') {
-							goto l880
+							goto l994
 						}
 						position++
-						goto l878
-					l880:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l994:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Â') {
-							goto l881
+							goto l995
 						}
 						position++
-						goto l878
-					l881:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l995:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ã') {
-							goto l882
+							goto l996
 						}
 						position++
-						goto l878
-					l882:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l996:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ä') {
-							goto l883
+							goto l997
 						}
 						position++
-						goto l878
-					l883:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l997:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Å') {
-							goto l884
+							goto l998
 						}
 						position++
-						goto l878
-					l884:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l998:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Æ') {
-							goto l885
+							goto l999
 						}
 						position++
-						goto l878
-					l885:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l999:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ç') {
-							goto l886
+							goto l1000
 						}
 						position++
-						goto l878
-					l886:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1000:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('È') {
-							goto l887
+							goto l1001
 						}
 						position++
-						goto l878
-					l887:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1001:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('É') {
-							goto l888
+							goto l1002
 						}
 						position++
-						goto l878
-					l888:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1002:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ê') {
-							goto l889
+							goto l1003
 						}
 						position++
-						goto l878
-					l889:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1003:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ë') {
-							goto l890
+							goto l1004
 						}
 						position++
-						goto l878
-					l890:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1004:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ì') {
-							goto l891
+							goto l1005
 						}
 						position++
-						goto l878
-					l891:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1005:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Í') {
-							goto l892
+							goto l1006
 						}
 						position++
-						goto l878
-					l892:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1006:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Î') {
-							goto l893
+							goto l1007
 						}
 						position++
-						goto l878
-					l893:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1007:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ï') {
-							goto l894
+							goto l1008
 						}
 						position++
-						goto l878
-					l894:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1008:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ð') {
-							goto l895
+							goto l1009
 						}
 						position++
-						goto l878
-					l895:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1009:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ñ') {
-							goto l896
+							goto l1010
 						}
 						position++
-						goto l878
-					l896:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1010:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ò') {
-							goto l897
+							goto l1011
 						}
 						position++
-						goto l878
-					l897:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1011:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ó') {
-							goto l898
+							goto l1012
 						}
 						position++
-						goto l878
-					l898:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1012:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ô') {
-							goto l899
+							goto l1013
 						}
 						position++
-						goto l878
-					l899:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1013:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Õ') {
-							goto l900
+							goto l1014
 						}
 						position++
-						goto l878
-					l900:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1014:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ö') {
-							goto l901
+							goto l1015
 						}
 						position++
-						goto l878
-					l901:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1015:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ø') {
-							goto l902
+							goto l1016
 						}
 						position++
-						goto l878
-					l902:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1016:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ù') {
-							goto l903
+							goto l1017
 						}
 						position++
-						goto l878
-					l903:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1017:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ú') {
-							goto l904
+							goto l1018
 						}
 						position++
-						goto l878
-					l904:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1018:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Û') {
-							goto l905
+							goto l1019
 						}
 						position++
-						goto l878
-					l905:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1019:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ü') {
-							goto l906
+							goto l1020
 						}
 						position++
-						goto l878
-					l906:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1020:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ý') {
-							goto l907
+							goto l1021
 						}
 						position++
-						goto l878
-					l907:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1021:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ć') {
-							goto l908
+							goto l1022
 						}
 						position++
-						goto l878
-					l908:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1022:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Č') {
-							goto l909
+							goto l1023
 						}
 						position++
-						goto l878
-					l909:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1023:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ď') {
-							goto l910
+							goto l1024
 						}
 						position++
-						goto l878
-					l910:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1024:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('İ') {
-							goto l911
+							goto l1025
 						}
 						position++
-						goto l878
-					l911:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1025:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ķ') {
-							goto l912
+							goto l1026
 						}
 						position++
-						goto l878
-					l912:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1026:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ĺ') {
-							goto l913
+							goto l1027
 						}
 						position++
-						goto l878
-					l913:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1027:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('ĺ') {
-							goto l914
+							goto l1028
 						}
 						position++
-						goto l878
-					l914:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1028:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ľ') {
-							goto l915
+							goto l1029
 						}
 						position++
-						goto l878
-					l915:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1029:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('ľ') {
-							goto l916
+							goto l1030
 						}
 						position++
-						goto l878
-					l916:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1030:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ł') {
-							goto l917
+							goto l1031
 						}
 						position++
-						goto l878
-					l917:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1031:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('ł') {
-							goto l918
+							goto l1032
 						}
 						position++
-						goto l878
-					l918:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1032:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ņ') {
-							goto l919
+							goto l1033
 						}
 						position++
-						goto l878
-					l919:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1033:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ō') {
-							goto l920
+							goto l1034
 						}
 						position++
-						goto l878
-					l920:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1034:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ő') {
-							goto l921
+							goto l1035
 						}
 						position++
-						goto l878
-					l921:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1035:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Œ') {
-							goto l922
+							goto l1036
 						}
 						position++
-						goto l878
-					l922:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1036:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ř') {
-							goto l923
+							goto l1037
 						}
 						position++
-						goto l878
-					l923:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1037:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ś') {
-							goto l924
+							goto l1038
 						}
 						position++
-						goto l878
-					l924:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1038:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ŝ') {
-							goto l925
+							goto l1039
 						}
 						position++
-						goto l878
-					l925:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1039:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ş') {
-							goto l926
+							goto l1040
 						}
 						position++
-						goto l878
-					l926:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1040:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Š') {
-							goto l927
+							goto l1041
 						}
 						position++
-						goto l878
-					l927:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1041:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ÿ') {
-							goto l928
+							goto l1042
 						}
 						position++
-						goto l878
-					l928:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1042:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ź') {
-							goto l929
+							goto l1043
 						}
 						position++
-						goto l878
-					l929:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1043:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ż') {
-							goto l930
+							goto l1044
 						}
 						position++
-						goto l878
-					l930:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1044:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ž') {
-							goto l931
+							goto l1045
 						}
 						position++
-						goto l878
-					l931:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1045:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('ƒ') {
-							goto l932
+							goto l1046
 						}
 						position++
-						goto l878
-					l932:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1046:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ǿ') {
-							goto l933
+							goto l1047
 						}
 						position++
-						goto l878
-					l933:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1047:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ș') {
-							goto l934
+							goto l1048
 						}
 						position++
-						goto l878
-					l934:
-						position, tokenIndex = position878, tokenIndex878
+						goto l992
+					l1048:
+						position, tokenIndex = position992, tokenIndex992
 						if buffer[position] != rune('Ț') {
-							goto l873
+							goto l987
 						}
 						position++
 					}
-				l878:
+				l992:
 				}
-			l875:
-				add(ruleAuthorUpperChar, position874)
+			l989:
+				add(ruleAuthorUpperChar, position988)
 			}
 			return true
-		l873:
-			position, tokenIndex = position873, tokenIndex873
+		l987:
+			position, tokenIndex = position987, tokenIndex987
 			return false
 		},
-		/* 119 AuthorLowerChar <- <(LowerASCII / MiscodedChar / Apostrophe / ('à' / 'á' / 'â' / 'ã' / 'ä' / 'å' / 'æ' / 'ç' / 'è' / 'é' / 'ê' / 'ë' / 'ì' / 'í' / 'î' / 'ï' / 'ð' / 'ñ' / 'ò' / 'ó' / 'ó' / 'ô' / 'õ' / 'ö' / 'ø' / 'ù' / 'ú' / 'û' / 'ü' / 'ý' / 'ÿ' / 'ā' / 'ă' / 'ą' / 'ć' / 'ĉ' / 'č' / 'ď' / 'đ' / 'ē' / 'ĕ' / 'ė' / 'ę' / 'ě' / 'ğ' / 'ī' / 'ĭ' / 'İ' / 'ı' / 'ĺ' / 'ľ' / 'ł' / 'ń' / 'ņ' / 'ň' / 'ŏ' / 'ő' / 'œ' / 'ŕ' / 'ř' / 'ś' / 'ş' / 'š' / 'ţ' / 'ť' / 'ũ' / 'ū' / 'ŭ' / 'ů' / 'ű' / 'ź' / 'ż' / 'ž' / 'ſ' / 'ǎ' / 'ǔ' / 'ǧ' / 'ș' / 'ț' / 'ȳ' / 'ß'))> */
+		/* 134 AuthorLowerChar <- <(LowerASCII / MiscodedChar / Apostrophe / ('à' / 'á' / 'â' / 'ã' / 'ä' / 'å' / 'æ' / 'ç' / 'è' / 'é' / 'ê' / 'ë' / 'ì' / 'í' / 'î' / 'ï' / 'ð' / 'ñ' / 'ò' / 'ó' / 'ó' / 'ô' / 'õ' / 'ö' / 'ø' / 'ù' / 'ú' / 'û' / 'ü' / 'ý' / 'ÿ' / 'ā' / 'ă' / 'ą' / 'ć' / 'ĉ' / 'č' / 'ď' / 'đ' / 'ē' / 'ĕ' / 'ė' / 'ę' / 'ě' / 'ğ' / 'ī' / 'ĭ' / 'İ' / 'ı' / 'ĺ' / 'ľ' / 'ł' / 'ń' / 'ņ' / 'ň' / 'ŏ' / 'ő' / 'œ' / 'ŕ' / 'ř' / 'ś' / 'ş' / 'š' / 'ţ' / 'ť' / 'ũ' / 'ū' / 'ŭ' / 'ů' / 'ű' / 'ź' / 'ż' / 'ž' / 'ſ' / 'ǎ' / 'ǔ' / 'ǧ' / 'ș' / 'ț' / 'ȳ' / 'ß'))> */
 		func() bool {
-			position935, tokenIndex935 := position, tokenIndex
+			position1049, tokenIndex1049 := position, tokenIndex
 			{
-				position936 := position
+				position1050 := position
 				{
-					position937, tokenIndex937 := position, tokenIndex
+					position1051, tokenIndex1051 := position, tokenIndex
 					if !_rules[ruleLowerASCII]() {
-						goto l938
+						goto l1052
 					}
-					goto l937
-				l938:
-					position, tokenIndex = position937, tokenIndex937
+					goto l1051
+				l1052:
+					position, tokenIndex = position1051, tokenIndex1051
 					if !_rules[ruleMiscodedChar]() {
-						goto l939
+						goto l1053
 					}
-					goto l937
-				l939:
-					position, tokenIndex = position937, tokenIndex937
+					goto l1051
+				l1053:
+					position, tokenIndex = position1051, tokenIndex1051
 					if !_rules[ruleApostrophe]() {
-						goto l940
+						goto l1054
 					}
-					goto l937
-				l940:
-					position, tokenIndex = position937, tokenIndex937
+					goto l1051
+				l1054:
+					position, tokenIndex = position1051, tokenIndex1051
 					{
-						position941, tokenIndex941 := position, tokenIndex
+						position1055, tokenIndex1055 := position, tokenIndex
 						if buffer[position] != rune('à') {
-							goto l942
+							goto l1056
 						}
 						position++
-						goto l941
-					l942:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1056:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('á') {
-							goto l943
+							goto l1057
 						}
 						position++
-						goto l941
-					l943:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1057:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('â') {
-							goto l944
+							goto l1058
 						}
 						position++
-						goto l941
-					l944:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1058:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ã') {
-							goto l945
+							goto l1059
 						}
 						position++
-						goto l941
-					l945:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1059:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ä') {
-							goto l946
+							goto l1060
 						}
 						position++
-						goto l941
-					l946:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1060:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('å') {
-							goto l947
+							goto l1061
 						}
 						position++
-						goto l941
-					l947:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1061:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('æ') {
-							goto l948
+							goto l1062
 						}
 						position++
-						goto l941
-					l948:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1062:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ç') {
-							goto l949
+							goto l1063
 						}
 						position++
-						goto l941
-					l949:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1063:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('è') {
-							goto l950
+							goto l1064
 						}
 						position++
-						goto l941
-					l950:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1064:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('é') {
-							goto l951
+							goto l1065
 						}
 						position++
-						goto l941
-					l951:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1065:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ê') {
-							goto l952
+							goto l1066
 						}
 						position++
-						goto l941
-					l952:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1066:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ë') {
-							goto l953
+							goto l1067
 						}
 						position++
-						goto l941
-					l953:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1067:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ì') {
-							goto l954
+							goto l1068
 						}
 						position++
-						goto l941
-					l954:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1068:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('í') {
-							goto l955
+							goto l1069
 						}
 						position++
-						goto l941
-					l955:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1069:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('î') {
-							goto l956
+							goto l1070
 						}
 						position++
-						goto l941
-					l956:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1070:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ï') {
-							goto l957
+							goto l1071
 						}
 						position++
-						goto l941
-					l957:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1071:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ð') {
-							goto l958
+							goto l1072
 						}
 						position++
-						goto l941
-					l958:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1072:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ñ') {
-							goto l959
+							goto l1073
 						}
 						position++
-						goto l941
-					l959:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1073:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ò') {
-							goto l960
+							goto l1074
 						}
 						position++
-						goto l941
-					l960:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1074:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ó') {
-							goto l961
+							goto l1075
 						}
 						position++
-						goto l941
-					l961:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1075:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ó') {
-							goto l962
+							goto l1076
 						}
 						position++
-						goto l941
-					l962:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1076:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ô') {
-							goto l963
+							goto l1077
 						}
 						position++
-						goto l941
-					l963:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1077:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('õ') {
-							goto l964
+							goto l1078
 						}
 						position++
-						goto l941
-					l964:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1078:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ö') {
-							goto l965
+							goto l1079
 						}
 						position++
-						goto l941
-					l965:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1079:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ø') {
-							goto l966
+							goto l1080
 						}
 						position++
-						goto l941
-					l966:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1080:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ù') {
-							goto l967
+							goto l1081
 						}
 						position++
-						goto l941
-					l967:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1081:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ú') {
-							goto l968
+							goto l1082
 						}
 						position++
-						goto l941
-					l968:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1082:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('û') {
-							goto l969
+							goto l1083
 						}
 						position++
-						goto l941
-					l969:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1083:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ü') {
-							goto l970
+							goto l1084
 						}
 						position++
-						goto l941
-					l970:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1084:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ý') {
-							goto l971
+							goto l1085
 						}
 						position++
-						goto l941
-					l971:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1085:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ÿ') {
-							goto l972
+							goto l1086
 						}
 						position++
-						goto l941
-					l972:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1086:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ā') {
-							goto l973
+							goto l1087
 						}
 						position++
-						goto l941
-					l973:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1087:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ă') {
-							goto l974
+							goto l1088
 						}
 						position++
-						goto l941
-					l974:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1088:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ą') {
-							goto l975
+							goto l1089
 						}
 						position++
-						goto l941
-					l975:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1089:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ć') {
-							goto l976
+							goto l1090
 						}
 						position++
-						goto l941
-					l976:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1090:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ĉ') {
-							goto l977
+							goto l1091
 						}
 						position++
-						goto l941
-					l977:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1091:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('č') {
-							goto l978
+							goto l1092
 						}
 						position++
-						goto l941
-					l978:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1092:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ď') {
-							goto l979
+							goto l1093
 						}
 						position++
-						goto l941
-					l979:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1093:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('đ') {
-							goto l980
+							goto l1094
 						}
 						position++
-						goto l941
-					l980:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1094:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ē') {
-							goto l981
+							goto l1095
 						}
 						position++
-						goto l941
-					l981:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1095:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ĕ') {
-							goto l982
+							goto l1096
 						}
 						position++
-						goto l941
-					l982:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1096:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ė') {
-							goto l983
+							goto l1097
 						}
 						position++
-						goto l941
-					l983:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1097:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ę') {
-							goto l984
+							goto l1098
 						}
 						position++
-						goto l941
-					l984:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1098:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ě') {
-							goto l985
+							goto l1099
 						}
 						position++
-						goto l941
-					l985:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1099:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ğ') {
-							goto l986
+							goto l1100
 						}
 						position++
-						goto l941
-					l986:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1100:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ī') {
-							goto l987
+							goto l1101
 						}
 						position++
-						goto l941
-					l987:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1101:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ĭ') {
-							goto l988
+							goto l1102
 						}
 						position++
-						goto l941
-					l988:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1102:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('İ') {
-							goto l989
+							goto l1103
 						}
 						position++
-						goto l941
-					l989:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1103:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ı') {
-							goto l990
+							goto l1104
 						}
 						position++
-						goto l941
-					l990:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1104:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ĺ') {
-							goto l991
+							goto l1105
 						}
 						position++
-						goto l941
-					l991:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1105:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ľ') {
-							goto l992
+							goto l1106
 						}
 						position++
-						goto l941
-					l992:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1106:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ł') {
-							goto l993
+							goto l1107
 						}
 						position++
-						goto l941
-					l993:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1107:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ń') {
-							goto l994
+							goto l1108
 						}
 						position++
-						goto l941
-					l994:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1108:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ņ') {
-							goto l995
+							goto l1109
 						}
 						position++
-						goto l941
-					l995:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1109:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ň') {
-							goto l996
+							goto l1110
 						}
 						position++
-						goto l941
-					l996:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1110:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ŏ') {
-							goto l997
+							goto l1111
 						}
 						position++
-						goto l941
-					l997:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1111:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ő') {
-							goto l998
+							goto l1112
 						}
 						position++
-						goto l941
-					l998:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1112:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('œ') {
-							goto l999
+							goto l1113
 						}
 						position++
-						goto l941
-					l999:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1113:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ŕ') {
-							goto l1000
+							goto l1114
 						}
 						position++
-						goto l941
-					l1000:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1114:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ř') {
-							goto l1001
+							goto l1115
 						}
 						position++
-						goto l941
-					l1001:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1115:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ś') {
-							goto l1002
+							goto l1116
 						}
 						position++
-						goto l941
-					l1002:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1116:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ş') {
-							goto l1003
+							goto l1117
 						}
 						position++
-						goto l941
-					l1003:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1117:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('š') {
-							goto l1004
+							goto l1118
 						}
 						position++
-						goto l941
-					l1004:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1118:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ţ') {
-							goto l1005
+							goto l1119
 						}
 						position++
-						goto l941
-					l1005:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1119:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ť') {
-							goto l1006
+							goto l1120
 						}
 						position++
-						goto l941
-					l1006:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1120:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ũ') {
-							goto l1007
+							goto l1121
 						}
 						position++
-						goto l941
-					l1007:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1121:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ū') {
-							goto l1008
+							goto l1122
 						}
 						position++
-						goto l941
-					l1008:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1122:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ŭ') {
-							goto l1009
+							goto l1123
 						}
 						position++
-						goto l941
-					l1009:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1123:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ů') {
-							goto l1010
+							goto l1124
 						}
 						position++
-						goto l941
-					l1010:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1124:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ű') {
-							goto l1011
+							goto l1125
 						}
 						position++
-						goto l941
-					l1011:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1125:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ź') {
-							goto l1012
+							goto l1126
 						}
 						position++
-						goto l941
-					l1012:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1126:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ż') {
-							goto l1013
+							goto l1127
 						}
 						position++
-						goto l941
-					l1013:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1127:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ž') {
-							goto l1014
+							goto l1128
 						}
 						position++
-						goto l941
-					l1014:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1128:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ſ') {
-							goto l1015
+							goto l1129
 						}
 						position++
-						goto l941
-					l1015:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1129:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ǎ') {
-							goto l1016
+							goto l1130
 						}
 						position++
-						goto l941
-					l1016:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1130:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ǔ') {
-							goto l1017
+							goto l1131
 						}
 						position++
-						goto l941
-					l1017:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1131:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ǧ') {
-							goto l1018
+							goto l1132
 						}
 						position++
-						goto l941
-					l1018:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1132:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ș') {
-							goto l1019
+							goto l1133
 						}
 						position++
-						goto l941
-					l1019:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1133:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ț') {
-							goto l1020
+							goto l1134
 						}
 						position++
-						goto l941
-					l1020:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1134:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ȳ') {
-							goto l1021
+							goto l1135
 						}
 						position++
-						goto l941
-					l1021:
-						position, tokenIndex = position941, tokenIndex941
+						goto l1055
+					l1135:
+						position, tokenIndex = position1055, tokenIndex1055
 						if buffer[position] != rune('ß') {
-							goto l935
+							goto l1049
 						}
 						position++
 					}
-				l941:
+				l1055:
 				}
-			l937:
-				add(ruleAuthorLowerChar, position936)
+			l1051:
+				add(ruleAuthorLowerChar, position1050)
 			}
 			return true
-		l935:
-			position, tokenIndex = position935, tokenIndex935
+		l1049:
+			position, tokenIndex = position1049, tokenIndex1049
 			return false
 		},
-		/* 120 Year <- <(YearRange / YearApprox / YearWithParens / YearWithPage / YearWithDot / YearWithChar / YearNum)> */
+		/* 135 Year <- <(YearRange / YearApprox / YearWithParens / YearWithPage / YearWithDot / YearWithChar / YearNum)> */
 		func() bool {
-			position1022, tokenIndex1022 := position, tokenIndex
+			position1136, tokenIndex1136 := position, tokenIndex
 			{
-				position1023 := position
+				position1137 := position
 				{
-					position1024, tokenIndex1024 := position, tokenIndex
+					position1138, tokenIndex1138 := position, tokenIndex
 					if !_rules[ruleYearRange]() {
-						goto l1025
+						goto l1139
 					}
-					goto l1024
-				l1025:
-					position, tokenIndex = position1024, tokenIndex1024
+					goto l1138
+				l1139:
+					position, tokenIndex = position1138, tokenIndex1138
 					if !_rules[ruleYearApprox]() {
-						goto l1026
+						goto l1140
 					}
-					goto l1024
-				l1026:
-					position, tokenIndex = position1024, tokenIndex1024
+					goto l1138
+				l1140:
+					position, tokenIndex = position1138, tokenIndex1138
 					if !_rules[ruleYearWithParens]() {
-						goto l1027
+						goto l1141
 					}
-					goto l1024
-				l1027:
-					position, tokenIndex = position1024, tokenIndex1024
+					goto l1138
+				l1141:
+					position, tokenIndex = position1138, tokenIndex1138
 					if !_rules[ruleYearWithPage]() {
-						goto l1028
+						goto l1142
 					}
-					goto l1024
-				l1028:
-					position, tokenIndex = position1024, tokenIndex1024
+					goto l1138
+				l1142:
+					position, tokenIndex = position1138, tokenIndex1138
 					if !_rules[ruleYearWithDot]() {
-						goto l1029
+						goto l1143
 					}
-					goto l1024
-				l1029:
-					position, tokenIndex = position1024, tokenIndex1024
+					goto l1138
+				l1143:
+					position, tokenIndex = position1138, tokenIndex1138
 					if !_rules[ruleYearWithChar]() {
-						goto l1030
+						goto l1144
 					}
-					goto l1024
-				l1030:
-					position, tokenIndex = position1024, tokenIndex1024
+					goto l1138
+				l1144:
+					position, tokenIndex = position1138, tokenIndex1138
 					if !_rules[ruleYearNum]() {
-						goto l1022
+						goto l1136
 					}
 				}
-			l1024:
-				add(ruleYear, position1023)
+			l1138:
+				add(ruleYear, position1137)
 			}
 			return true
-		l1022:
-			position, tokenIndex = position1022, tokenIndex1022
+		l1136:
+			position, tokenIndex = position1136, tokenIndex1136
 			return false
 		},
-		/* 121 YearRange <- <(YearNum (Dash / Slash) (Nums+ ('a' / 'b' / 'c' / 'd' / 'e' / 'f' / 'g' / 'h' / 'i' / 'j' / 'k' / 'l' / 'm' / 'n' / 'o' / 'p' / 'q' / 'r' / 's' / 't' / 'u' / 'v' / 'w' / 'x' / 'y' / 'z' / '?')*))> */
+		/* 136 YearRange <- <(YearNum (Dash / Slash) (Nums+ ('a' / 'b' / 'c' / 'd' / 'e' / 'f' / 'g' / 'h' / 'i' / 'j' / 'k' / 'l' / 'm' / 'n' / 'o' / 'p' / 'q' / 'r' / 's' / 't' / 'u' / 'v' / 'w' / 'x' / 'y' / 'z' / '?')*))> */
 		func() bool {
-			position1031, tokenIndex1031 := position, tokenIndex
+			position1145, tokenIndex1145 := position, tokenIndex
 			{
-				position1032 := position
+				position1146 := position
 				if !_rules[ruleYearNum]() {
-					goto l1031
+					goto l1145
 				}
 				{
-					position1033, tokenIndex1033 := position, tokenIndex
+					position1147, tokenIndex1147 := position, tokenIndex
 					if !_rules[ruleDash]() {
-						goto l1034
+						goto l1148
 					}
-					goto l1033
-				l1034:
-					position, tokenIndex = position1033, tokenIndex1033
+					goto l1147
+				l1148:
+					position, tokenIndex = position1147, tokenIndex1147
 					if !_rules[ruleSlash]() {
-						goto l1031
+						goto l1145
 					}
 				}
-			l1033:
+			l1147:
 				if !_rules[ruleNums]() {
-					goto l1031
+					goto l1145
 				}
-			l1035:
+			l1149:
 				{
-					position1036, tokenIndex1036 := position, tokenIndex
+					position1150, tokenIndex1150 := position, tokenIndex
 					if !_rules[ruleNums]() {
-						goto l1036
+						goto l1150
 					}
-					goto l1035
-				l1036:
-					position, tokenIndex = position1036, tokenIndex1036
+					goto l1149
+				l1150:
+					position, tokenIndex = position1150, tokenIndex1150
 				}
-			l1037:
+			l1151:
 				{
-					position1038, tokenIndex1038 := position, tokenIndex
+					position1152, tokenIndex1152 := position, tokenIndex
 					{
-						position1039, tokenIndex1039 := position, tokenIndex
+						position1153, tokenIndex1153 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l1040
+							goto l1154
 						}
 						position++
-						goto l1039
-					l1040:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1154:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('b') {
-							goto l1041
+							goto l1155
 						}
 						position++
-						goto l1039
-					l1041:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1155:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('c') {
-							goto l1042
+							goto l1156
 						}
 						position++
-						goto l1039
-					l1042:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1156:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('d') {
-							goto l1043
+							goto l1157
 						}
 						position++
-						goto l1039
-					l1043:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1157:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('e') {
-							goto l1044
+							goto l1158
 						}
 						position++
-						goto l1039
-					l1044:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1158:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('f') {
-							goto l1045
+							goto l1159
 						}
 						position++
-						goto l1039
-					l1045:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1159:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('g') {
-							goto l1046
+							goto l1160
 						}
 						position++
-						goto l1039
-					l1046:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1160:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('h') {
-							goto l1047
+							goto l1161
 						}
 						position++
-						goto l1039
-					l1047:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1161:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('i') {
-							goto l1048
+							goto l1162
 						}
 						position++
-						goto l1039
-					l1048:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1162:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('j') {
-							goto l1049
+							goto l1163
 						}
 						position++
-						goto l1039
-					l1049:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1163:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('k') {
-							goto l1050
+							goto l1164
 						}
 						position++
-						goto l1039
-					l1050:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1164:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('l') {
-							goto l1051
+							goto l1165
 						}
 						position++
-						goto l1039
-					l1051:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1165:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('m') {
-							goto l1052
+							goto l1166
 						}
 						position++
-						goto l1039
-					l1052:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1166:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('n') {
-							goto l1053
+							goto l1167
 						}
 						position++
-						goto l1039
-					l1053:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1167:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('o') {
-							goto l1054
+							goto l1168
 						}
 						position++
-						goto l1039
-					l1054:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1168:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('p') {
-							goto l1055
+							goto l1169
 						}
 						position++
-						goto l1039
-					l1055:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1169:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('q') {
-							goto l1056
+							goto l1170
 						}
 						position++
-						goto l1039
-					l1056:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1170:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('r') {
-							goto l1057
+							goto l1171
 						}
 						position++
-						goto l1039
-					l1057:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1171:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('s') {
-							goto l1058
+							goto l1172
 						}
 						position++
-						goto l1039
-					l1058:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1172:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('t') {
-							goto l1059
+							goto l1173
 						}
 						position++
-						goto l1039
-					l1059:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1173:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('u') {
-							goto l1060
+							goto l1174
 						}
 						position++
-						goto l1039
-					l1060:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1174:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('v') {
-							goto l1061
+							goto l1175
 						}
 						position++
-						goto l1039
-					l1061:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1175:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('w') {
-							goto l1062
+							goto l1176
 						}
 						position++
-						goto l1039
-					l1062:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1176:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('x') {
-							goto l1063
+							goto l1177
 						}
 						position++
-						goto l1039
-					l1063:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1177:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('y') {
-							goto l1064
+							goto l1178
 						}
 						position++
-						goto l1039
-					l1064:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1178:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('z') {
-							goto l1065
+							goto l1179
 						}
 						position++
-						goto l1039
-					l1065:
-						position, tokenIndex = position1039, tokenIndex1039
+						goto l1153
+					l1179:
+						position, tokenIndex = position1153, tokenIndex1153
 						if buffer[position] != rune('?') {
-							goto l1038
+							goto l1152
 						}
 						position++
 					}
-				l1039:
-					goto l1037
-				l1038:
-					position, tokenIndex = position1038, tokenIndex1038
+				l1153:
+					goto l1151
+				l1152:
+					position, tokenIndex = position1152, tokenIndex1152
 				}
-				add(ruleYearRange, position1032)
+				add(ruleYearRange, position1146)
 			}
 			return true
-		l1031:
-			position, tokenIndex = position1031, tokenIndex1031
+		l1145:
+			position, tokenIndex = position1145, tokenIndex1145
 			return false
 		},
-		/* 122 YearWithDot <- <(YearNum '.')> */
+		/* 137 YearWithDot <- <(YearNum '.')> */
 		func() bool {
-			position1066, tokenIndex1066 := position, tokenIndex
+			position1180, tokenIndex1180 := position, tokenIndex
 			{
-				position1067 := position
+				position1181 := position
 				if !_rules[ruleYearNum]() {
-					goto l1066
+					goto l1180
 				}
 				if buffer[position] != rune('.') {
-					goto l1066
+					goto l1180
 				}
 				position++
-				add(ruleYearWithDot, position1067)
+				add(ruleYearWithDot, position1181)
 			}
 			return true
-		l1066:
-			position, tokenIndex = position1066, tokenIndex1066
+		l1180:
+			position, tokenIndex = position1180, tokenIndex1180
 			return false
 		},
-		/* 123 YearApprox <- <('[' _? YearNum _? ']')> */
+		/* 138 YearApprox <- <('[' _? YearNum _? ']')> */
 		func() bool {
-			position1068, tokenIndex1068 := position, tokenIndex
+			position1182, tokenIndex1182 := position, tokenIndex
 			{
-				position1069 := position
+				position1183 := position
 				if buffer[position] != rune('[') {
-					goto l1068
+					goto l1182
 				}
 				position++
 				{
-					position1070, tokenIndex1070 := position, tokenIndex
+					position1184, tokenIndex1184 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l1070
+						goto l1184
 					}
-					goto l1071
-				l1070:
-					position, tokenIndex = position1070, tokenIndex1070
+					goto l1185
+				l1184:
+					position, tokenIndex = position1184, tokenIndex1184
 				}
-			l1071:
+			l1185:
 				if !_rules[ruleYearNum]() {
-					goto l1068
+					goto l1182
 				}
 				{
-					position1072, tokenIndex1072 := position, tokenIndex
+					position1186, tokenIndex1186 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l1072
+						goto l1186
 					}
-					goto l1073
-				l1072:
-					position, tokenIndex = position1072, tokenIndex1072
+					goto l1187
+				l1186:
+					position, tokenIndex = position1186, tokenIndex1186
 				}
-			l1073:
+			l1187:
 				if buffer[position] != rune(']') {
-					goto l1068
+					goto l1182
 				}
 				position++
-				add(ruleYearApprox, position1069)
+				add(ruleYearApprox, position1183)
 			}
 			return true
-		l1068:
-			position, tokenIndex = position1068, tokenIndex1068
+		l1182:
+			position, tokenIndex = position1182, tokenIndex1182
 			return false
 		},
-		/* 124 YearWithPage <- <((YearWithChar / YearNum) _? ':' _? Nums+)> */
+		/* 139 YearWithPage <- <((YearWithChar / YearNum) _? ':' _? Nums+)> */
 		func() bool {
-			position1074, tokenIndex1074 := position, tokenIndex
+			position1188, tokenIndex1188 := position, tokenIndex
 			{
-				position1075 := position
+				position1189 := position
 				{
-					position1076, tokenIndex1076 := position, tokenIndex
+					position1190, tokenIndex1190 := position, tokenIndex
 					if !_rules[ruleYearWithChar]() {
-						goto l1077
+						goto l1191
 					}
-					goto l1076
-				l1077:
-					position, tokenIndex = position1076, tokenIndex1076
+					goto l1190
+				l1191:
+					position, tokenIndex = position1190, tokenIndex1190
 					if !_rules[ruleYearNum]() {
-						goto l1074
+						goto l1188
 					}
 				}
-			l1076:
+			l1190:
 				{
-					position1078, tokenIndex1078 := position, tokenIndex
+					position1192, tokenIndex1192 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l1078
+						goto l1192
 					}
-					goto l1079
-				l1078:
-					position, tokenIndex = position1078, tokenIndex1078
+					goto l1193
+				l1192:
+					position, tokenIndex = position1192, tokenIndex1192
 				}
-			l1079:
+			l1193:
 				if buffer[position] != rune(':') {
-					goto l1074
+					goto l1188
 				}
 				position++
 				{
-					position1080, tokenIndex1080 := position, tokenIndex
+					position1194, tokenIndex1194 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l1080
+						goto l1194
 					}
-					goto l1081
-				l1080:
-					position, tokenIndex = position1080, tokenIndex1080
+					goto l1195
+				l1194:
+					position, tokenIndex = position1194, tokenIndex1194
 				}
-			l1081:
+			l1195:
 				if !_rules[ruleNums]() {
-					goto l1074
+					goto l1188
 				}
-			l1082:
+			l1196:
 				{
-					position1083, tokenIndex1083 := position, tokenIndex
+					position1197, tokenIndex1197 := position, tokenIndex
 					if !_rules[ruleNums]() {
-						goto l1083
+						goto l1197
 					}
-					goto l1082
-				l1083:
-					position, tokenIndex = position1083, tokenIndex1083
+					goto l1196
+				l1197:
+					position, tokenIndex = position1197, tokenIndex1197
 				}
-				add(ruleYearWithPage, position1075)
+				add(ruleYearWithPage, position1189)
 			}
 			return true
-		l1074:
-			position, tokenIndex = position1074, tokenIndex1074
+		l1188:
+			position, tokenIndex = position1188, tokenIndex1188
 			return false
 		},
-		/* 125 YearWithParens <- <('(' (YearWithChar / YearNum) ')')> */
+		/* 140 YearWithParens <- <('(' (YearWithChar / YearNum) ')')> */
 		func() bool {
-			position1084, tokenIndex1084 := position, tokenIndex
+			position1198, tokenIndex1198 := position, tokenIndex
 			{
-				position1085 := position
+				position1199 := position
 				if buffer[position] != rune('(') {
-					goto l1084
+					goto l1198
 				}
 				position++
 				{
-					position1086, tokenIndex1086 := position, tokenIndex
+					position1200, tokenIndex1200 := position, tokenIndex
 					if !_rules[ruleYearWithChar]() {
-						goto l1087
+						goto l1201
 					}
-					goto l1086
-				l1087:
-					position, tokenIndex = position1086, tokenIndex1086
+					goto l1200
+				l1201:
+					position, tokenIndex = position1200, tokenIndex1200
 					if !_rules[ruleYearNum]() {
-						goto l1084
+						goto l1198
 					}
 				}
-			l1086:
+			l1200:
 				if buffer[position] != rune(')') {
-					goto l1084
+					goto l1198
 				}
 				position++
-				add(ruleYearWithParens, position1085)
+				add(ruleYearWithParens, position1199)
 			}
 			return true
-		l1084:
-			position, tokenIndex = position1084, tokenIndex1084
+		l1198:
+			position, tokenIndex = position1198, tokenIndex1198
 			return false
 		},
-		/* 126 YearWithChar <- <(YearNum LowerASCII)> */
+		/* 141 YearWithChar <- <(YearNum LowerASCII)> */
 		func() bool {
-			position1088, tokenIndex1088 := position, tokenIndex
+			position1202, tokenIndex1202 := position, tokenIndex
 			{
-				position1089 := position
+				position1203 := position
 				if !_rules[ruleYearNum]() {
-					goto l1088
+					goto l1202
 				}
 				if !_rules[ruleLowerASCII]() {
-					goto l1088
+					goto l1202
 				}
-				add(ruleYearWithChar, position1089)
+				add(ruleYearWithChar, position1203)
 			}
 			return true
-		l1088:
-			position, tokenIndex = position1088, tokenIndex1088
+		l1202:
+			position, tokenIndex = position1202, tokenIndex1202
 			return false
 		},
-		/* 127 YearNum <- <(('1' / '2') ('0' / '7' / '8' / '9') Nums (Nums / '?') '?'*)> */
+		/* 142 YearNum <- <(('1' / '2') ('0' / '7' / '8' / '9') Nums (Nums / '?') '?'*)> */
 		func() bool {
-			position1090, tokenIndex1090 := position, tokenIndex
+			position1204, tokenIndex1204 := position, tokenIndex
 			{
-				position1091 := position
+				position1205 := position
 				{
-					position1092, tokenIndex1092 := position, tokenIndex
+					position1206, tokenIndex1206 := position, tokenIndex
 					if buffer[position] != rune('1') {
-						goto l1093
+						goto l1207
 					}
 					position++
-					goto l1092
-				l1093:
-					position, tokenIndex = position1092, tokenIndex1092
+					goto l1206
+				l1207:
+					position, tokenIndex = position1206, tokenIndex1206
 					if buffer[position] != rune('2') {
-						goto l1090
+						goto l1204
 					}
 					position++
 				}
-			l1092:
+			l1206:
 				{
-					position1094, tokenIndex1094 := position, tokenIndex
+					position1208, tokenIndex1208 := position, tokenIndex
 					if buffer[position] != rune('0') {
-						goto l1095
+						goto l1209
 					}
 					position++
-					goto l1094
-				l1095:
-					position, tokenIndex = position1094, tokenIndex1094
+					goto l1208
+				l1209:
+					position, tokenIndex = position1208, tokenIndex1208
 					if buffer[position] != rune('7') {
-						goto l1096
+						goto l1210
 					}
 					position++
-					goto l1094
-				l1096:
-					position, tokenIndex = position1094, tokenIndex1094
+					goto l1208
+				l1210:
+					position, tokenIndex = position1208, tokenIndex1208
 					if buffer[position] != rune('8') {
-						goto l1097
+						goto l1211
 					}
 					position++
-					goto l1094
-				l1097:
-					position, tokenIndex = position1094, tokenIndex1094
+					goto l1208
+				l1211:
+					position, tokenIndex = position1208, tokenIndex1208
 					if buffer[position] != rune('9') {
-						goto l1090
+						goto l1204
 					}
 					position++
 				}
-			l1094:
+			l1208:
 				if !_rules[ruleNums]() {
-					goto l1090
+					goto l1204
 				}
 				{
-					position1098, tokenIndex1098 := position, tokenIndex
+					position1212, tokenIndex1212 := position, tokenIndex
 					if !_rules[ruleNums]() {
-						goto l1099
+						goto l1213
 					}
-					goto l1098
-				l1099:
-					position, tokenIndex = position1098, tokenIndex1098
+					goto l1212
+				l1213:
+					position, tokenIndex = position1212, tokenIndex1212
 					if buffer[position] != rune('?') {
-						goto l1090
+						goto l1204
 					}
 					position++
 				}
-			l1098:
-			l1100:
+			l1212:
+			l1214:
 				{
-					position1101, tokenIndex1101 := position, tokenIndex
+					position1215, tokenIndex1215 := position, tokenIndex
 					if buffer[position] != rune('?') {
-						goto l1101
+						goto l1215
 					}
 					position++
-					goto l1100
-				l1101:
-					position, tokenIndex = position1101, tokenIndex1101
+					goto l1214
+				l1215:
+					position, tokenIndex = position1215, tokenIndex1215
 				}
-				add(ruleYearNum, position1091)
+				add(ruleYearNum, position1205)
 			}
 			return true
-		l1090:
-			position, tokenIndex = position1090, tokenIndex1090
+		l1204:
+			position, tokenIndex = position1204, tokenIndex1204
 			return false
 		},
-		/* 128 NameUpperChar <- <(UpperChar / UpperCharExtended)> */
+		/* 143 NameUpperChar <- <(UpperChar / UpperCharExtended)> */
 		func() bool {
-			position1102, tokenIndex1102 := position, tokenIndex
+			position1216, tokenIndex1216 := position, tokenIndex
 			{
-				position1103 := position
+				position1217 := position
 				{
-					position1104, tokenIndex1104 := position, tokenIndex
+					position1218, tokenIndex1218 := position, tokenIndex
 					if !_rules[ruleUpperChar]() {
-						goto l1105
+						goto l1219
 					}
-					goto l1104
-				l1105:
-					position, tokenIndex = position1104, tokenIndex1104
+					goto l1218
+				l1219:
+					position, tokenIndex = position1218, tokenIndex1218
 					if !_rules[ruleUpperCharExtended]() {
-						goto l1102
+						goto l1216
 					}
 				}
-			l1104:
-				add(ruleNameUpperChar, position1103)
+			l1218:
+				add(ruleNameUpperChar, position1217)
 			}
 			return true
-		l1102:
-			position, tokenIndex = position1102, tokenIndex1102
+		l1216:
+			position, tokenIndex = position1216, tokenIndex1216
 			return false
 		},
-		/* 129 UpperCharExtended <- <('Æ' / 'Œ' / 'Ö')> */
+		/* 144 UpperCharExtended <- <('Æ' / 'Œ' / 'Ö')> */
 		func() bool {
-			position1106, tokenIndex1106 := position, tokenIndex
+			position1220, tokenIndex1220 := position, tokenIndex
 			{
-				position1107 := position
+				position1221 := position
 				{
-					position1108, tokenIndex1108 := position, tokenIndex
+					position1222, tokenIndex1222 := position, tokenIndex
 					if buffer[position] != rune('Æ') {
-						goto l1109
+						goto l1223
 					}
 					position++
-					goto l1108
-				l1109:
-					position, tokenIndex = position1108, tokenIndex1108
+					goto l1222
+				l1223:
+					position, tokenIndex = position1222, tokenIndex1222
 					if buffer[position] != rune('Œ') {
-						goto l1110
+						goto l1224
 					}
 					position++
-					goto l1108
-				l1110:
-					position, tokenIndex = position1108, tokenIndex1108
+					goto l1222
+				l1224:
+					position, tokenIndex = position1222, tokenIndex1222
 					if buffer[position] != rune('Ö') {
-						goto l1106
+						goto l1220
 					}
 					position++
 				}
-			l1108:
-				add(ruleUpperCharExtended, position1107)
+			l1222:
+				add(ruleUpperCharExtended, position1221)
 			}
 			return true
-		l1106:
-			position, tokenIndex = position1106, tokenIndex1106
+		l1220:
+			position, tokenIndex = position1220, tokenIndex1220
 			return false
 		},
-		/* 130 UpperChar <- <UpperASCII> */
+		/* 145 UpperChar <- <UpperASCII> */
 		func() bool {
-			position1111, tokenIndex1111 := position, tokenIndex
+			position1225, tokenIndex1225 := position, tokenIndex
 			{
-				position1112 := position
+				position1226 := position
 				if !_rules[ruleUpperASCII]() {
-					goto l1111
+					goto l1225
 				}
-				add(ruleUpperChar, position1112)
+				add(ruleUpperChar, position1226)
 			}
 			return true
-		l1111:
-			position, tokenIndex = position1111, tokenIndex1111
+		l1225:
+			position, tokenIndex = position1225, tokenIndex1225
 			return false
 		},
-		/* 131 NameLowerChar <- <(LowerChar / LowerCharExtended / MiscodedChar)> */
+		/* 146 NameLowerChar <- <(LowerChar / LowerCharExtended / MiscodedChar)> */
 		func() bool {
-			position1113, tokenIndex1113 := position, tokenIndex
+			position1227, tokenIndex1227 := position, tokenIndex
 			{
-				position1114 := position
+				position1228 := position
 				{
-					position1115, tokenIndex1115 := position, tokenIndex
+					position1229, tokenIndex1229 := position, tokenIndex
 					if !_rules[ruleLowerChar]() {
-						goto l1116
+						goto l1230
 					}
-					goto l1115
-				l1116:
-					position, tokenIndex = position1115, tokenIndex1115
+					goto l1229
+				l1230:
+					position, tokenIndex = position1229, tokenIndex1229
 					if !_rules[ruleLowerCharExtended]() {
-						goto l1117
+						goto l1231
 					}
-					goto l1115
-				l1117:
-					position, tokenIndex = position1115, tokenIndex1115
+					goto l1229
+				l1231:
+					position, tokenIndex = position1229, tokenIndex1229
 					if !_rules[ruleMiscodedChar]() {
-						goto l1113
+						goto l1227
 					}
 				}
-			l1115:
-				add(ruleNameLowerChar, position1114)
+			l1229:
+				add(ruleNameLowerChar, position1228)
 			}
 			return true
-		l1113:
-			position, tokenIndex = position1113, tokenIndex1113
+		l1227:
+			position, tokenIndex = position1227, tokenIndex1227
 			return false
 		},
-		/* 132 MiscodedChar <- <'�'> */
+		/* 147 MiscodedChar <- <'�'> */
 		func() bool {
-			position1118, tokenIndex1118 := position, tokenIndex
+			position1232, tokenIndex1232 := position, tokenIndex
 			{
-				position1119 := position
+				position1233 := position
 				if buffer[position] != rune('�') {
-					goto l1118
+					goto l1232
 				}
 				position++
-				add(ruleMiscodedChar, position1119)
+				add(ruleMiscodedChar, position1233)
 			}
 			return true
-		l1118:
-			position, tokenIndex = position1118, tokenIndex1118
+		l1232:
+			position, tokenIndex = position1232, tokenIndex1232
 			return false
 		},
-		/* 133 LowerCharExtended <- <('æ' / 'œ' / 'à' / 'â' / 'å' / 'ã' / 'ä' / 'á' / 'ç' / 'č' / 'é' / 'è' / 'ë' / 'í' / 'ì' / 'ï' / 'ň' / 'ñ' / 'ñ' / 'ó' / 'ò' / 'ô' / 'ø' / 'õ' / 'ö' / 'ú' / 'û' / 'ù' / 'ü' / 'ŕ' / 'ř' / 'ŗ' / 'ſ' / 'š' / 'š' / 'ş' / 'ß' / 'ž')> */
+		/* 148 LowerCharExtended <- <('æ' / 'œ' / 'à' / 'â' / 'å' / 'ã' / 'ä' / 'á' / 'ç' / 'č' / 'é' / 'è' / 'ë' / 'í' / 'ì' / 'ï' / 'ň' / 'ñ' / 'ñ' / 'ó' / 'ò' / 'ô' / 'ø' / 'õ' / 'ö' / 'ú' / 'û' / 'ù' / 'ü' / 'ŕ' / 'ř' / 'ŗ' / 'ſ' / 'š' / 'š' / 'ş' / 'ß' / 'ž')> */
 		func() bool {
-			position1120, tokenIndex1120 := position, tokenIndex
+			position1234, tokenIndex1234 := position, tokenIndex
 			{
-				position1121 := position
+				position1235 := position
 				{
-					position1122, tokenIndex1122 := position, tokenIndex
+					position1236, tokenIndex1236 := position, tokenIndex
 					if buffer[position] != rune('æ') {
-						goto l1123
+						goto l1237
 					}
 					position++
-					goto l1122
-				l1123:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1237:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('œ') {
-						goto l1124
+						goto l1238
 					}
 					position++
-					goto l1122
-				l1124:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1238:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('à') {
-						goto l1125
+						goto l1239
 					}
 					position++
-					goto l1122
-				l1125:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1239:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('â') {
-						goto l1126
+						goto l1240
 					}
 					position++
-					goto l1122
-				l1126:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1240:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('å') {
-						goto l1127
+						goto l1241
 					}
 					position++
-					goto l1122
-				l1127:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1241:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ã') {
-						goto l1128
+						goto l1242
 					}
 					position++
-					goto l1122
-				l1128:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1242:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ä') {
-						goto l1129
+						goto l1243
 					}
 					position++
-					goto l1122
-				l1129:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1243:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('á') {
-						goto l1130
+						goto l1244
 					}
 					position++
-					goto l1122
-				l1130:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1244:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ç') {
-						goto l1131
+						goto l1245
 					}
 					position++
-					goto l1122
-				l1131:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1245:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('č') {
-						goto l1132
+						goto l1246
 					}
 					position++
-					goto l1122
-				l1132:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1246:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('é') {
-						goto l1133
+						goto l1247
 					}
 					position++
-					goto l1122
-				l1133:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1247:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('è') {
-						goto l1134
+						goto l1248
 					}
 					position++
-					goto l1122
-				l1134:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1248:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ë') {
-						goto l1135
+						goto l1249
 					}
 					position++
-					goto l1122
-				l1135:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1249:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('í') {
-						goto l1136
+						goto l1250
 					}
 					position++
-					goto l1122
-				l1136:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1250:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ì') {
-						goto l1137
+						goto l1251
 					}
 					position++
-					goto l1122
-				l1137:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1251:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ï') {
-						goto l1138
+						goto l1252
 					}
 					position++
-					goto l1122
-				l1138:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1252:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ň') {
-						goto l1139
+						goto l1253
 					}
 					position++
-					goto l1122
-				l1139:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1253:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ñ') {
-						goto l1140
+						goto l1254
 					}
 					position++
-					goto l1122
-				l1140:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1254:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ñ') {
-						goto l1141
+						goto l1255
 					}
 					position++
-					goto l1122
-				l1141:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1255:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ó') {
-						goto l1142
+						goto l1256
 					}
 					position++
-					goto l1122
-				l1142:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1256:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ò') {
-						goto l1143
+						goto l1257
 					}
 					position++
-					goto l1122
-				l1143:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1257:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ô') {
-						goto l1144
+						goto l1258
 					}
 					position++
-					goto l1122
-				l1144:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1258:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ø') {
-						goto l1145
+						goto l1259
 					}
 					position++
-					goto l1122
-				l1145:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1259:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('õ') {
-						goto l1146
+						goto l1260
 					}
 					position++
-					goto l1122
-				l1146:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1260:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ö') {
-						goto l1147
+						goto l1261
 					}
 					position++
-					goto l1122
-				l1147:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1261:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ú') {
-						goto l1148
+						goto l1262
 					}
 					position++
-					goto l1122
-				l1148:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1262:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('û') {
-						goto l1149
+						goto l1263
 					}
 					position++
-					goto l1122
-				l1149:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1263:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ù') {
-						goto l1150
+						goto l1264
 					}
 					position++
-					goto l1122
-				l1150:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1264:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ü') {
-						goto l1151
+						goto l1265
 					}
 					position++
-					goto l1122
-				l1151:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1265:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ŕ') {
-						goto l1152
+						goto l1266
 					}
 					position++
-					goto l1122
-				l1152:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1266:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ř') {
-						goto l1153
+						goto l1267
 					}
 					position++
-					goto l1122
-				l1153:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1267:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ŗ') {
-						goto l1154
+						goto l1268
 					}
 					position++
-					goto l1122
-				l1154:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1268:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ſ') {
-						goto l1155
+						goto l1269
 					}
 					position++
-					goto l1122
-				l1155:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1269:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('š') {
-						goto l1156
+						goto l1270
 					}
 					position++
-					goto l1122
-				l1156:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1270:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('š') {
-						goto l1157
+						goto l1271
 					}
 					position++
-					goto l1122
-				l1157:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1271:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ş') {
-						goto l1158
+						goto l1272
 					}
 					position++
-					goto l1122
-				l1158:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1272:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ß') {
-						goto l1159
+						goto l1273
 					}
 					position++
-					goto l1122
-				l1159:
-					position, tokenIndex = position1122, tokenIndex1122
+					goto l1236
+				l1273:
+					position, tokenIndex = position1236, tokenIndex1236
 					if buffer[position] != rune('ž') {
-						goto l1120
+						goto l1234
 					}
 					position++
 				}
-			l1122:
-				add(ruleLowerCharExtended, position1121)
+			l1236:
+				add(ruleLowerCharExtended, position1235)
 			}
 			return true
-		l1120:
-			position, tokenIndex = position1120, tokenIndex1120
+		l1234:
+			position, tokenIndex = position1234, tokenIndex1234
 			return false
 		},
-		/* 134 LowerChar <- <LowerASCII> */
+		/* 149 LowerChar <- <LowerASCII> */
 		func() bool {
-			position1160, tokenIndex1160 := position, tokenIndex
+			position1274, tokenIndex1274 := position, tokenIndex
 			{
-				position1161 := position
+				position1275 := position
 				if !_rules[ruleLowerASCII]() {
-					goto l1160
+					goto l1274
 				}
-				add(ruleLowerChar, position1161)
+				add(ruleLowerChar, position1275)
 			}
 			return true
-		l1160:
-			position, tokenIndex = position1160, tokenIndex1160
+		l1274:
+			position, tokenIndex = position1274, tokenIndex1274
 			return false
 		},
-		/* 135 SpaceCharEOI <- <(_ / !.)> */
+		/* 150 SpaceCharEOI <- <(_ / !.)> */
 		func() bool {
-			position1162, tokenIndex1162 := position, tokenIndex
+			position1276, tokenIndex1276 := position, tokenIndex
 			{
-				position1163 := position
+				position1277 := position
 				{
-					position1164, tokenIndex1164 := position, tokenIndex
+					position1278, tokenIndex1278 := position, tokenIndex
 					if !_rules[rule_]() {
-						goto l1165
+						goto l1279
 					}
-					goto l1164
-				l1165:
-					position, tokenIndex = position1164, tokenIndex1164
+					goto l1278
+				l1279:
+					position, tokenIndex = position1278, tokenIndex1278
 					{
-						position1166, tokenIndex1166 := position, tokenIndex
+						position1280, tokenIndex1280 := position, tokenIndex
 						if !matchDot() {
-							goto l1166
+							goto l1280
 						}
-						goto l1162
-					l1166:
-						position, tokenIndex = position1166, tokenIndex1166
+						goto l1276
+					l1280:
+						position, tokenIndex = position1280, tokenIndex1280
 					}
 				}
-			l1164:
-				add(ruleSpaceCharEOI, position1163)
+			l1278:
+				add(ruleSpaceCharEOI, position1277)
 			}
 			return true
-		l1162:
-			position, tokenIndex = position1162, tokenIndex1162
+		l1276:
+			position, tokenIndex = position1276, tokenIndex1276
 			return false
 		},
-		/* 136 Nums <- <[0-9]> */
+		/* 151 Nums <- <[0-9]> */
 		func() bool {
-			position1167, tokenIndex1167 := position, tokenIndex
+			position1281, tokenIndex1281 := position, tokenIndex
 			{
-				position1168 := position
+				position1282 := position
 				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l1167
+					goto l1281
 				}
 				position++
-				add(ruleNums, position1168)
+				add(ruleNums, position1282)
 			}
 			return true
-		l1167:
-			position, tokenIndex = position1167, tokenIndex1167
+		l1281:
+			position, tokenIndex = position1281, tokenIndex1281
 			return false
 		},
-		/* 137 LowerGreek <- <[α-ω]> */
+		/* 152 LowerGreek <- <[α-ω]> */
 		func() bool {
-			position1169, tokenIndex1169 := position, tokenIndex
+			position1283, tokenIndex1283 := position, tokenIndex
 			{
-				position1170 := position
+				position1284 := position
 				if c := buffer[position]; c < rune('α') || c > rune('ω') {
-					goto l1169
+					goto l1283
 				}
 				position++
-				add(ruleLowerGreek, position1170)
+				add(ruleLowerGreek, position1284)
 			}
 			return true
-		l1169:
-			position, tokenIndex = position1169, tokenIndex1169
+		l1283:
+			position, tokenIndex = position1283, tokenIndex1283
 			return false
 		},
-		/* 138 LowerASCII <- <[a-z]> */
+		/* 153 LowerASCII <- <[a-z]> */
 		func() bool {
-			position1171, tokenIndex1171 := position, tokenIndex
+			position1285, tokenIndex1285 := position, tokenIndex
 			{
-				position1172 := position
+				position1286 := position
 				if c := buffer[position]; c < rune('a') || c > rune('z') {
-					goto l1171
+					goto l1285
 				}
 				position++
-				add(ruleLowerASCII, position1172)
+				add(ruleLowerASCII, position1286)
 			}
 			return true
-		l1171:
-			position, tokenIndex = position1171, tokenIndex1171
+		l1285:
+			position, tokenIndex = position1285, tokenIndex1285
 			return false
 		},
-		/* 139 UpperASCII <- <[A-Z]> */
+		/* 154 UpperASCII <- <[A-Z]> */
 		func() bool {
-			position1173, tokenIndex1173 := position, tokenIndex
+			position1287, tokenIndex1287 := position, tokenIndex
 			{
-				position1174 := position
+				position1288 := position
 				if c := buffer[position]; c < rune('A') || c > rune('Z') {
-					goto l1173
+					goto l1287
 				}
 				position++
-				add(ruleUpperASCII, position1174)
+				add(ruleUpperASCII, position1288)
 			}
 			return true
-		l1173:
-			position, tokenIndex = position1173, tokenIndex1173
+		l1287:
+			position, tokenIndex = position1287, tokenIndex1287
 			return false
 		},
-		/* 140 Apostrophe <- <(ApostrOther / ApostrASCII)> */
+		/* 155 Apostrophe <- <(ApostrOther / ApostrASCII)> */
 		func() bool {
-			position1175, tokenIndex1175 := position, tokenIndex
+			position1289, tokenIndex1289 := position, tokenIndex
 			{
-				position1176 := position
+				position1290 := position
 				{
-					position1177, tokenIndex1177 := position, tokenIndex
+					position1291, tokenIndex1291 := position, tokenIndex
 					if !_rules[ruleApostrOther]() {
-						goto l1178
+						goto l1292
 					}
-					goto l1177
-				l1178:
-					position, tokenIndex = position1177, tokenIndex1177
+					goto l1291
+				l1292:
+					position, tokenIndex = position1291, tokenIndex1291
 					if !_rules[ruleApostrASCII]() {
-						goto l1175
+						goto l1289
 					}
 				}
-			l1177:
-				add(ruleApostrophe, position1176)
+			l1291:
+				add(ruleApostrophe, position1290)
 			}
 			return true
-		l1175:
-			position, tokenIndex = position1175, tokenIndex1175
+		l1289:
+			position, tokenIndex = position1289, tokenIndex1289
 			return false
 		},
-		/* 141 ApostrASCII <- <'\''> */
+		/* 156 ApostrASCII <- <'\''> */
 		func() bool {
-			position1179, tokenIndex1179 := position, tokenIndex
+			position1293, tokenIndex1293 := position, tokenIndex
 			{
-				position1180 := position
+				position1294 := position
 				if buffer[position] != rune('\'') {
-					goto l1179
+					goto l1293
 				}
 				position++
-				add(ruleApostrASCII, position1180)
+				add(ruleApostrASCII, position1294)
 			}
 			return true
-		l1179:
-			position, tokenIndex = position1179, tokenIndex1179
+		l1293:
+			position, tokenIndex = position1293, tokenIndex1293
 			return false
 		},
-		/* 142 ApostrOther <- <('‘' / '’' / '`' / '´')> */
+		/* 157 ApostrOther <- <('‘' / '’' / '`' / '´')> */
 		func() bool {
-			position1181, tokenIndex1181 := position, tokenIndex
+			position1295, tokenIndex1295 := position, tokenIndex
 			{
-				position1182 := position
+				position1296 := position
 				{
-					position1183, tokenIndex1183 := position, tokenIndex
+					position1297, tokenIndex1297 := position, tokenIndex
 					if buffer[position] != rune('‘') {
-						goto l1184
+						goto l1298
 					}
 					position++
-					goto l1183
-				l1184:
-					position, tokenIndex = position1183, tokenIndex1183
+					goto l1297
+				l1298:
+					position, tokenIndex = position1297, tokenIndex1297
 					if buffer[position] != rune('’') {
-						goto l1185
+						goto l1299
 					}
 					position++
-					goto l1183
-				l1185:
-					position, tokenIndex = position1183, tokenIndex1183
+					goto l1297
+				l1299:
+					position, tokenIndex = position1297, tokenIndex1297
 					if buffer[position] != rune('`') {
-						goto l1186
+						goto l1300
 					}
 					position++
-					goto l1183
-				l1186:
-					position, tokenIndex = position1183, tokenIndex1183
+					goto l1297
+				l1300:
+					position, tokenIndex = position1297, tokenIndex1297
 					if buffer[position] != rune('´') {
-						goto l1181
+						goto l1295
 					}
 					position++
 				}
-			l1183:
-				add(ruleApostrOther, position1182)
+			l1297:
+				add(ruleApostrOther, position1296)
 			}
 			return true
-		l1181:
-			position, tokenIndex = position1181, tokenIndex1181
+		l1295:
+			position, tokenIndex = position1295, tokenIndex1295
 			return false
 		},
-		/* 143 Dash <- <'-'> */
+		/* 158 Dash <- <'-'> */
 		func() bool {
-			position1187, tokenIndex1187 := position, tokenIndex
+			position1301, tokenIndex1301 := position, tokenIndex
 			{
-				position1188 := position
+				position1302 := position
 				if buffer[position] != rune('-') {
-					goto l1187
+					goto l1301
 				}
 				position++
-				add(ruleDash, position1188)
+				add(ruleDash, position1302)
 			}
 			return true
-		l1187:
-			position, tokenIndex = position1187, tokenIndex1187
+		l1301:
+			position, tokenIndex = position1301, tokenIndex1301
 			return false
 		},
-		/* 144 Slash <- <'/'> */
+		/* 159 Slash <- <'/'> */
 		func() bool {
-			position1189, tokenIndex1189 := position, tokenIndex
+			position1303, tokenIndex1303 := position, tokenIndex
 			{
-				position1190 := position
+				position1304 := position
 				if buffer[position] != rune('/') {
-					goto l1189
+					goto l1303
 				}
 				position++
-				add(ruleSlash, position1190)
+				add(ruleSlash, position1304)
 			}
 			return true
-		l1189:
-			position, tokenIndex = position1189, tokenIndex1189
+		l1303:
+			position, tokenIndex = position1303, tokenIndex1303
 			return false
 		},
-		/* 145 _ <- <(MultipleSpace / SingleSpace)> */
+		/* 160 _ <- <(MultipleSpace / SingleSpace)> */
 		func() bool {
-			position1191, tokenIndex1191 := position, tokenIndex
+			position1305, tokenIndex1305 := position, tokenIndex
 			{
-				position1192 := position
+				position1306 := position
 				{
-					position1193, tokenIndex1193 := position, tokenIndex
+					position1307, tokenIndex1307 := position, tokenIndex
 					if !_rules[ruleMultipleSpace]() {
-						goto l1194
+						goto l1308
 					}
-					goto l1193
-				l1194:
-					position, tokenIndex = position1193, tokenIndex1193
+					goto l1307
+				l1308:
+					position, tokenIndex = position1307, tokenIndex1307
 					if !_rules[ruleSingleSpace]() {
-						goto l1191
+						goto l1305
 					}
 				}
-			l1193:
-				add(rule_, position1192)
+			l1307:
+				add(rule_, position1306)
 			}
 			return true
-		l1191:
-			position, tokenIndex = position1191, tokenIndex1191
+		l1305:
+			position, tokenIndex = position1305, tokenIndex1305
 			return false
 		},
-		/* 146 MultipleSpace <- <(SingleSpace SingleSpace+)> */
+		/* 161 MultipleSpace <- <(SingleSpace SingleSpace+)> */
 		func() bool {
-			position1195, tokenIndex1195 := position, tokenIndex
+			position1309, tokenIndex1309 := position, tokenIndex
 			{
-				position1196 := position
+				position1310 := position
 				if !_rules[ruleSingleSpace]() {
-					goto l1195
+					goto l1309
 				}
 				if !_rules[ruleSingleSpace]() {
-					goto l1195
+					goto l1309
 				}
-			l1197:
+			l1311:
 				{
-					position1198, tokenIndex1198 := position, tokenIndex
+					position1312, tokenIndex1312 := position, tokenIndex
 					if !_rules[ruleSingleSpace]() {
-						goto l1198
+						goto l1312
 					}
-					goto l1197
-				l1198:
-					position, tokenIndex = position1198, tokenIndex1198
+					goto l1311
+				l1312:
+					position, tokenIndex = position1312, tokenIndex1312
 				}
-				add(ruleMultipleSpace, position1196)
+				add(ruleMultipleSpace, position1310)
 			}
 			return true
-		l1195:
-			position, tokenIndex = position1195, tokenIndex1195
+		l1309:
+			position, tokenIndex = position1309, tokenIndex1309
 			return false
 		},
-		/* 147 SingleSpace <- <(' ' / OtherSpace)> */
+		/* 162 SingleSpace <- <(' ' / OtherSpace)> */
 		func() bool {
-			position1199, tokenIndex1199 := position, tokenIndex
+			position1313, tokenIndex1313 := position, tokenIndex
 			{
-				position1200 := position
+				position1314 := position
 				{
-					position1201, tokenIndex1201 := position, tokenIndex
+					position1315, tokenIndex1315 := position, tokenIndex
 					if buffer[position] != rune(' ') {
-						goto l1202
+						goto l1316
 					}
 					position++
-					goto l1201
-				l1202:
-					position, tokenIndex = position1201, tokenIndex1201
+					goto l1315
+				l1316:
+					position, tokenIndex = position1315, tokenIndex1315
 					if !_rules[ruleOtherSpace]() {
-						goto l1199
+						goto l1313
 					}
 				}
-			l1201:
-				add(ruleSingleSpace, position1200)
+			l1315:
+				add(ruleSingleSpace, position1314)
 			}
 			return true
-		l1199:
-			position, tokenIndex = position1199, tokenIndex1199
+		l1313:
+			position, tokenIndex = position1313, tokenIndex1313
 			return false
 		},
-		/* 148 OtherSpace <- <('\u3000' / '\u00a0' / '\t' / '\r' / '\n' / '\f' / '\v')> */
+		/* 163 OtherSpace <- <('\u3000' / '\u00a0' / '\t' / '\r' / '\n' / '\f' / '\v')> */
 		func() bool {
-			position1203, tokenIndex1203 := position, tokenIndex
+			position1317, tokenIndex1317 := position, tokenIndex
 			{
-				position1204 := position
+				position1318 := position
 				{
-					position1205, tokenIndex1205 := position, tokenIndex
+					position1319, tokenIndex1319 := position, tokenIndex
 					if buffer[position] != rune('\u3000') {
-						goto l1206
+						goto l1320
 					}
 					position++
-					goto l1205
-				l1206:
-					position, tokenIndex = position1205, tokenIndex1205
+					goto l1319
+				l1320:
+					position, tokenIndex = position1319, tokenIndex1319
 					if buffer[position] != rune('\u00a0') {
-						goto l1207
+						goto l1321
 					}
 					position++
-					goto l1205
-				l1207:
-					position, tokenIndex = position1205, tokenIndex1205
+					goto l1319
+				l1321:
+					position, tokenIndex = position1319, tokenIndex1319
 					if buffer[position] != rune('\t') {
-						goto l1208
+						goto l1322
 					}
 					position++
-					goto l1205
-				l1208:
-					position, tokenIndex = position1205, tokenIndex1205
+					goto l1319
+				l1322:
+					position, tokenIndex = position1319, tokenIndex1319
 					if buffer[position] != rune('\r') {
-						goto l1209
+						goto l1323
 					}
 					position++
-					goto l1205
-				l1209:
-					position, tokenIndex = position1205, tokenIndex1205
+					goto l1319
+				l1323:
+					position, tokenIndex = position1319, tokenIndex1319
 					if buffer[position] != rune('\n') {
-						goto l1210
+						goto l1324
 					}
 					position++
-					goto l1205
-				l1210:
-					position, tokenIndex = position1205, tokenIndex1205
+					goto l1319
+				l1324:
+					position, tokenIndex = position1319, tokenIndex1319
 					if buffer[position] != rune('\f') {
-						goto l1211
+						goto l1325
 					}
 					position++
-					goto l1205
-				l1211:
-					position, tokenIndex = position1205, tokenIndex1205
+					goto l1319
+				l1325:
+					position, tokenIndex = position1319, tokenIndex1319
 					if buffer[position] != rune('\v') {
-						goto l1203
+						goto l1317
 					}
 					position++
 				}
-			l1205:
-				add(ruleOtherSpace, position1204)
+			l1319:
+				add(ruleOtherSpace, position1318)
 			}
 			return true
-		l1203:
-			position, tokenIndex = position1203, tokenIndex1203
+		l1317:
+			position, tokenIndex = position1317, tokenIndex1317
 			return false
 		},
-		/* 149 END <- <!.> */
+		/* 164 END <- <!.> */
 		func() bool {
-			position1212, tokenIndex1212 := position, tokenIndex
+			position1326, tokenIndex1326 := position, tokenIndex
 			{
-				position1213 := position
+				position1327 := position
 				{
-					position1214, tokenIndex1214 := position, tokenIndex
+					position1328, tokenIndex1328 := position, tokenIndex
 					if !matchDot() {
-						goto l1214
+						goto l1328
 					}
-					goto l1212
-				l1214:
-					position, tokenIndex = position1214, tokenIndex1214
+					goto l1326
+				l1328:
+					position, tokenIndex = position1328, tokenIndex1328
 				}
-				add(ruleEND, position1213)
+				add(ruleEND, position1327)
 			}
 			return true
-		l1212:
-			position, tokenIndex = position1212, tokenIndex1212
+		l1326:
+			position, tokenIndex = position1326, tokenIndex1326
 			return false
 		},
 	}
@@ -8,11 +8,22 @@ import (
 // name and creation of the Abstract Syntax Tree of the name-string.
 type Parser interface {
 	// PreprocessAndParse takes a scientific name and returns back Abstract
-	// Syntax Tree of the name-string.
+	// Syntax Tree of the name-string. If code is not UnknownCode, it is
+	// used as a hint for the nomenclatural code of the name. If
+	// withPublishedIn is true, a trailing bibliographic reference is parsed
+	// instead of being an unparsed tail. If withPhonetic is true, canonical
+	// forms get phonetic keys.
 	PreprocessAndParse(
 		name, version string,
 		keepHTML, capitalize, enableCultivars, preserveDiaereses bool,
+		withPublishedIn, withPhonetic bool,
+		code parsed.Code,
 	) ScientificNameNode
+
+	// ParseAuthorship takes an authorship string without a name and
+	// returns its parsed representation.
+	ParseAuthorship(authorship string, keepHTML bool) parsed.Authorship
+
 	Debug(name string) []byte
 }
 
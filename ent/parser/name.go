@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/ent/phonetic"
 	"github.com/gnames/gnparser/ent/stemmer"
 	"github.com/gnames/gnparser/ent/str"
 )
@@ -32,7 +33,7 @@ func (sn *scientificNameNode) Normalized() string {
 	if sn.nameData == nil {
 		return ""
 	}
-	return sn.value()
+	return str.JoinStrings(sn.value(), sn.aggregate, " ")
 }
 
 // Canonical returns canonical forms of scientific name. There are
@@ -44,10 +45,29 @@ func (sn *scientificNameNode) Canonical() *parsed.Canonical {
 		return res
 	}
 	c := sn.canonical()
+	stemmed := c.Value
+	var phon string
+	if pn, ok := sn.nameData.(*phraseNode); ok {
+		// the phrase is an informal designation, only the genus and
+		// the species are stemmed.
+		base := pn.base()
+		phrase := c.Value[len(base):]
+		stemmed = stemmer.StemCanonical(base) + phrase
+		if sn.withPhonetic {
+			phon = phonetic.Canonical(base) + phrase
+		}
+	} else if vn, ok := sn.nameData.(*virusNode); !ok || vn.binomial {
+		stemmed = stemmer.StemCanonical(c.Value)
+		// virus names are not Latin, phonetic rules do not apply to them.
+		if sn.withPhonetic && !ok {
+			phon = phonetic.Canonical(c.Value)
+		}
+	}
 	return &parsed.Canonical{
-		Stemmed: stemmer.StemCanonical(c.Value),
-		Simple:  c.Value,
-		Full:    c.ValueRanked,
+		Stemmed:  stemmed,
+		Simple:   c.Value,
+		Full:     str.JoinStrings(c.ValueRanked, sn.aggregate, " "),
+		Phonetic: phon,
 	}
 }
 
@@ -438,19 +458,127 @@ func (apr *approxNode) details() parsed.Details {
 	return parsed.DetailsApproximation{Approximation: ao}
 }
 
+func (pn *phraseNode) words() []parsed.Word {
+	var words []parsed.Word
+	if pn == nil {
+		return words
+	}
+	words = append(words, *pn.Genus)
+	if pn.SpEpithet != nil {
+		words = append(words, pn.SpEpithet.words()...)
+	}
+	for _, v := range []*parsed.Word{pn.Rank, pn.Phrase, pn.Collector, pn.Number} {
+		if v != nil {
+			words = append(words, *v)
+		}
+	}
+	return words
+}
+
+func (pn *phraseNode) value() string {
+	if pn == nil {
+		return ""
+	}
+	val := pn.Genus.Normalized
+	if pn.SpEpithet != nil {
+		val = str.JoinStrings(val, pn.SpEpithet.value(), " ")
+	}
+	phrase := pn.Phrase.Normalized
+	if pn.Quoted {
+		phrase = "‘" + phrase + "’"
+	}
+	val = str.JoinStrings(val, pn.Rank.Normalized, " ")
+	val = str.JoinStrings(val, phrase, " ")
+	return str.JoinStrings(val, pn.voucher(), " ")
+}
+
+// base returns the genus and the specific epithet of a phrase name.
+func (pn *phraseNode) base() string {
+	res := pn.Genus.Normalized
+	if pn.SpEpithet != nil {
+		res = str.JoinStrings(res, pn.SpEpithet.Word.Normalized, " ")
+	}
+	return res
+}
+
+// voucher returns the voucher specimen of a phrase name in parentheses.
+func (pn *phraseNode) voucher() string {
+	if pn.Collector == nil || pn.Number == nil {
+		return ""
+	}
+	return "(" + pn.Collector.Normalized + " " + pn.Number.Normalized + ")"
+}
+
+// canonical keeps the rank and the phrase, so informal taxa of the same
+// genus do not collapse into the genus. The full canonical form also keeps
+// the voucher.
+func (pn *phraseNode) canonical() *canonical {
+	var c *canonical
+	if pn == nil {
+		return c
+	}
+	val := pn.base() + " " + pn.Rank.Normalized + " " + pn.Phrase.Normalized
+	c = &canonical{
+		Value:       val,
+		ValueRanked: str.JoinStrings(val, pn.voucher(), " "),
+	}
+	return c
+}
+
+func (pn *phraseNode) lastAuthorship() *authorshipNode {
+	var au *authorshipNode
+	if pn == nil || pn.SpEpithet == nil {
+		return au
+	}
+	return pn.SpEpithet.Authorship
+}
+
+func (pn *phraseNode) details() parsed.Details {
+	if pn == nil {
+		return nil
+	}
+	po := parsed.PhraseName{
+		Genus:  pn.Genus.Normalized,
+		Rank:   parsed.NewRank(pn.Rank.Normalized),
+		Phrase: pn.Phrase.Normalized,
+	}
+	if pn.SpEpithet != nil {
+		po.Species = pn.SpEpithet.Word.Normalized
+	}
+	if pn.Collector != nil {
+		po.VoucherCollector = pn.Collector.Normalized
+	}
+	if pn.Number != nil {
+		po.VoucherNumber = pn.Number.Normalized
+	}
+	return parsed.DetailsPhraseName{PhraseName: po}
+}
+
 func (comp *comparisonNode) words() []parsed.Word {
 	var words []parsed.Word
-	var wrd parsed.Word
 	if comp == nil {
 		return nil
 	}
-	wrd = *comp.Genus
-	words = []parsed.Word{wrd}
-	wrd = *comp.Comparison
-	words = append(words, wrd)
+	words = []parsed.Word{*comp.Genus}
+	if comp.compPos == 0 {
+		words = append(words, *comp.Comparison)
+	}
 	if comp.SpEpithet != nil {
 		words = append(words, comp.SpEpithet.words()...)
 	}
+	if comp.compPos == 1 {
+		if comp.rankFirst {
+			words = append(words, *comp.Infraspecies[0].Rank.Word)
+		}
+		words = append(words, *comp.Comparison)
+	}
+	for i, v := range comp.Infraspecies {
+		ws := v.words()
+		if i == 0 && comp.rankFirst {
+			ws = ws[1:]
+		}
+		words = append(words, ws...)
+	}
 	return words
 }
 
@@ -459,10 +587,26 @@ func (comp *comparisonNode) value() string {
 		return ""
 	}
 	val := comp.Genus.Normalized
-	val = str.JoinStrings(val, comp.Comparison.Normalized, " ")
+	if comp.compPos == 0 {
+		val = str.JoinStrings(val, comp.Comparison.Normalized, " ")
+	}
 	if comp.SpEpithet != nil {
 		val = str.JoinStrings(val, comp.SpEpithet.value(), " ")
 	}
+	if comp.compPos == 1 {
+		if comp.rankFirst {
+			rank := comp.Infraspecies[0].Rank.Word.Normalized
+			val = str.JoinStrings(val, rank, " ")
+		}
+		val = str.JoinStrings(val, comp.Comparison.Normalized, " ")
+	}
+	for i, v := range comp.Infraspecies {
+		iv := v.value()
+		if i == 0 && comp.rankFirst {
+			iv = str.JoinStrings(v.Word.Normalized, v.Authorship.value(), " ")
+		}
+		val = str.JoinStrings(val, iv, " ")
+	}
 	return val
 }
 
@@ -476,6 +620,9 @@ func (comp *comparisonNode) canonical() *canonical {
 		sCan := comp.SpEpithet.canonical()
 		c = appendCanonical(c, sCan, " ")
 	}
+	for _, v := range comp.Infraspecies {
+		c = appendCanonical(c, v.canonical(), " ")
+	}
 	return c
 }
 
@@ -484,6 +631,9 @@ func (comp *comparisonNode) lastAuthorship() *authorshipNode {
 	if comp == nil || comp.SpEpithet == nil {
 		return au
 	}
+	if l := len(comp.Infraspecies); l > 0 {
+		return comp.Infraspecies[l-1].Authorship
+	}
 	return comp.SpEpithet.Authorship
 }
 
@@ -494,6 +644,7 @@ func (comp *comparisonNode) details() parsed.Details {
 	co := parsed.Comparison{
 		Genus:      comp.Genus.Normalized,
 		CompMarker: comp.Comparison.Normalized,
+		AppliesTo:  comp.appliesTo,
 	}
 	if comp.SpEpithet == nil {
 		return parsed.DetailsComparison{Comparison: co}
@@ -503,6 +654,9 @@ func (comp *comparisonNode) details() parsed.Details {
 	if comp.SpEpithet.Authorship != nil {
 		co.SpeciesAuthorship = comp.SpEpithet.Authorship.details()
 	}
+	for _, v := range comp.Infraspecies {
+		co.Infraspecies = append(co.Infraspecies, v.details())
+	}
 	return parsed.DetailsComparison{Comparison: co}
 }
 
@@ -669,15 +823,14 @@ func (inf *infraspEpithetNode) canonical() *canonical {
 }
 
 func (inf *infraspEpithetNode) details() parsed.InfraspeciesElem {
-	rank := ""
-	if inf.Rank != nil && inf.Rank.Word != nil {
-		rank = inf.Rank.Word.Normalized
-	}
 	res := parsed.InfraspeciesElem{
 		Value:      inf.Word.Normalized,
-		Rank:       rank,
 		Authorship: inf.Authorship.details(),
 	}
+	if inf.Rank != nil && inf.Rank.Word != nil {
+		res.Rank = parsed.NewRank(inf.Rank.Word.Normalized)
+		res.RankVerbatim = inf.Rank.Word.Verbatim
+	}
 	return res
 }
 
@@ -739,7 +892,7 @@ func (u *uninomialComboNode) words() []parsed.Word {
 	wrd = *u.Uninomial1.Word
 	words := []parsed.Word{wrd}
 	words = append(words, u.Uninomial1.Authorship.words()...)
-	if u.Rank.Word.Start != 0 {
+	if !u.Rank.Implied {
 		wrd = *u.Rank.Word
 		words = append(words, wrd)
 	}
@@ -785,9 +938,12 @@ func (u *uninomialComboNode) lastAuthorship() *authorshipNode {
 func (u *uninomialComboNode) details() parsed.Details {
 	ud := parsed.Uninomial{
 		Value:  u.Uninomial2.Word.Normalized,
-		Rank:   u.Rank.Word.Normalized,
+		Rank:   parsed.NewRank(u.Rank.Word.Normalized),
 		Parent: u.Uninomial1.Word.Normalized,
 	}
+	if !u.Rank.Implied {
+		ud.RankVerbatim = u.Rank.Word.Verbatim
+	}
 	if u.Uninomial2.Authorship != nil {
 		ud.Authorship = u.Uninomial2.Authorship.details()
 	}
@@ -843,25 +999,28 @@ func authGroupDetail(ag *authorsGroupNode) *parsed.AuthGroup {
 	if ag == nil {
 		return &ago
 	}
-	aus, yr := ag.Team1.details()
+	aus, ps, yr := ag.Team1.details()
 	ago = parsed.AuthGroup{
 		Authors: aus,
+		Persons: ps,
 		Year:    yr,
 	}
 	if ag.Team2 == nil {
 		return &ago
 	}
-	aus, yr = ag.Team2.details()
+	aus, ps, yr = ag.Team2.details()
 	switch ag.Team2Type {
 	case teamEx:
 		eao := parsed.Authors{
 			Authors: aus,
+			Persons: ps,
 			Year:    yr,
 		}
 		ago.ExAuthors = &eao
 	case teamEmend:
 		eao := parsed.Authors{
 			Authors: aus,
+			Persons: ps,
 			Year:    yr,
 		}
 		ago.EmendAuthors = &eao
@@ -942,24 +1101,31 @@ func (aut *authorsTeamNode) value() string {
 	return value
 }
 
-func (at *authorsTeamNode) details() ([]string, *parsed.Year) {
+func (at *authorsTeamNode) details() (
+	[]string,
+	[]parsed.AuthorPerson,
+	*parsed.Year,
+) {
 	var yr *parsed.Year
 	var aus []string
+	var ps []parsed.AuthorPerson
 	if at == nil {
-		return aus, yr
+		return aus, ps, yr
 	}
 	aus = make([]string, len(at.Authors))
+	ps = make([]parsed.AuthorPerson, len(at.Authors))
 	for i, v := range at.Authors {
 		aus[i] = v.Value
+		ps[i] = v.Person
 	}
 	if at.Year == nil {
-		return aus, yr
+		return aus, ps, yr
 	}
 	yr = &parsed.Year{
 		Value:         at.Year.Word.Normalized,
 		IsApproximate: at.Year.Approximate,
 	}
-	return aus, yr
+	return aus, ps, yr
 }
 
 func (aut *authorsTeamNode) words() []parsed.Word {
@@ -20,6 +20,7 @@ func (sn *scientificNameNode) ToOutput(withDetails bool) parsed.Parsed {
 	}
 
 	if res.Canonical == nil {
+		res.Code = sn.nomCode()
 		return res
 	}
 
@@ -30,13 +31,27 @@ func (sn *scientificNameNode) ToOutput(withDetails bool) parsed.Parsed {
 	res.Authorship = sn.LastAuthorship(withDetails)
 	res.Hybrid = sn.hybrid
 	res.Surrogate = sn.surrogate
+	res.NomenclaturalStatus = sn.nomStatus
+	res.TaxonConcept = sn.taxonConcept
+	res.PublishedIn = sn.publishedIn
 	res.Bacteria = sn.bacteria
+	res.Strains = sn.strains
 	res.Tail = sn.tail
 	if withDetails {
 		res.Details = sn.Details()
 		res.Words = sn.Words()
 	}
 
+	res.Code = sn.nomCode()
+	if un, ok := sn.nameData.(*uninomialNode); ok {
+		ev := parsed.RankEvidence{
+			Code:            res.Code,
+			KnownGenus:      sn.bacteria != nil,
+			BotanicalAuthor: sn.icnAuthor || sn.authorForm,
+		}
+		res.InferredRank = parsed.InferRank(un.Word.Normalized, ev)
+	}
+
 	if sn.ambiguousEpithet != "" {
 		res.RestoreAmbiguous(sn.ambiguousEpithet, sn.ambiguousModif)
 	}
@@ -11,10 +11,19 @@ import (
 	"github.com/gnames/gnparser/ent/str"
 )
 
+// aggregateAnnots maps normalized qualifiers of aggregates, species groups,
+// species complexes and clades to their annotations.
+var aggregateAnnots = map[string]parsed.Annotation{
+	"agg.":    parsed.AggregateAnnot,
+	"group":   parsed.SpeciesGroupAnnot,
+	"complex": parsed.ComplexAnnot,
+	"clade":   parsed.CladeAnnot,
+}
+
 // Debug takes a string, parsers it, and returns a byte representation of
 // the node tree
 func (p *Engine) Debug(s string) []byte {
-	ppr := preprocess.Preprocess([]byte(s))
+	ppr := preprocess.Preprocess([]byte(s), p.dictionary())
 	var b bytes.Buffer
 	if ppr.NoParse || ppr.Virus {
 		b.WriteString("\n*** Preprocessing: NO PARSE ***\n")
@@ -42,10 +51,14 @@ func (p *Engine) PreprocessAndParse(
 	capitalize bool,
 	enableCultivars bool,
 	preserveDiaereses bool,
+	withPublishedIn bool,
+	withPhonetic bool,
+	code parsed.Code,
 ) ScientificNameNode {
 
 	p.enableCultivars = enableCultivars
 	p.preserveDiaereses = preserveDiaereses
+	p.code = code
 
 	originalString := s
 	var tagsOrEntities, lowCase bool
@@ -63,7 +76,13 @@ func (p *Engine) PreprocessAndParse(
 		}
 	}
 
-	preproc := preprocess.Preprocess([]byte(s))
+	var publishedIn *parsed.PublishedIn
+	if withPublishedIn {
+		s, publishedIn = cutPublishedIn(s)
+	}
+
+	preproc := preprocess.Preprocess([]byte(s), p.dictionary())
+	var strains []parsed.Strain
 
 	defer func() {
 		p.sn.daggerChar = preproc.DaggerChar
@@ -82,26 +101,56 @@ func (p *Engine) PreprocessAndParse(
 		p.sn.ambiguousEpithet = preproc.Ambiguous.Orig
 		p.sn.ambiguousModif = preproc.Ambiguous.Subst
 
+		p.sn.strains = strains
+		if preproc.NomStatus != "" {
+			p.sn.nomStatus = &parsed.NomenclaturalStatus{
+				Verbatim:   preproc.NomStatusVerbatim,
+				Normalized: preproc.NomStatus,
+			}
+		}
+		if preproc.TaxonConcept != "" {
+			p.sn.taxonConcept = newTaxonConcept(preproc.TaxonConcept)
+		}
+		if preproc.Aggregate != "" {
+			p.sn.aggregate = preproc.AggregateVerbatim
+			// a surrogate found by the parser, like a comparison in
+			// 'Aus cf. bus agg.', is kept.
+			if p.sn.surrogate == nil {
+				annot := aggregateAnnots[preproc.Aggregate]
+				p.sn.surrogate = &annot
+			}
+		}
+		if publishedIn != nil {
+			p.sn.publishedIn = publishedIn
+			p.addPublishedInWarnings(publishedIn)
+		}
 		p.sn.warnings = p.warnings
+		p.sn.codeHint = code
+		p.sn.icnAuthor = p.icnAuthor
+		p.sn.authorForm = p.authorForm
+		p.sn.withPhonetic = withPhonetic
 		p.sn.addVerbatim(originalString)
 		p.sn.parserVersion = ver
 	}()
 
+	if preproc.Virus {
+		p.fullReset()
+		p.addPreprocWarnings(tagsOrEntities, lowCase)
+		p.newVirusScientificNameNode(s)
+		return p.sn
+	}
+
 	if preproc.NoParse {
 		p.newNotParsedScientificNameNode(preproc)
 		return p.sn
 	}
 
+	strains = cutStrains(preproc, p.dictionary())
+
 	p.Buffer = string(preproc.Body)
 	p.fullReset()
 
-	if tagsOrEntities {
-		p.addWarn(parsed.HTMLTagsEntitiesWarn)
-	}
-
-	if lowCase {
-		p.addWarn(parsed.LowCaseWarn)
-	}
+	p.addPreprocWarnings(tagsOrEntities, lowCase)
 
 	if preproc.Underscore {
 		p.addWarn(parsed.SpaceNonStandardWarn)
@@ -118,3 +167,66 @@ func (p *Engine) PreprocessAndParse(
 	p.newScientificNameNode()
 	return p.sn
 }
+
+// ParseAuthorship parses a string that contains only an authorship of
+// a name, for example "(L.) Mill. 1768". If the string cannot be parsed,
+// only the Verbatim field of the result is set, and its ParseQuality is 0.
+// An unparsed remainder of the string goes to the Tail field and lowers
+// the ParseQuality.
+func (p *Engine) ParseAuthorship(s string, keepHTML bool) parsed.Authorship {
+	res := parsed.Authorship{Verbatim: s}
+	if !keepHTML {
+		s = preprocess.StripTags(s)
+	}
+
+	p.Buffer = s
+	p.fullReset()
+	p.addPreprocWarnings(s != res.Verbatim, false)
+	p.authorshipOnly = true
+	err := p.Parse()
+	p.authorshipOnly = false
+	if err != nil {
+		return res
+	}
+
+	p.outputAST()
+	if p.root == nil {
+		return res
+	}
+	var ao *parsed.Authorship
+	var tail string
+	for n := p.root.up; n != nil; n = n.next {
+		switch n.pegRule {
+		case ruleAuthorship:
+			if an := p.newAuthorshipNode(n); an != nil {
+				ao = an.details()
+			}
+		case ruleTail:
+			tail = p.tailValue(n)
+		}
+	}
+	if ao == nil {
+		return res
+	}
+	ao.Verbatim = res.Verbatim
+	if tail != "" {
+		ao.Tail = tail
+		p.addWarn(parsed.TailWarn)
+	}
+	ao.ParseQuality = 1
+	if len(p.warnings) > 0 {
+		ao.QualityWarnings = prepareWarnings(p.warnings)
+		ao.ParseQuality = ao.QualityWarnings[0].Quality
+	}
+	return *ao
+}
+
+func (p *Engine) addPreprocWarnings(tagsOrEntities, lowCase bool) {
+	if tagsOrEntities {
+		p.addWarn(parsed.HTMLTagsEntitiesWarn)
+	}
+
+	if lowCase {
+		p.addWarn(parsed.LowCaseWarn)
+	}
+}
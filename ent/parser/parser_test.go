@@ -3,6 +3,7 @@ package parser_test
 import (
 	"testing"
 
+	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/gnames/gnparser/ent/parser"
 	"github.com/stretchr/testify/assert"
 )
@@ -18,7 +19,7 @@ func TestPreNParse(t *testing.T) {
 		{"something", ""},
 	}
 	for _, v := range testData {
-		sn := p.PreprocessAndParse(v.name, "test_version", true, false, false, false)
+		sn := p.PreprocessAndParse(v.name, "test_version", true, false, false, false, false, false, parsed.UnknownCode)
 		parsed := sn.ToOutput(false)
 		can := parsed.Canonical
 		msg := v.name
@@ -54,7 +55,7 @@ func TestToOutput(t *testing.T) {
 		{"something", "", "", false, false},
 	}
 	for _, v := range testData {
-		sn := p.PreprocessAndParse(v.name, "test_version", true, false, false, false)
+		sn := p.PreprocessAndParse(v.name, "test_version", true, false, false, false, false, false, parsed.UnknownCode)
 		out := sn.ToOutput(v.det)
 		msg := v.name
 		if !out.Parsed {
@@ -0,0 +1,73 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// personRole describes what part of an author's name a word represents.
+type personRole int
+
+const (
+	personName personRole = iota
+	personParticle
+	personSuffix
+	personEtAl
+)
+
+var initialsRe = regexp.MustCompile(`^\p{Lu}\.?(-\p{Lu}\.?)?$`)
+
+// newAuthorPerson creates a structured representation of an author from
+// words of the author and their roles. Single capital letters are
+// considered initials, unless there is no other word for a surname, like
+// in "L.". Particles are prefixes of a surname only when they precede it,
+// like in "A. de Candolle". Particles that follow a surname word belong to
+// the surname, like in "Sousa da Câmara".
+func (p *Engine) newAuthorPerson(
+	ws []*parsed.Word,
+	roles []personRole,
+) parsed.AuthorPerson {
+	var res parsed.AuthorPerson
+	if len(ws) == 0 {
+		return res
+	}
+	end := ws[len(ws)-1].End
+	for i := range roles {
+		if roles[i] == personEtAl {
+			end = ws[i].Start
+			break
+		}
+	}
+	res.Verbatim = strings.TrimSpace(string(p.buffer[ws[0].Start:end]))
+
+	var initials, surname, particles, suffixes []string
+	for i, w := range ws {
+		switch roles[i] {
+		case personParticle:
+			if len(surname) > 0 {
+				surname = append(surname, w.Normalized)
+			} else {
+				particles = append(particles, w.Normalized)
+			}
+		case personSuffix:
+			suffixes = append(suffixes, w.Normalized)
+		case personName:
+			if initialsRe.MatchString(w.Normalized) {
+				initials = append(initials, w.Normalized)
+			} else {
+				surname = append(surname, w.Normalized)
+			}
+		}
+	}
+	if len(surname) == 0 && len(initials) > 0 {
+		surname = initials[len(initials)-1:]
+		initials = initials[:len(initials)-1]
+	}
+	res.Surname = strings.Join(surname, " ")
+	res.Initials = strings.Join(initials, " ")
+	res.Particle = strings.Join(particles, " ")
+	res.Suffix = strings.Join(suffixes, " ")
+	return res
+}
@@ -0,0 +1,108 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// publishedInRe finds a bibliographic reference at the end of
+// a name-string, like ", Sp. Pl. 2: 1001. 1753" or " in Fl. Bras. 5(1): 123
+// (1840)". The reference has to be separated from the authorship by a comma
+// or by 'in'.
+var publishedInRe = regexp.MustCompile(
+	`(,\s*|\s+in\s+)([A-Z][^,:;]*?)` +
+		`(?:,?\s+(\d+[A-Za-z]?(?:\s?\([^)]+\))?))?` +
+		`(?:\s*:\s*([^:]+?))?` +
+		`(?:[.,]?\s*[(\[]?(1[5-9]\d\d|20\d\d)[a-z]?[)\]]?)?\.?\s*$`,
+)
+
+var publishedInAuthorsRe = regexp.MustCompile(`\s(&|et|ex|and)\s`)
+
+// cutPublishedIn finds a trailing publication reference and returns
+// the name-string without it, and the reference. If a reference is not
+// found, the name-string is returned intact.
+func cutPublishedIn(s string) (string, *parsed.PublishedIn) {
+	for _, loc := range publishedInRe.FindAllStringSubmatchIndex(s, -1) {
+		if len(strings.Fields(s[:loc[0]])) == 0 {
+			continue
+		}
+		sub := func(i int) string {
+			if loc[2*i] < 0 {
+				return ""
+			}
+			return strings.TrimSpace(s[loc[2*i]:loc[2*i+1]])
+		}
+		res := &parsed.PublishedIn{
+			Work:   sub(2),
+			Volume: sub(3),
+			Pages:  strings.TrimRight(sub(4), " .,"),
+			Year:   sub(5),
+		}
+		// the period of an abbreviation is taken by the year separator
+		// in "Fl. Bras. 1881"
+		if res.Volume == "" && res.Pages == "" && loc[5] < len(s) &&
+			s[loc[5]] == '.' {
+			res.Work += "."
+		}
+		// a lone number followed by a period and a year is a page in
+		// IPNI-style "Sp. Pl. 972. 1753"
+		if res.Pages == "" && res.Year != "" && loc[7] > -1 &&
+			loc[7] < len(s) && s[loc[7]] == '.' && isDigits(res.Volume) {
+			res.Pages, res.Volume = res.Volume, ""
+		}
+		if publishedInAuthorsRe.MatchString(res.Work) {
+			continue
+		}
+		// without pages a reference is reliable only if the work
+		// is abbreviated.
+		if res.Pages == "" &&
+			(res.Year == "" || !strings.Contains(res.Work, ".")) {
+			continue
+		}
+		res.Verbatim = strings.TrimSpace(s[loc[0]:])
+		res.Verbatim = strings.TrimLeft(res.Verbatim, ", ")
+		return s[:loc[0]], res
+	}
+	return s, nil
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// addPublishedInWarnings adds warnings about incomplete references, or
+// about a reference year that differs from the year of the authorship.
+func (p *Engine) addPublishedInWarnings(pub *parsed.PublishedIn) {
+	if pub.Pages == "" {
+		p.addWarn(parsed.PublishedInNoPagesWarn)
+	}
+	if pub.Year == "" {
+		p.addWarn(parsed.PublishedInNoYearWarn)
+		return
+	}
+	if p.sn == nil || p.sn.nameData == nil {
+		return
+	}
+	an := p.sn.lastAuthorship()
+	if an == nil {
+		return
+	}
+	ao := an.details()
+	ag := ao.Original
+	if ao.Combination != nil {
+		ag = ao.Combination
+	}
+	if ag != nil && ag.Year != nil && ag.Year.Value != pub.Year {
+		p.addWarn(parsed.PublishedInYearMismatchWarn)
+	}
+}
@@ -0,0 +1,221 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/gnames/gnparser/ent/internal/preprocess"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/io/dict"
+)
+
+// cultureCollections contains acronyms of culture collections that are
+// commonly used in designations of bacterial strains.
+var cultureCollections = map[string]struct{}{
+	"ATCC": {}, "BCRC": {}, "CBS": {}, "CCM": {}, "CCUG": {}, "CCT": {},
+	"CECT": {}, "CGMCC": {}, "CIP": {}, "DSM": {}, "DSMZ": {}, "HAMBI": {},
+	"IAM": {}, "ICMP": {}, "IFO": {}, "JCM": {}, "KCCM": {}, "KCTC": {},
+	"LMD": {}, "LMG": {}, "MTCC": {}, "NBRC": {}, "NCCB": {}, "NCDO": {},
+	"NCIB": {}, "NCIMB": {}, "NCPPB": {}, "NCTC": {}, "NRRL": {}, "PCC": {},
+	"TISTR": {}, "VKM": {},
+}
+
+// strainMarkers are words that introduce a strain designation.
+var strainMarkers = map[string]struct{}{
+	"strain": {}, "str.": {}, "substr.": {},
+}
+
+// strainTypeMarkers designate a type strain if they follow a strain number.
+var strainTypeMarkers = map[string]struct{}{
+	"T": {}, "(T)": {}, "^T": {}, "ᵀ": {},
+}
+
+// strainSeparators are allowed between several strain designations.
+var strainSeparators = map[string]struct{}{
+	"=": {}, "and": {}, "&": {}, ",": {}, ";": {},
+}
+
+var collectionNumberRe = regexp.MustCompile(`^([A-Z]{2,6})[-:]([A-Z]*\d[\w.-]*)$`)
+var strainNumberRe = regexp.MustCompile(`^[A-Z]*\d[\w.-]*$`)
+var strainDesignationRe = regexp.MustCompile(`^[\w][\w.:/-]*$`)
+
+type strainToken struct {
+	value string
+	start int
+	sep   bool
+}
+
+// cutStrains finds strain designations at the end of a bacterial name,
+// converts them to Strains and removes them from the body and the tail
+// of the name, so they are not reported as an unparsed tail.
+func cutStrains(pr *preprocess.Preprocessor, d *dict.Dictionary) []parsed.Strain {
+	if !isBacterialName(pr.Body, d) {
+		return nil
+	}
+	bl := len(pr.Body)
+	full := string(pr.Body) + string(pr.Tail)
+	toks := strainTokens(full)
+
+	// strains cannot start at the genus or a specific epithet
+	for i := 2; i < len(toks); i++ {
+		if toks[i].sep {
+			continue
+		}
+		strains, ok := parseStrains(toks[i:])
+		if !ok {
+			continue
+		}
+		idx := strings.LastIndexFunc(full[:toks[i].start], func(r rune) bool {
+			return !unicode.IsSpace(r) && r != ',' && r != '('
+		})
+		idx++
+		if idx < bl {
+			pr.Body = pr.Body[:idx]
+			pr.Tail = nil
+		} else {
+			pr.Tail = []byte(full[bl:idx])
+		}
+		return strains
+	}
+	return nil
+}
+
+func isBacterialName(body []byte, d *dict.Dictionary) bool {
+	words := strings.Fields(string(body))
+	if len(words) < 2 {
+		return false
+	}
+	if words[0] == "Candidatus" {
+		return true
+	}
+	_, ok := d.Bacteria[words[0]]
+	return ok
+}
+
+// strainTokens splits a string into words, detaching commas and semicolons
+// into separate separator tokens.
+func strainTokens(s string) []strainToken {
+	var res []strainToken
+	var start int
+	for _, w := range strings.Fields(s) {
+		start += strings.Index(s[start:], w)
+		val := strings.TrimRight(w, ",;")
+		if val != "" {
+			_, sep := strainSeparators[val]
+			res = append(res, strainToken{value: val, start: start, sep: sep})
+		}
+		if val != w {
+			res = append(res, strainToken{
+				value: w[len(val):],
+				start: start + len(val),
+				sep:   true,
+			})
+		}
+		start += len(w)
+	}
+	return res
+}
+
+// parseStrains succeeds only if all the tokens are strain designations
+// or separators between them.
+func parseStrains(toks []strainToken) ([]parsed.Strain, bool) {
+	var res []parsed.Strain
+	for i := 0; i < len(toks); i++ {
+		if toks[i].sep {
+			continue
+		}
+		st, n := parseStrain(toks[i:])
+		if n == 0 {
+			return nil, false
+		}
+		i += n - 1
+		res = append(res, st)
+	}
+	return res, len(res) > 0
+}
+
+// parseStrain tries to convert the first tokens into a strain. It returns
+// the strain and the number of used tokens, or zero if a strain is not
+// found.
+func parseStrain(toks []strainToken) (parsed.Strain, int) {
+	var res parsed.Strain
+	val := func(i int) string {
+		v := strings.TrimPrefix(toks[i].value, "(")
+		if !strings.Contains(v, "(") {
+			v = strings.TrimSuffix(v, ")")
+		}
+		return v
+	}
+
+	var n int
+	if strings.EqualFold(val(0), "type") && len(toks) > 1 &&
+		strings.EqualFold(val(1), "strain") {
+		res.IsType = true
+		n = 1
+	}
+	_, isMarker := strainMarkers[strings.ToLower(val(n))]
+	if isMarker {
+		n++
+	}
+	if n >= len(toks) {
+		return res, 0
+	}
+
+	v := val(n)
+	switch {
+	case collectionNumberRe.MatchString(v):
+		m := collectionNumberRe.FindStringSubmatch(v)
+		if !isCollection(m[1]) {
+			return res, 0
+		}
+		res.Collection = m[1]
+		res.Number = m[2]
+		n++
+	case len(toks) > n+1 && strainNumberRe.MatchString(val(n+1)) &&
+		isCollection(v):
+		res.Collection = v
+		res.Number = val(n + 1)
+		n += 2
+	case isMarker && strainDesignationRe.MatchString(v):
+		res.Number = v
+		n++
+	default:
+		return res, 0
+	}
+
+	for _, suffix := range []string{"^T", "ᵀ", "(T)", "T"} {
+		num := strings.TrimSuffix(res.Number, suffix)
+		if num != res.Number && num != "" && unicode.IsDigit(lastRune(num)) {
+			res.Number = num
+			res.IsType = true
+			break
+		}
+	}
+	if len(toks) > n {
+		if _, ok := strainTypeMarkers[toks[n].value]; ok {
+			res.IsType = true
+			n++
+		}
+	}
+
+	words := make([]string, n)
+	for i := range words {
+		words[i] = val(i)
+		if _, ok := strainTypeMarkers[toks[i].value]; ok && i > 0 {
+			words[i] = toks[i].value
+		}
+	}
+	res.Verbatim = strings.Join(words, " ")
+	return res, n
+}
+
+func isCollection(s string) bool {
+	_, ok := cultureCollections[s]
+	return ok
+}
+
+func lastRune(s string) rune {
+	rs := []rune(s)
+	return rs[len(rs)-1]
+}
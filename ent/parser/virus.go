@@ -0,0 +1,402 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/gnames/gnparser/ent/parsed"
+)
+
+// virusNode is a result of parsing of a name of a virus, phage, plasmid,
+// prion, satellite or viroid. Such names do not follow the grammar of
+// scientific names, so they are parsed by a simple word-based parser
+// instead of PEG.
+type virusNode struct {
+	category  parsed.VirusCategory
+	binomial  bool
+	nameWords []parsed.Word
+	strain    string
+	isolate   string
+	serotype  string
+	tokens    []string
+	wrds      []parsed.Word
+}
+
+type virusSegment int
+
+const (
+	virusMarkerSeg virusSegment = iota - 1
+	virusNameSeg
+	virusStrainSeg
+	virusIsolateSeg
+	virusSerotypeSeg
+)
+
+// virusMarkers are words that introduce strain, isolate or serotype
+// designations.
+var virusMarkers = map[string]virusSegment{
+	"strain":    virusStrainSeg,
+	"str.":      virusStrainSeg,
+	"isolate":   virusIsolateSeg,
+	"isol.":     virusIsolateSeg,
+	"serotype":  virusSerotypeSeg,
+	"serovar":   virusSerotypeSeg,
+	"serogroup": virusSerotypeSeg,
+	"sv.":       virusSerotypeSeg,
+}
+
+// virusCategories is ordered by precedence: a satellite virus is
+// a satellite, a phage virus is a phage etc.
+var virusCategories = []parsed.VirusCategory{
+	parsed.SatelliteCat,
+	parsed.ViroidCat,
+	parsed.PrionCat,
+	parsed.PlasmidCat,
+	parsed.PhageCat,
+	parsed.VirusCat,
+}
+
+var virusGenusRe = regexp.MustCompile(`^[A-Z][a-z]+(virus|viroid|satellite)$`)
+var virusEpithetRe = regexp.MustCompile(`^[a-z]+(-[a-z]+)?$`)
+var influenzaSerotypeRe = regexp.MustCompile(`\((H\d+N\d+)\)$`)
+
+// newVirusScientificNameNode creates a scientific name node from a name
+// that was recognized as a virus-like name by the preprocessor.
+func (p *Engine) newVirusScientificNameNode(s string) {
+	if !parensBalanced(s) {
+		p.addWarn(parsed.VirusParensUnbalancedWarn)
+	}
+	vn, tail := newVirusNode(s)
+	sn := &scientificNameNode{
+		nameData: vn,
+		virus:    true,
+		tail:     tail,
+	}
+	if vn.binomial {
+		sn.cardinality = 2
+	}
+	p.sn = sn
+}
+
+func newVirusNode(s string) (*virusNode, string) {
+	rs := []rune(s)
+	toks := virusTokens(rs)
+	var tail string
+	for i := range toks {
+		if i > 0 && strings.HasPrefix(toks[i].Verbatim, "[") {
+			tail = string(rs[toks[i-1].End:])
+			toks = toks[:i]
+			break
+		}
+	}
+	// brackets without content, like in 'isolate ( )', are not a part of
+	// a name or of a designation.
+	n := 0
+	for i := range toks {
+		if strings.Trim(toks[i].Verbatim, "()[]") != "" {
+			toks[n] = toks[i]
+			n++
+		}
+	}
+	toks = toks[:n]
+
+	vn := &virusNode{}
+	segs := make([]virusSegment, len(toks))
+	seg := virusNameSeg
+	var nameEnd int
+	parens := make(map[virusSegment]bool)
+	for i := range toks {
+		w := strings.ToLower(toks[i].Verbatim)
+		if sg, ok := virusMarkers[strings.TrimPrefix(w, "(")]; ok && i > 0 {
+			seg = sg
+			segs[i] = virusMarkerSeg
+			parens[sg] = strings.HasPrefix(w, "(")
+			continue
+		}
+		segs[i] = seg
+		if seg == virusNameSeg {
+			nameEnd = i + 1
+		}
+	}
+
+	vn.designationInName(rs, toks[:nameEnd], segs)
+
+	for i := range toks {
+		if segs[i] == virusNameSeg {
+			vn.nameWords = append(vn.nameWords, toks[i])
+		}
+	}
+	vn.strain = joinVirusSegment(toks, segs, virusStrainSeg, vn.strain)
+	vn.isolate = joinVirusSegment(toks, segs, virusIsolateSeg, vn.isolate)
+	vn.serotype = joinVirusSegment(toks, segs, virusSerotypeSeg, vn.serotype)
+	for sg, v := range map[virusSegment]*string{
+		virusStrainSeg:   &vn.strain,
+		virusIsolateSeg:  &vn.isolate,
+		virusSerotypeSeg: &vn.serotype,
+	} {
+		if parens[sg] {
+			*v = strings.TrimSuffix(*v, ")")
+		}
+	}
+	if vn.serotype == "" {
+		if m := influenzaSerotypeRe.FindStringSubmatch(vn.strain); m != nil {
+			vn.serotype = m[1]
+		}
+	}
+
+	vn.category = parsed.VirusCat
+	for _, c := range virusCategories {
+		if vn.hasCategory(c) {
+			vn.category = c
+			break
+		}
+	}
+
+	vn.binomial = len(vn.nameWords) == 2 &&
+		virusGenusRe.MatchString(vn.nameWords[0].Verbatim) &&
+		virusEpithetRe.MatchString(vn.nameWords[1].Verbatim) &&
+		virusWordCategory(vn.nameWords[1].Verbatim) == parsed.UnknownVirusCategory
+
+	for i := range toks {
+		vn.tokens = append(vn.tokens, toks[i].Verbatim)
+	}
+	for i := 0; i < len(toks); i++ {
+		if segs[i] == virusMarkerSeg {
+			continue
+		}
+		wrd := toks[i]
+		switch {
+		case segs[i] != virusNameSeg:
+			// a designation is one word even if it contains spaces,
+			// like 'A/Puerto Rico/8/1934(H1N1)'.
+			j := i
+			for j+1 < len(toks) && segs[j+1] == segs[i] {
+				j++
+			}
+			wrd = designationWord(rs, toks[i].Start, toks[j].End, parens[segs[i]])
+			i = j
+		case vn.binomial && len(vn.wrds) == 0:
+			wrd.Type = parsed.GenusType
+		case vn.binomial:
+			wrd.Type = parsed.SpEpithetType
+		default:
+			wrd.Type = parsed.VirusWordType
+		}
+		vn.wrds = append(vn.wrds, wrd)
+	}
+	return vn, tail
+}
+
+// designationInName finds strain designations that are not introduced
+// by a marker word. NCBI often provides them in parentheses like
+// "Influenza A virus (A/Puerto Rico/8/1934(H1N1))", or as a slash-separated
+// list after the name like "Australian bat lyssavirus human/AUS/1998".
+func (vn *virusNode) designationInName(
+	rs []rune,
+	toks []parsed.Word,
+	segs []virusSegment,
+) {
+	l := len(toks)
+	if l < 2 {
+		return
+	}
+
+	last := toks[l-1].Verbatim
+	if strings.HasSuffix(last, ")") {
+		for i := 1; i < l; i++ {
+			if !strings.HasPrefix(toks[i].Verbatim, "(") {
+				continue
+			}
+			inner := string(rs[toks[i].Start+1 : toks[l-1].End-1])
+			if strings.Contains(inner, "/") {
+				vn.strain = inner
+				for j := i; j < l; j++ {
+					segs[j] = virusStrainSeg
+				}
+				return
+			}
+			break
+		}
+	}
+
+	catIdx := -1
+	for i := range toks {
+		if virusWordCategory(toks[i].Verbatim) != parsed.UnknownVirusCategory {
+			catIdx = i
+		}
+	}
+	if catIdx < 0 || catIdx == l-1 {
+		return
+	}
+	for i := catIdx + 1; i < l; i++ {
+		if strings.Contains(toks[i].Verbatim, "/") {
+			for j := catIdx + 1; j < l; j++ {
+				segs[j] = virusStrainSeg
+			}
+			vn.strain = joinVirusSegment(toks, segs, virusStrainSeg, "")
+			return
+		}
+	}
+}
+
+// designationWord creates a word for a strain, isolate or serotype
+// designation that spans from start to end. Enclosing parentheses are
+// not included into the word. If the designation follows a marker in
+// parentheses, like '(strain Ab 12)', only the closing parenthesis is
+// removed.
+func designationWord(rs []rune, start, end int, closeParen bool) parsed.Word {
+	switch {
+	case rs[start] == '(' && rs[end-1] == ')':
+		start, end = start+1, end-1
+	case closeParen && rs[end-1] == ')':
+		end--
+	}
+	w := string(rs[start:end])
+	return parsed.Word{
+		Verbatim:   w,
+		Normalized: w,
+		Type:       parsed.StrainType,
+		Start:      start,
+		End:        end,
+	}
+}
+
+func (vn *virusNode) hasCategory(c parsed.VirusCategory) bool {
+	for i := range vn.nameWords {
+		if virusWordCategory(vn.nameWords[i].Verbatim) == c {
+			return true
+		}
+	}
+	return false
+}
+
+// virusWordCategory returns a category of a sub-cellular entity if a word
+// indicates it, for example 'bacteriophage', 'herpesviruses', 'viroid'.
+func virusWordCategory(w string) parsed.VirusCategory {
+	w = strings.ToLower(w)
+	w = strings.TrimFunc(w, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	switch {
+	case strings.Contains(w, "satellite"):
+		return parsed.SatelliteCat
+	case strings.HasSuffix(w, "viroid"), strings.HasSuffix(w, "viroids"):
+		return parsed.ViroidCat
+	case w == "prion", w == "prions":
+		return parsed.PrionCat
+	case strings.HasSuffix(w, "plasmid"), strings.HasSuffix(w, "plasmids"),
+		w == "vector", w == "vectors":
+		return parsed.PlasmidCat
+	case strings.Contains(w, "phage"):
+		return parsed.PhageCat
+	case strings.Contains(w, "virus"), w == "vir", w == "ictv",
+		strings.HasSuffix(w, "npv"), strings.Contains(w, "npv-"),
+		strings.Contains(w, "particle"):
+		return parsed.VirusCat
+	}
+	return parsed.UnknownVirusCategory
+}
+
+// virusTokens splits a name into space-separated words and remembers
+// their positions.
+func virusTokens(rs []rune) []parsed.Word {
+	var res []parsed.Word
+	start := -1
+	for i := 0; i <= len(rs); i++ {
+		if i < len(rs) && !unicode.IsSpace(rs[i]) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			w := string(rs[start:i])
+			res = append(res, parsed.Word{
+				Verbatim:   w,
+				Normalized: w,
+				Start:      start,
+				End:        i,
+			})
+			start = -1
+		}
+	}
+	return res
+}
+
+// parensBalanced checks if every opening parenthesis or bracket in
+// a string has a matching closing one.
+func parensBalanced(s string) bool {
+	var stack []rune
+	pairs := map[rune]rune{')': '(', ']': '['}
+	for _, r := range s {
+		switch r {
+		case '(', '[':
+			stack = append(stack, r)
+		case ')', ']':
+			if len(stack) == 0 || stack[len(stack)-1] != pairs[r] {
+				return false
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+	return len(stack) == 0
+}
+
+func joinVirusSegment(
+	toks []parsed.Word,
+	segs []virusSegment,
+	seg virusSegment,
+	val string,
+) string {
+	if val != "" {
+		return val
+	}
+	var res []string
+	for i := range toks {
+		if segs[i] == seg {
+			res = append(res, toks[i].Verbatim)
+		}
+	}
+	return strings.Join(res, " ")
+}
+
+func (vn *virusNode) name() string {
+	res := make([]string, len(vn.nameWords))
+	for i := range vn.nameWords {
+		res[i] = vn.nameWords[i].Verbatim
+	}
+	return strings.Join(res, " ")
+}
+
+func (vn *virusNode) value() string {
+	return strings.Join(vn.tokens, " ")
+}
+
+func (vn *virusNode) canonical() *canonical {
+	name := vn.name()
+	return &canonical{Value: name, ValueRanked: name}
+}
+
+func (vn *virusNode) words() []parsed.Word {
+	return vn.wrds
+}
+
+func (vn *virusNode) lastAuthorship() *authorshipNode {
+	return nil
+}
+
+func (vn *virusNode) details() parsed.Details {
+	v := parsed.Virus{
+		Category: vn.category,
+		Name:     vn.name(),
+		Strain:   vn.strain,
+		Isolate:  vn.isolate,
+		Serotype: vn.serotype,
+	}
+	if vn.binomial {
+		v.Genus = vn.nameWords[0].Verbatim
+		v.Species = vn.nameWords[1].Verbatim
+	}
+	return parsed.DetailsVirus{Virus: v}
+}
@@ -0,0 +1,143 @@
+// Package phonetic creates Taxamatch-style phonetic keys of scientific
+// names. Such keys allow to match names that differ by spelling variants
+// like "Pseudophyllum" and "Pseudofillum", "Aequorea" and "Equorea", which
+// are common in names from OCR-ed and legacy data.
+//
+// The algorithm is based on the near_match function of Taxamatch by Tony
+// Rees (Rees T (2014) Taxamatch, an Algorithm for Near ('Fuzzy') Matching
+// of Scientific Names in Taxonomic Databases. PLoS ONE 9(9): e107510).
+// In addition to the original algorithm "ph" is equated with "f".
+package phonetic
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/gnames/gnparser/ent/str"
+)
+
+// leadingSubst are replacements of the first letters of a word.
+var leadingSubst = []struct{ from, to string }{
+	{"AE", "E"}, {"CN", "N"}, {"CT", "T"}, {"CZ", "C"}, {"DJ", "J"},
+	{"EA", "E"}, {"EU", "U"}, {"GN", "N"}, {"KN", "N"}, {"MC", "MAC"},
+	{"MN", "N"}, {"OE", "E"}, {"QU", "Q"}, {"PS", "S"}, {"PT", "T"},
+	{"TS", "S"}, {"WR", "R"}, {"PH", "F"}, {"X", "Z"},
+}
+
+// restSubst equates sound-alike combinations of letters after the first
+// letter of a word. Replacements are applied one after another.
+var restSubst = []struct{ from, to string }{
+	{"PH", "F"}, {"AE", "I"}, {"IA", "A"}, {"OE", "I"}, {"OI", "A"},
+	{"SC", "S"},
+}
+
+// letterMap equates sound-alike letters after the first letter of a word,
+// 'H' is removed.
+var letterMap = map[rune]string{
+	'E': "I", 'O': "A", 'U': "I", 'Y': "I", 'K': "C", 'Z': "S", 'H': "",
+}
+
+// NearMatch returns a phonetic key of a word. Endings of specific and
+// infraspecific epithets ("-is", "-us", "-um", "-as", "-os" etc.) are
+// normalized to "-a" if epithet is true. The key is in upper case.
+func NearMatch(word string, epithet bool) string {
+	w := strings.ToUpper(str.Normalize(str.TransliterateDiaereses(word)))
+	w = strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && unicode.IsLetter(r) {
+			return r
+		}
+		return -1
+	}, w)
+	if w == "" {
+		return ""
+	}
+
+	for _, v := range leadingSubst {
+		if strings.HasPrefix(w, v.from) && len(w) > len(v.from) {
+			w = v.to + w[len(v.from):]
+			break
+		}
+	}
+
+	first, rest := w[:1], w[1:]
+	for _, v := range restSubst {
+		rest = strings.ReplaceAll(rest, v.from, v.to)
+	}
+	var sb strings.Builder
+	sb.WriteString(first)
+	for _, r := range rest {
+		if s, ok := letterMap[r]; ok {
+			sb.WriteString(s)
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	w = squeeze(sb.String())
+
+	if epithet && len(w) > 4 {
+		for _, suffix := range []string{"IS", "IM", "AS"} {
+			if strings.HasSuffix(w, suffix) {
+				w = squeeze(w[:len(w)-2] + "A")
+				break
+			}
+		}
+	}
+	return w
+}
+
+// Canonical takes a simple canonical form of a name and returns
+// its phonetic version. The first word of a name (or of a hybrid formula
+// element) is treated as a genus, the rest of the words as epithets.
+// Cultivar epithets, hybrid signs and words shorter than 3 characters
+// stay unchanged. Phonetic keys are capitalized for genera and uninomials,
+// and are in lower case for epithets.
+func Canonical(c string) string {
+	graftChimeraFormulaParts := strings.Split(c, " + ")
+	for gci, gcv := range graftChimeraFormulaParts {
+		hybridFormulaParts := strings.Split(gcv, " × ")
+		for hi, hv := range hybridFormulaParts {
+			nameParts := strings.Split(hv, "‘")
+			words := strings.Split(nameParts[0], " ")
+			for wi, wv := range words {
+				words[wi] = phoneticWord(wv, wi > 0)
+			}
+			nameParts[0] = strings.Join(words, " ")
+			hybridFormulaParts[hi] = strings.Join(nameParts, "‘")
+		}
+		graftChimeraFormulaParts[gci] = strings.Join(hybridFormulaParts, " × ")
+	}
+	return strings.Join(graftChimeraFormulaParts, " + ")
+}
+
+func phoneticWord(w string, epithet bool) string {
+	if len(w) < 3 {
+		return w
+	}
+	for _, r := range w {
+		if !unicode.IsLetter(r) && r != '-' {
+			return w
+		}
+	}
+	res := NearMatch(w, epithet)
+	if res == "" {
+		return w
+	}
+	if epithet {
+		return strings.ToLower(res)
+	}
+	return res[:1] + strings.ToLower(res[1:])
+}
+
+// squeeze removes repeated characters, e.g. "LL" becomes "L".
+func squeeze(s string) string {
+	var sb strings.Builder
+	var prev rune
+	for i, r := range s {
+		if i > 0 && r == prev {
+			continue
+		}
+		sb.WriteRune(r)
+		prev = r
+	}
+	return sb.String()
+}
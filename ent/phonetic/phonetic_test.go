@@ -0,0 +1,52 @@
+package phonetic_test
+
+import (
+	"testing"
+
+	"github.com/gnames/gnparser/ent/phonetic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNearMatch(t *testing.T) {
+	tests := []struct {
+		msg, in string
+		epithet bool
+		out     string
+	}{
+		{"empty", "", false, ""},
+		{"ph/f", "Pseudophyllum", false, "SIDAFILIM"},
+		{"ph/f2", "Pseudofillum", false, "SIDAFILIM"},
+		{"ae/e", "Aequorea", false, "EQIARIA"},
+		{"ae/e2", "Equorea", false, "EQIARIA"},
+		{"y/i", "Sylvia", false, "SILVA"},
+		{"y/i2", "Silvia", false, "SILVA"},
+		{"leading x", "Xanthium", false, "ZANTIM"},
+		{"mc", "Mcdonaldia", false, "MACDANALDA"},
+		{"diaeresis", "Leptochloöpsis", false, "LIPTACLAPSIS"},
+		{"ending us", "albus", true, "ALBA"},
+		{"ending um", "album", true, "ALBA"},
+		{"ending a", "alba", true, "ALBA"},
+		{"short", "bus", true, "BIS"},
+		{"genus ending", "Sylvius", false, "SILVIS"},
+	}
+	for _, v := range tests {
+		assert.Equal(t, v.out, phonetic.NearMatch(v.in, v.epithet), v.msg)
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	tests := []struct {
+		msg, in, out string
+	}{
+		{"uninomial", "Pseudophyllum", "Sidafilim"},
+		{"binomial", "Aequorea albus", "Eqiaria alba"},
+		{"trinomial", "Betula alba naturae", "Bitila alba natiri"},
+		{"abbr genus", "Mo. alpium", "Mo. alpa"},
+		{"hybrid formula", "Abies alba × Pinus", "Abis alba × Pinis"},
+		{"graft chimera", "Crataegus + Mespilus", "Cratigis + Mispilis"},
+		{"cultivar", "Sorbus aria ‘Aurea’", "Sarbis ara ‘Aurea’"},
+	}
+	for _, v := range tests {
+		assert.Equal(t, v.out, phonetic.Canonical(v.in), v.msg)
+	}
+}
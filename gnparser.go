@@ -11,6 +11,7 @@ import (
 
 	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnlib/ent/gnvers"
+	"github.com/gnames/gnparser/ent/compare"
 	"github.com/gnames/gnparser/ent/nameidx"
 	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/gnames/gnparser/ent/parser"
@@ -31,7 +32,7 @@ type gnparser struct {
 // interface.
 func New(cfg Config) GNparser {
 	gnp := gnparser{cfg: cfg}
-	gnp.parser = parser.New()
+	gnp.parser = parser.New(gnp.cfg.Dictionary)
 	return gnp
 }
 
@@ -43,15 +44,53 @@ func (gnp gnparser) Debug(s string) []byte {
 // Parse function parses input string according to configurations.
 // It takes a string and returns an parsed.Parsed object.
 func (gnp gnparser) ParseName(s string) parsed.Parsed {
+	// Darwin Core output requires details to find elements of a name.
+	withDetails := gnp.cfg.WithDetails || gnp.cfg.WithDwC
+	return gnp.parseName(s, withDetails)
+}
+
+// ParseComponents assembles a name-string from its components, parses it
+// and checks if the result is consistent with the components.
+func (gnp gnparser) ParseComponents(c parsed.Components) parsed.Parsed {
+	name, warns := c.Name()
+	// words are required to compare the result with components.
+	res := gnp.parseName(name, true)
+	res.AddWarnings(warns...)
+	res.CheckComponents(c)
+
+	if gnp.cfg.WithDetails || gnp.cfg.WithDwC {
+		return res
+	}
+	res.Details = nil
+	res.Words = nil
+	if res.Authorship != nil {
+		res.Authorship.Original = nil
+		res.Authorship.Combination = nil
+	}
+	return res
+}
+
+func (gnp gnparser) parseName(s string, withDetails bool) parsed.Parsed {
 	ver := Version
 	if gnp.cfg.IsTest {
 		ver = "test_version"
 	}
 	sciNameNode := gnp.parser.PreprocessAndParse(
 		s, ver, gnp.cfg.IgnoreHTMLTags, gnp.cfg.WithCapitalization, gnp.cfg.WithCultivars, gnp.cfg.WithPreserveDiaereses,
+		gnp.cfg.WithPublishedIn, gnp.cfg.WithPhonetic, gnp.cfg.Code,
 	)
-	res := sciNameNode.ToOutput(gnp.cfg.WithDetails)
-	return res
+	return sciNameNode.ToOutput(withDetails)
+}
+
+// CompareNames parses two name-strings and compares the results.
+func (gnp gnparser) CompareNames(a, b string) compare.Match {
+	// words are required to find which elements of names differ.
+	return compare.Compare(gnp.parseName(a, true), gnp.parseName(b, true))
+}
+
+// ParseAuthorship parses an authorship string without a name.
+func (gnp gnparser) ParseAuthorship(s string) parsed.Authorship {
+	return gnp.parser.ParseAuthorship(s, gnp.cfg.IgnoreHTMLTags)
 }
 
 // ParseNames function takes input names and returns parsed results.
@@ -104,12 +143,37 @@ func (gnp gnparser) Format() gnfmt.Format {
 	return gnp.cfg.Format
 }
 
+// WithDwC returns true if CSV and TSV outputs contain Darwin Core terms.
+func (gnp gnparser) WithDwC() bool {
+	return gnp.cfg.WithDwC
+}
+
+// HeaderCSV returns the header of CSV and TSV outputs according to the
+// configured output format and Darwin Core setting.
+func (gnp gnparser) HeaderCSV() string {
+	if gnp.cfg.WithDwC {
+		return parsed.HeaderDwC(gnp.cfg.Format)
+	}
+	return parsed.HeaderCSV(gnp.cfg.Format)
+}
+
+// Output returns parsed results according to the configured output format
+// and Darwin Core setting.
+func (gnp gnparser) Output(p parsed.Parsed) string {
+	if gnp.cfg.WithDwC {
+		return p.OutputDwC(gnp.cfg.Format)
+	}
+	return p.Output(gnp.cfg.Format)
+}
+
 // ChangeConfig allows change configuration of already created
-// GNparser object.
+// GNparser object. The returned object gets its own parsing engine, so it
+// can be used concurrently with the original one.
 func (gnp gnparser) ChangeConfig(opts ...Option) GNparser {
 	for i := range opts {
 		opts[i](&gnp.cfg)
 	}
+	gnp.parser = parser.New(gnp.cfg.Dictionary)
 	return gnp
 }
 
@@ -131,7 +195,7 @@ func (gnp gnparser) parseWorker(
 	wgIn *sync.WaitGroup,
 ) {
 	defer wgIn.Done()
-	gnp.parser = parser.New()
+	gnp.parser = parser.New(gnp.cfg.Dictionary)
 
 	for v := range chIn {
 		parseRes := gnp.ParseName(v.NameString)
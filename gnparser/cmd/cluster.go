@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnsys"
+	"github.com/spf13/cobra"
+)
+
+// clusterCmd collapses variants of name-strings into clusters.
+var clusterCmd = &cobra.Command{
+	Use:   "cluster names.txt",
+	Short: "Clusters name-strings by their canonical forms.",
+	Long: `
+Reads a list of name-strings (one name per line) and collapses spelling and
+authorship variants into clusters that share the same simple (or stemmed)
+canonical form. For every cluster it shows the number of name-strings,
+the number of distinct variants, the most common verbatim form and the
+distribution of parsing quality.
+
+To cluster names from a file by simple canonical forms:
+gnparser cluster names.txt
+
+To cluster names from STDIN by stemmed canonical forms:
+cat names.txt | gnparser cluster --stemmed -f compact
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		formatFlag(cmd)
+		jobsNumFlag(cmd)
+		ignoreHTMLTagsFlag(cmd)
+		batchSizeFlag(cmd)
+		stemmed, _ := cmd.Flags().GetBool("stemmed")
+		quiet, _ := cmd.Flags().GetBool("quiet")
+		gnp := gnparser.New(gnparser.NewConfig(opts...))
+
+		var r io.Reader
+		switch len(args) {
+		case 0:
+			if !checkStdin() {
+				_ = cmd.Help()
+				os.Exit(1)
+			}
+			r = os.Stdin
+		case 1:
+			exists, _ := gnsys.FileExists(args[0])
+			if !exists {
+				log.Fatalf("Cannot find file '%s'", args[0])
+			}
+			f, err := os.OpenFile(args[0], os.O_RDONLY, os.ModePerm)
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer f.Close()
+			r = f
+		default:
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+		clusterNames(gnp, r, stemmed, quiet)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(clusterCmd)
+
+	clusterCmd.Flags().BoolP("stemmed", "S", false,
+		"cluster by stemmed canonical forms instead of simple ones")
+	clusterCmd.Flags().StringP("format", "f", "",
+		"sets output format. Can be one of:\n  'csv', 'tsv', 'compact', 'pretty'")
+	clusterCmd.Flags().BoolP("ignore_tags", "i", false,
+		"ignore HTML entities and tags when parsing.")
+	clusterCmd.Flags().IntP("jobs", "j", 0,
+		"number of threads to run. CPU's threads number is the default.")
+	clusterCmd.Flags().IntP("batch_size", "b", 0,
+		"maximum number of names in a batch send for processing.")
+	clusterCmd.Flags().BoolP("quiet", "q", false, "do not show progress")
+}
+
+// clusterNames parses names in batches, so large lists of names do not
+// have to be kept in memory.
+func clusterNames(gnp gnparser.GNparser, r io.Reader, stemmed, quiet bool) {
+	bs := gnparser.NewConfig(opts...).BatchSize
+	cl := parsed.NewClustering(stemmed)
+	start := time.Now()
+	batch := make([]string, 0, bs)
+	var total int
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		batch = append(batch, sc.Text())
+		if len(batch) == bs {
+			total += len(batch)
+			if !quiet {
+				progressLog(start, total)
+			}
+			cl.Add(gnp.ParseNames(batch)...)
+			batch = batch[:0]
+		}
+	}
+	if err := sc.Err(); err != nil {
+		log.Panic(err)
+	}
+	cl.Add(gnp.ParseNames(batch)...)
+
+	f := outputFormat(gnp)
+	header := parsed.HeaderClusterCSV(f)
+	if header != "" {
+		fmt.Println(header)
+	}
+	for _, v := range cl.Clusters() {
+		fmt.Println(v.Output(f))
+	}
+}
@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/compare"
+	"github.com/gnames/gnsys"
+	"github.com/spf13/cobra"
+)
+
+// compareCmd compares two name-strings, or pairs of names from a file.
+var compareCmd = &cobra.Command{
+	Use:   "compare name1 name2",
+	Short: "Compares two scientific names.",
+	Long: `
+Compares two scientific names and reports how they relate: identical
+verbatim, the same full, simple or stemmed canonical form, difference only
+in rank markers, compatibility of authorships, and elements that differ.
+
+To compare two names:
+gnparser compare "Aus bus var. cus L." "Aus bus f. cus Linnaeus"
+
+To compare pairs of names from a file (two tab-separated names per line):
+gnparser compare pairs.tsv -f compact
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		formatFlag(cmd)
+		ignoreHTMLTagsFlag(cmd)
+		cfg := gnparser.NewConfig(opts...)
+		gnp := gnparser.New(cfg)
+		f := outputFormat(gnp)
+
+		switch len(args) {
+		case 2:
+			printCompareHeader(f)
+			fmt.Println(gnp.CompareNames(args[0], args[1]).Output(f))
+		case 1:
+			exists, _ := gnsys.FileExists(args[0])
+			if !exists {
+				_ = cmd.Help()
+				os.Exit(1)
+			}
+			fl, err := os.OpenFile(args[0], os.O_RDONLY, os.ModePerm)
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer fl.Close()
+			comparePairs(gnp, fl, f)
+		case 0:
+			if !checkStdin() {
+				_ = cmd.Help()
+				os.Exit(1)
+			}
+			comparePairs(gnp, os.Stdin, f)
+		default:
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(compareCmd)
+
+	compareCmd.Flags().StringP("format", "f", "",
+		"sets output format. Can be one of:\n  'csv', 'tsv', 'compact', 'pretty'")
+	compareCmd.Flags().BoolP("ignore_tags", "i", false,
+		"ignore HTML entities and tags when parsing.")
+}
+
+// outputFormat returns the output format for commands where Darwin Core
+// format is not applicable, CSV is used instead of it.
+func outputFormat(gnp gnparser.GNparser) gnfmt.Format {
+	f := gnp.Format()
+	if f != gnfmt.CSV && f != gnfmt.TSV && f != gnfmt.CompactJSON &&
+		f != gnfmt.PrettyJSON {
+		return gnfmt.CSV
+	}
+	return f
+}
+
+func comparePairs(gnp gnparser.GNparser, r io.Reader, f gnfmt.Format) {
+	printCompareHeader(f)
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := sc.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		names := strings.SplitN(line, "\t", 2)
+		if len(names) < 2 {
+			log.Printf("Cannot find two tab-separated names in '%s'", line)
+			continue
+		}
+		fmt.Println(gnp.CompareNames(names[0], names[1]).Output(f))
+	}
+	if err := sc.Err(); err != nil {
+		log.Panic(err)
+	}
+}
+
+func printCompareHeader(f gnfmt.Format) {
+	header := compare.HeaderCSV(f)
+	if header != "" {
+		fmt.Println(header)
+	}
+}
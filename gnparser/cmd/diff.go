@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/diff"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnsys"
+	"github.com/spf13/cobra"
+)
+
+// diffCmd compares two JSON outputs of gnparser.
+var diffCmd = &cobra.Command{
+	Use:   "diff old.jsonl new.jsonl",
+	Short: "Shows differences between two JSON outputs of gnparser.",
+	Long: `
+Compares two JSON outputs of gnparser (for example outputs of two versions
+of gnparser for the same names). Results are aligned by their ids, and
+for every changed name the command shows which fields changed: canonical
+forms, cardinality, quality, warnings, authorship, details or other
+fields. Summary counts of every type of change go to STDERR, or to STDOUT
+if only the summary is requested.
+
+To see changed names:
+gnparser diff old.jsonl new.jsonl
+
+To see only the summary:
+gnparser diff old.jsonl new.jsonl --summary
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 2 {
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+		formatFlag(cmd)
+		summary, _ := cmd.Flags().GetBool("summary")
+		f := outputFormat(gnparser.New(gnparser.NewConfig(opts...)))
+
+		res := diff.Compare(readParsed(args[0]), readParsed(args[1]))
+		if summary {
+			fmt.Println(res.SummaryOutput(f))
+			return
+		}
+
+		header := diff.HeaderCSV(f)
+		if header != "" {
+			fmt.Println(header)
+		}
+		for _, v := range res.Records {
+			fmt.Println(v.Output(f))
+		}
+		fmt.Fprintln(os.Stderr, res.SummaryOutput(gnfmt.CSV))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+
+	diffCmd.Flags().BoolP("summary", "s", false,
+		"show only summary counts of changes")
+	diffCmd.Flags().StringP("format", "f", "",
+		"sets output format. Can be one of:\n  'csv', 'tsv', 'compact', 'pretty'")
+}
+
+func readParsed(path string) []parsed.Parsed {
+	exists, _ := gnsys.FileExists(path)
+	if !exists {
+		log.Fatalf("Cannot find file '%s'", path)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	res, err := diff.Decode(f)
+	if err != nil {
+		log.Fatalf("Cannot decode '%s' as gnparser JSON output: %s", path, err)
+	}
+	return res
+}
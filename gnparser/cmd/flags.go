@@ -6,6 +6,7 @@ import (
 	"os"
 
 	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/io/dict"
 	"github.com/spf13/cobra"
 )
 
@@ -22,6 +23,15 @@ func versionFlag(cmd *cobra.Command) bool {
 	return false
 }
 
+func authorshipFlag(cmd *cobra.Command) bool {
+	b, err := cmd.Flags().GetBool("authorship")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	return b
+}
+
 func formatFlag(cmd *cobra.Command) {
 	f, err := cmd.Flags().GetString("format")
 	if err != nil {
@@ -110,6 +120,61 @@ func withEnableCultivarsFlag(cmd *cobra.Command) {
 	}
 }
 
+func withPublishedInFlag(cmd *cobra.Command) {
+	b, err := cmd.Flags().GetBool("published-in")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if b {
+		opts = append(opts, gnparser.OptWithPublishedIn(true))
+	}
+}
+
+func withPhoneticFlag(cmd *cobra.Command) {
+	b, err := cmd.Flags().GetBool("phonetic")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if b {
+		opts = append(opts, gnparser.OptWithPhonetic(true))
+	}
+}
+
+func codeFlag(cmd *cobra.Command) {
+	code, err := cmd.Flags().GetString("code")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if code != "" {
+		opts = append(opts, gnparser.OptCode(code))
+	}
+}
+
+func dictFlag(cmd *cobra.Command) {
+	dir, err := cmd.Flags().GetString("dict")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if dir == "" {
+		return
+	}
+	replace, err := cmd.Flags().GetBool("dict-replace")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	d, err := dict.Load(dir, replace)
+	if err != nil {
+		fmt.Printf("Cannot load dictionaries: %s\n", err)
+		os.Exit(1)
+	}
+	opts = append(opts, gnparser.OptDictionary(d))
+}
+
 func withStreamFlag(cmd *cobra.Command) {
 	withDet, err := cmd.Flags().GetBool("stream")
 	if err != nil {
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnsys"
+	"github.com/spf13/cobra"
+)
+
+// homotypyCmd groups names from a list into likely homotypic synonyms.
+var homotypyCmd = &cobra.Command{
+	Use:   "homotypy names.txt",
+	Short: "Groups names that are likely homotypic synonyms.",
+	Long: `
+Reads a list of names (one name per line) and groups names that have the
+same stemmed terminal epithet, original authors and year. Such names are
+likely homotypic synonyms, for example "Aus bus (L.) Smith" and
+"Cus bus L.".
+
+To group names from a file:
+gnparser homotypy names.txt
+
+To group names from STDIN and include names without synonyms:
+cat names.txt | gnparser homotypy --all -f compact
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		formatFlag(cmd)
+		jobsNumFlag(cmd)
+		ignoreHTMLTagsFlag(cmd)
+		all, err := cmd.Flags().GetBool("all")
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		opts = append(opts, gnparser.OptWithDetails(true))
+		gnp := gnparser.New(gnparser.NewConfig(opts...))
+
+		switch len(args) {
+		case 0:
+			if !checkStdin() {
+				_ = cmd.Help()
+				os.Exit(1)
+			}
+			groupHomotypic(gnp, os.Stdin, all)
+		case 1:
+			exists, _ := gnsys.FileExists(args[0])
+			if !exists {
+				log.Fatalf("Cannot find file '%s'", args[0])
+			}
+			f, err := os.OpenFile(args[0], os.O_RDONLY, os.ModePerm)
+			if err != nil {
+				log.Fatal(err)
+			}
+			defer f.Close()
+			groupHomotypic(gnp, f, all)
+		default:
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(homotypyCmd)
+
+	homotypyCmd.Flags().BoolP("all", "a", false,
+		"include groups with only one name")
+	homotypyCmd.Flags().StringP("format", "f", "",
+		"sets output format. Can be one of:\n  'csv', 'tsv', 'compact', 'pretty'")
+	homotypyCmd.Flags().BoolP("ignore_tags", "i", false,
+		"ignore HTML entities and tags when parsing.")
+	homotypyCmd.Flags().IntP("jobs", "j", 0,
+		"number of threads to run. CPU's threads number is the default.")
+}
+
+func groupHomotypic(gnp gnparser.GNparser, r io.Reader, all bool) {
+	names := scanNames(r)
+	f := outputFormat(gnp)
+	header := parsed.HeaderHomotypyCSV(f)
+	if header != "" {
+		fmt.Println(header)
+	}
+	for _, v := range parsed.GroupHomotypic(gnp.ParseNames(names), all) {
+		fmt.Println(v.Output(f))
+	}
+}
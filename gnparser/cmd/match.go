@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/fuzzy"
+	"github.com/spf13/cobra"
+)
+
+// matchCmd reconciles names from a file with a reference list of names.
+var matchCmd = &cobra.Command{
+	Use:   "match --reference ref.txt input.txt",
+	Short: "Matches names to a reference list of names.",
+	Long: `
+Matches names to a reference list of names offline. Both files contain one
+name per line. Names are compared by their canonical forms, genus and
+epithets can differ by a few edits each. Candidates are ranked by their
+similarity score and compatibility of authorships.
+
+To match names from a file:
+gnparser match --reference ref.txt input.txt
+
+To match names from STDIN and return 3 best candidates with details:
+cat input.txt | gnparser match -r ref.txt -l 3 -f pretty
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		formatFlag(cmd)
+		jobsNumFlag(cmd)
+		ignoreHTMLTagsFlag(cmd)
+		ref, _ := cmd.Flags().GetString("reference")
+		limit, _ := cmd.Flags().GetInt("limit")
+		maxDist, _ := cmd.Flags().GetInt("max-distance")
+		if ref == "" {
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+		// words and authorship details improve ranking of candidates.
+		opts = append(opts, gnparser.OptWithDetails(true))
+		gnp := gnparser.New(gnparser.NewConfig(opts...))
+
+		refs := readNames(ref)
+		idx := fuzzy.New(gnp.ParseNames(refs), maxDist)
+		log.Printf("Indexed %d reference names", idx.Len())
+
+		var names []string
+		switch len(args) {
+		case 0:
+			if !checkStdin() {
+				_ = cmd.Help()
+				os.Exit(1)
+			}
+			names = scanNames(os.Stdin)
+		case 1:
+			names = readNames(args[0])
+		default:
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+		matchNames(gnp, idx, names, limit)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(matchCmd)
+
+	matchCmd.Flags().StringP("reference", "r", "",
+		"file with reference names, one name per line")
+	matchCmd.Flags().IntP("limit", "l", 1,
+		"maximum number of candidates per name, 0 means all candidates")
+	matchCmd.Flags().IntP("max-distance", "m", 2,
+		"maximum edit distance for genus or an epithet")
+	matchCmd.Flags().StringP("format", "f", "",
+		"sets output format. Can be one of:\n  'csv', 'tsv', 'compact', 'pretty'")
+	matchCmd.Flags().BoolP("ignore_tags", "i", false,
+		"ignore HTML entities and tags when parsing.")
+	matchCmd.Flags().IntP("jobs", "j", 0,
+		"number of threads to run. CPU's threads number is the default.")
+}
+
+func matchNames(
+	gnp gnparser.GNparser,
+	idx *fuzzy.Index,
+	names []string,
+	limit int,
+) {
+	f := outputFormat(gnp)
+	header := fuzzy.HeaderCSV(f)
+	if header != "" {
+		fmt.Println(header)
+	}
+	for _, v := range gnp.ParseNames(names) {
+		fmt.Println(idx.Match(v, limit).Output(f))
+	}
+}
+
+func readNames(path string) []string {
+	f, err := os.OpenFile(path, os.O_RDONLY, os.ModePerm)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	return scanNames(f)
+}
+
+func scanNames(r io.Reader) []string {
+	var res []string
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		res = append(res, sc.Text())
+	}
+	if err := sc.Err(); err != nil {
+		log.Panic(err)
+	}
+	return res
+}
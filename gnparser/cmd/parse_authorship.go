@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnsys"
+	"github.com/spf13/cobra"
+)
+
+// parseAuthorshipInput parses authorship strings from the standard input,
+// from a file (one authorship per line), or a single authorship string.
+func parseAuthorshipInput(
+	cmd *cobra.Command,
+	args []string,
+	cfg gnparser.Config,
+) {
+	gnp := gnparser.New(cfg)
+	if len(args) == 0 {
+		if !checkStdin() {
+			_ = cmd.Help()
+			return
+		}
+		parseAuthorships(gnp, os.Stdin)
+		return
+	}
+
+	data := getInput(cmd, args)
+	exists, _ := gnsys.FileExists(data)
+	if !exists {
+		printAuthorshipHeader(gnp)
+		fmt.Println(gnp.ParseAuthorship(data).Output(outputFormat(gnp)))
+		return
+	}
+
+	f, err := os.OpenFile(data, os.O_RDONLY, os.ModePerm)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	parseAuthorships(gnp, f)
+}
+
+func parseAuthorships(gnp gnparser.GNparser, f io.Reader) {
+	printAuthorshipHeader(gnp)
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		fmt.Println(gnp.ParseAuthorship(sc.Text()).Output(outputFormat(gnp)))
+	}
+	if err := sc.Err(); err != nil {
+		log.Panic(err)
+	}
+}
+
+func printAuthorshipHeader(gnp gnparser.GNparser) {
+	header := parsed.HeaderAuthorshipCSV(outputFormat(gnp))
+	if header != "" {
+		fmt.Println(header)
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnparser"
 	"github.com/gnames/gnparser/ent/parsed"
 )
@@ -24,7 +23,7 @@ func parseBatch(
 	var wg sync.WaitGroup
 
 	wg.Add(1)
-	go processResults(chOut, &wg, gnp.Format())
+	go processResults(gnp, chOut, &wg)
 
 	sc := bufio.NewScanner(f)
 	var i, count int
@@ -50,20 +49,20 @@ func parseBatch(
 }
 
 func processResults(
+	gnp gnparser.GNparser,
 	out <-chan []parsed.Parsed,
 	wg *sync.WaitGroup,
-	f gnfmt.Format,
 ) {
 	defer wg.Done()
 
-	header := parsed.HeaderCSV(f)
+	header := gnp.HeaderCSV()
 	if header != "" {
 		fmt.Println(header)
 	}
 
 	for pr := range out {
 		for i := range pr {
-			fmt.Println(pr[i].Output(f))
+			fmt.Println(gnp.Output(pr[i]))
 		}
 	}
 }
@@ -60,7 +60,7 @@ func parseStream(
 		defer wg.Done()
 		start := time.Now()
 
-		header := parsed.HeaderCSV(gnp.Format())
+		header := gnp.HeaderCSV()
 		if header != "" {
 			fmt.Println(header)
 		}
@@ -78,7 +78,7 @@ func parseStream(
 				if !ok {
 					return
 				}
-				fmt.Println(v.Output(gnp.Format()))
+				fmt.Println(gnp.Output(v))
 			}
 		}
 	}()
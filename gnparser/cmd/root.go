@@ -9,7 +9,6 @@ import (
 
 	"github.com/dustin/go-humanize"
 	"github.com/gnames/gnparser"
-	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/gnames/gnparser/io/web"
 	"github.com/gnames/gnsys"
 	"github.com/spf13/cobra"
@@ -42,6 +41,22 @@ gnparser "Homo sapiens Linnaeus 1758" -f compact [flags]
 or
 gnparser "Homo sapiens Linnaeus 1758" -f pretty [flags]
 
+To parse one name into Darwin Core terms:
+gnparser "Homo sapiens Linnaeus 1758" -f dwc
+
+To parse names using a nomenclatural code hint:
+gnparser "Aus (Bus) cus" --code ICN
+
+To parse names together with references to their publications:
+gnparser "Pinus strobus L., Sp. Pl. 2: 1001. 1753" -P -f pretty
+
+To parse an authorship without a name:
+gnparser "(L.) Mill. 1768" -A -f pretty
+
+To parse names using custom dictionaries of bacterial genera, ICN authors
+and exceptions from a directory:
+gnparser names.txt --dict ~/my-dicts
+
 To parse with maximum amount of details:
 gnparser "Homo sapiens Linnaeus 1758" -d -f pretty
 
@@ -51,10 +66,16 @@ gnparser names.txt [flags] > parsed_names.txt
 To leave HTML tags and entities intact when parsing (faster)
 gnparser names.txt -n > parsed_names.txt
 
+To compare two names:
+gnparser compare "Aus bus var. cus L." "Aus bus f. cus Linnaeus"
+
 To start web service on port 8080 with 5 concurrent jobs:
 gnparser -j 5 -p 8080
  `,
 
+	// names are positional arguments, not subcommands.
+	Args: cobra.ArbitraryArgs,
+
 	Run: func(cmd *cobra.Command, args []string) {
 		if versionFlag(cmd) {
 			os.Exit(0)
@@ -73,19 +94,31 @@ gnparser -j 5 -p 8080
 		withCapitalizeFlag(cmd)
 		withEnableCultivarsFlag(cmd)
 		withPreserveDiaeresesFlag(cmd)
+		withPublishedInFlag(cmd)
+		withPhoneticFlag(cmd)
+		codeFlag(cmd)
+		dictFlag(cmd)
 		batchSizeFlag(cmd)
 		port := portFlag(cmd)
 		cfg := gnparser.NewConfig(opts...)
 		batchSize = cfg.BatchSize
 
 		if port != 0 {
-			cfg := gnparser.NewConfig(gnparser.OptFormat("compact"))
+			cfg := gnparser.NewConfig(
+				gnparser.OptFormat("compact"),
+				gnparser.OptDictionary(cfg.Dictionary),
+			)
 			gnp := gnparser.New(cfg)
 			gnps := web.NewGNparserService(gnp, port)
 			web.Run(gnps)
 			os.Exit(0)
 		}
 
+		if authorshipFlag(cmd) {
+			parseAuthorshipInput(cmd, args, cfg)
+			os.Exit(0)
+		}
+
 		quiet, _ := cmd.Flags().GetBool("quiet")
 
 		if len(args) == 0 {
@@ -117,13 +150,16 @@ func init() {
 	rootCmd.PersistentFlags().BoolP("version", "V", false,
 		"shows build version and date, ignores other flags.")
 
+	rootCmd.Flags().BoolP("authorship", "A", false,
+		"parse input as authorship strings without names")
+
 	rootCmd.Flags().IntP("batch_size", "b", 0,
 		"maximum number of names in a batch send for processing.")
 
 	rootCmd.Flags().BoolP("details", "d", false, "provides more details")
 
 	formatHelp := "sets output format. Can be one of:\n  " +
-		"'csv', 'compact', 'pretty'"
+		"'csv', 'tsv', 'compact', 'pretty', 'dwc'"
 	rootCmd.Flags().StringP("format", "f", "", formatHelp)
 
 	rootCmd.Flags().BoolP("ignore_tags", "i", false,
@@ -152,6 +188,21 @@ func init() {
 	rootCmd.Flags().BoolP("diaereses", "D", false,
 		"preserve diaereses in names")
 
+	rootCmd.Flags().BoolP("published-in", "P", false,
+		"parse a bibliographic reference that follows authorship")
+
+	rootCmd.Flags().Bool("phonetic", false,
+		"add phonetic keys of canonical forms")
+
+	rootCmd.Flags().String("code", "",
+		"nomenclatural code hint (ICZN, ICN, ICNP, ICVCN, ICNCP).")
+
+	rootCmd.Flags().String("dict", "",
+		"directory with custom dictionary files that extend embedded ones")
+
+	rootCmd.Flags().Bool("dict-replace", false,
+		"custom dictionary files replace embedded ones instead of extending")
+
 }
 
 func processStdin(cmd *cobra.Command, cfg gnparser.Config, quiet bool) {
@@ -226,14 +277,13 @@ func parse(
 
 func parseString(gnp gnparser.GNparser, name string) {
 	res := gnp.ParseName(name)
-	f := gnp.Format()
 
-	header := parsed.HeaderCSV(f)
+	header := gnp.HeaderCSV()
 	if header != "" {
 		fmt.Println(header)
 	}
 
-	fmt.Println(res.Output(f))
+	fmt.Println(gnp.Output(res))
 }
 
 func progressLog(start time.Time, namesNum int) {
@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/io/corpus"
+	"github.com/gnames/gnsys"
+	"github.com/spf13/cobra"
+)
+
+// verifyCmd checks a corpus of names with expected results.
+var verifyCmd = &cobra.Command{
+	Use:   "verify corpus.md",
+	Short: "Verifies expected parsing results from a corpus of names.",
+	Long: `
+Parses every name of a corpus and compares results with the expected ones.
+Failed examples are shown with unified diffs, the command exits with an
+error if at least one example failed.
+
+A markdown corpus follows the format of gnparser's test_data.md: every
+example starts with a "Name: name-string" line, followed by optional
+"Canonical: " and "Authorship: " lines and fenced json, csv or tsv blocks.
+A corpus with .jsonl or .json extension contains one expected compact JSON
+output per line.
+
+Parsing settings of the corpus are set by flags, for example expected
+results with details require --details flag.
+
+To verify a corpus:
+gnparser verify corpus.md -d
+
+To regenerate expected results of a corpus with the current gnparser:
+gnparser verify corpus.md -d --update
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+		withDetailsFlag(cmd)
+		withEnableCultivarsFlag(cmd)
+		withCapitalizeFlag(cmd)
+		withPreserveDiaeresesFlag(cmd)
+		withPublishedInFlag(cmd)
+		withPhoneticFlag(cmd)
+		ignoreHTMLTagsFlag(cmd)
+		codeFlag(cmd)
+		dictFlag(cmd)
+		update, _ := cmd.Flags().GetBool("update")
+		verbose, _ := cmd.Flags().GetBool("verbose")
+		gnp := gnparser.New(gnparser.NewConfig(opts...))
+
+		path := args[0]
+		c := readCorpus(path)
+		if update {
+			updateCorpus(gnp, c, path)
+			return
+		}
+
+		res := c.Verify(gnp)
+		for _, v := range res.Results {
+			if v.Passed {
+				if verbose {
+					fmt.Printf("PASS line %d: %s\n", v.Line, v.Name)
+				}
+				continue
+			}
+			fmt.Printf("FAIL line %d: %s\n%s\n", v.Line, v.Name, v.Diff)
+		}
+		fmt.Printf("Passed: %d, Failed: %d\n", res.Passed, res.Failed)
+		if res.Failed > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(verifyCmd)
+
+	verifyCmd.Flags().BoolP("update", "u", false,
+		"regenerate expected results of the corpus")
+	verifyCmd.Flags().BoolP("verbose", "v", false,
+		"show passed examples as well")
+	verifyCmd.Flags().BoolP("details", "d", false, "provides more details")
+	verifyCmd.Flags().BoolP("cultivar", "C", false,
+		"include cultivar epithets and graft-chimeras in normalized and canonical outputs")
+	verifyCmd.Flags().BoolP("capitalize", "c", false,
+		"capitalize the first letter of input name-strings")
+	verifyCmd.Flags().BoolP("diaereses", "D", false,
+		"preserve diaereses in names")
+	verifyCmd.Flags().BoolP("published-in", "P", false,
+		"parse a bibliographic reference that follows authorship")
+	verifyCmd.Flags().Bool("phonetic", false,
+		"add phonetic keys of canonical forms")
+	verifyCmd.Flags().BoolP("ignore_tags", "i", false,
+		"ignore HTML entities and tags when parsing.")
+	verifyCmd.Flags().String("code", "",
+		"nomenclatural code hint (ICZN, ICN, ICNP, ICVCN, ICNCP).")
+	verifyCmd.Flags().String("dict", "",
+		"directory with custom dictionary files that extend embedded ones")
+	verifyCmd.Flags().Bool("dict-replace", false,
+		"custom dictionary files replace embedded ones instead of extending")
+}
+
+func readCorpus(path string) *corpus.Corpus {
+	exists, _ := gnsys.FileExists(path)
+	if !exists {
+		log.Fatalf("Cannot find file '%s'", path)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	ext := filepath.Ext(path)
+	c, err := corpus.Read(f, ext == ".jsonl" || ext == ".json")
+	if err != nil {
+		log.Fatalf("Cannot read corpus '%s': %s", path, err)
+	}
+	return c
+}
+
+func updateCorpus(gnp gnparser.GNparser, c *corpus.Corpus, path string) {
+	var buf bytes.Buffer
+	err := c.Regenerate(gnp, &buf)
+	if err == nil {
+		err = os.WriteFile(path, buf.Bytes(), 0644)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Updated %d examples in '%s'\n", len(c.Examples), path)
+}
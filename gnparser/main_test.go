@@ -1,6 +1,8 @@
 package main
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -70,3 +72,123 @@ func TestStdin(t *testing.T) {
 		assert.Contains(t, c.Stdout(), ",Bubo,")
 	})
 }
+
+func TestCompare(t *testing.T) {
+	t.Run("compares two names", func(t *testing.T) {
+		c := testcli.Command("gnparser", "compare", "Aus bus L.", "Aus bus Linnaeus")
+		c.Run()
+		assert.True(t, c.Success())
+		assert.Contains(t, c.Stdout(), "FullCanonical,compatible")
+	})
+
+	t.Run("compares pairs from Stdin", func(t *testing.T) {
+		c := testcli.Command("gnparser", "compare", "-f", "compact")
+		c.SetStdin(strings.NewReader("Aus bus\tAus bus\nAus alba\tAus albus\n"))
+		c.Run()
+		assert.True(t, c.Success())
+		assert.Contains(t, c.Stdout(), `"level":"IdenticalVerbatim"`)
+		assert.Contains(t, c.Stdout(), `"level":"StemmedCanonical"`)
+	})
+}
+
+func TestHomotypy(t *testing.T) {
+	c := testcli.Command("gnparser", "homotypy")
+	c.SetStdin(strings.NewReader("Aus bus (L.) Smith\nAus cus\nCus bus L.\n"))
+	c.Run()
+	assert.True(t, c.Success())
+	assert.Contains(t, c.Stdout(), "bus|l|,Aus bus (L.) Smith\nbus|l|,Cus bus L.")
+	assert.NotContains(t, c.Stdout(), "Aus cus")
+}
+
+func TestCluster(t *testing.T) {
+	c := testcli.Command("gnparser", "cluster", "-q")
+	c.SetStdin(strings.NewReader("Aus bus L.\nAus bus Linn.\nAus bus L.\nBus cus\n"))
+	c.Run()
+	assert.True(t, c.Success())
+	assert.Contains(t, c.Stdout(), "Aus bus,3,2,Aus bus L.,3,0,0,0")
+	assert.Contains(t, c.Stdout(), "Bus cus,1,1,Bus cus,1,0,0,0")
+}
+
+func TestDiff(t *testing.T) {
+	dir := t.TempDir()
+	oldPath := filepath.Join(dir, "old.jsonl")
+	newPath := filepath.Join(dir, "new.jsonl")
+	for _, v := range []struct {
+		path, names string
+	}{
+		{oldPath, "Aus bus L.\nBus cus\n"},
+		{newPath, "Bus cus\nCus dus\n"},
+	} {
+		c := testcli.Command("gnparser", "-f", "compact")
+		c.SetStdin(strings.NewReader(v.names))
+		c.Run()
+		assert.True(t, c.Success())
+		err := os.WriteFile(v.path, []byte(c.Stdout()), 0644)
+		assert.Nil(t, err)
+	}
+
+	c := testcli.Command("gnparser", "diff", oldPath, newPath)
+	c.Run()
+	assert.True(t, c.Success())
+	assert.Contains(t, c.Stdout(), ",Cus dus,Added,,Cus dus,,1")
+	assert.Contains(t, c.Stdout(), ",Aus bus L.,Removed,Aus bus,,1,")
+	assert.NotContains(t, c.Stdout(), "Bus cus")
+	assert.Contains(t, c.Stderr(), "Unchanged,1")
+
+	c = testcli.Command("gnparser", "diff", oldPath, newPath, "-s",
+		"-f", "compact")
+	c.Run()
+	assert.True(t, c.Success())
+	assert.Contains(t, c.Stdout(), `"total":3,"unchanged":1`)
+}
+
+func TestVerify(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "corpus.md")
+	corpus := "Name: Aus bus L.\n\nCanonical: Aus cus\n\n```json\n```\n"
+	err := os.WriteFile(path, []byte(corpus), 0644)
+	assert.Nil(t, err)
+
+	c := testcli.Command("gnparser", "verify", path)
+	c.Run()
+	assert.False(t, c.Success())
+	assert.Contains(t, c.Stdout(), "FAIL line 1: Aus bus L.")
+	assert.Contains(t, c.Stdout(), "-Aus cus\n+Aus bus")
+	assert.Contains(t, c.Stdout(), "Passed: 0, Failed: 1")
+
+	c = testcli.Command("gnparser", "verify", path, "--update")
+	c.Run()
+	assert.True(t, c.Success())
+	assert.Contains(t, c.Stdout(), "Updated 1 examples")
+
+	c = testcli.Command("gnparser", "verify", path, "-v")
+	c.Run()
+	assert.True(t, c.Success())
+	assert.Contains(t, c.Stdout(), "PASS line 1: Aus bus L.\nPassed: 1, Failed: 0")
+}
+
+func TestDict(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "virus_exceptions.txt")
+	err := os.WriteFile(path, []byte("Homo\tvirus\n"), 0644)
+	assert.Nil(t, err)
+
+	c := testcli.Command("gnparser", "Homo virus", "--dict", dir)
+	c.Run()
+	assert.True(t, c.Success())
+	assert.Contains(t, c.Stdout(), ",Homo virus,2,Homo uir,")
+
+	c = testcli.Command("gnparser", "Homo virus", "--dict", path)
+	c.Run()
+	assert.False(t, c.Success())
+	assert.Contains(t, c.Stdout(), "is not a directory")
+}
+
+func TestMatch(t *testing.T) {
+	c := testcli.Command("gnparser", "match", "-r", "../testdata/match_ref.txt")
+	c.SetStdin(strings.NewReader("Pseudophylum albus\nZzz\n"))
+	c.Run()
+	assert.True(t, c.Success())
+	assert.Contains(t, c.Stdout(),
+		"Pseudophylum albus,Pseudophylum albus,Pseudophyllum alba L.,")
+	assert.Contains(t, c.Stdout(), "Zzz,Zzz,,,,,")
+}
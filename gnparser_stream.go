@@ -50,7 +50,7 @@ func (gnp gnparser) parseStreamWorker(
 	wg *sync.WaitGroup,
 ) {
 	defer wg.Done()
-	gnp.parser = parser.New()
+	gnp.parser = parser.New(gnp.cfg.Dictionary)
 	for v := range chIn {
 		parseRes := gnp.ParseName(v.NameString)
 		select {
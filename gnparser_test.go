@@ -6,10 +6,13 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnparser"
 	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/gnames/gnparser/io/dict"
 	"github.com/gnames/gnsys"
 	"github.com/stretchr/testify/assert"
 )
@@ -50,6 +53,39 @@ func TestParseNameCultivars(t *testing.T) {
 	}
 }
 
+func TestParsedRoundTrip(t *testing.T) {
+	enc := gnfmt.GNjson{}
+	tests := []struct {
+		file string
+		opts []gnparser.Option
+	}{
+		{"test_data.md", nil},
+		{"test_data_cultivars.md", []gnparser.Option{gnparser.OptWithCultivars(true)}},
+	}
+	for _, v := range tests {
+		opts := append([]gnparser.Option{
+			gnparser.OptWithDetails(true),
+			gnparser.OptIsTest(true),
+		}, v.opts...)
+		gnp := gnparser.New(gnparser.NewConfig(opts...))
+		data := getTestData(t, v.file)
+		for _, d := range data {
+			p := gnp.ParseName(d.name)
+			bs1, err := enc.Encode(p)
+			assert.Nil(t, err, d.name)
+
+			var p2 parsed.Parsed
+			err = enc.Decode(bs1, &p2)
+			assert.Nil(t, err, d.name)
+			assert.Equal(t, p.Details, p2.Details, d.name)
+
+			bs2, err := enc.Encode(p2)
+			assert.Nil(t, err, d.name)
+			assert.Equal(t, string(bs1), string(bs2), d.name)
+		}
+	}
+}
+
 func TestParseLowCaseName(t *testing.T) {
 	tests := []struct {
 		msg, in, out string
@@ -96,6 +132,845 @@ func TestParsePreserveDiaereses(t *testing.T) {
 	}
 }
 
+func TestParseDwC(t *testing.T) {
+	tests := []struct {
+		msg, in string
+		dwc     parsed.DwCRecord
+	}{
+		{"binomial", "Homo sapiens Linnaeus, 1758",
+			parsed.DwCRecord{
+				Genus:                    "Homo",
+				SpecificEpithet:          "sapiens",
+				TaxonRank:                "species",
+				ScientificNameAuthorship: "Linnaeus 1758",
+				NamePublishedInYear:      "1758",
+			}},
+		{"infrasp", "Agalinis purpurea (L.) Briton var. borealis (Berg.) Peterson 1987",
+			parsed.DwCRecord{
+				Genus:                    "Agalinis",
+				SpecificEpithet:          "purpurea",
+				InfraspecificEpithet:     "borealis",
+				TaxonRank:                "variety",
+				VerbatimTaxonRank:        "var.",
+				ScientificNameAuthorship: "(Berg.) Peterson 1987",
+				NamePublishedInYear:      "1987",
+			}},
+		{"trinomial", "Aus (Bus) cus dus Smith",
+			parsed.DwCRecord{
+				Genus:                    "Aus",
+				Subgenus:                 "Bus",
+				SpecificEpithet:          "cus",
+				InfraspecificEpithet:     "dus",
+				TaxonRank:                "subspecies",
+				ScientificNameAuthorship: "Smith",
+			}},
+		{"subgenus", "Pereskia subg. Maihuenia Philippi ex F.A.C.Weber, 1898",
+			parsed.DwCRecord{
+				Genus:                    "Pereskia",
+				Subgenus:                 "Maihuenia",
+				TaxonRank:                "subgenus",
+				VerbatimTaxonRank:        "subg.",
+				ScientificNameAuthorship: "Philippi ex F. A. C. Weber 1898",
+				NamePublishedInYear:      "1898",
+			}},
+		{"cultivar", "Sarracenia flava 'Maxima'",
+			parsed.DwCRecord{
+				Genus:           "Sarracenia",
+				SpecificEpithet: "flava",
+				TaxonRank:       "species",
+				CultivarEpithet: "Maxima",
+			}},
+		{"phrase name", "Pultenaea sp. Olinda (R.Coveny 6616)",
+			parsed.DwCRecord{
+				Genus:             "Pultenaea",
+				TaxonRank:         "species",
+				VerbatimTaxonRank: "sp.",
+			}},
+		{"qualified infrasp", "Homo sapiens cf. neanderthalensis",
+			parsed.DwCRecord{
+				Genus:                "Homo",
+				SpecificEpithet:      "sapiens",
+				InfraspecificEpithet: "neanderthalensis",
+			}},
+		{"infrasp phrase name", "Eucalyptus globulus ssp. Eastern (J.Smith s.n.)",
+			parsed.DwCRecord{
+				Genus:             "Eucalyptus",
+				SpecificEpithet:   "globulus",
+				TaxonRank:         "subspecies",
+				VerbatimTaxonRank: "ssp.",
+			}},
+		{"notho rank", "Mentha aquatica nvar. citrata",
+			parsed.DwCRecord{
+				Genus:                "Mentha",
+				SpecificEpithet:      "aquatica",
+				InfraspecificEpithet: "citrata",
+				TaxonRank:            "nothovariety",
+				VerbatimTaxonRank:    "nvar.",
+			}},
+	}
+	cfg := gnparser.NewConfig(gnparser.OptFormat("dwc"))
+	gnp := gnparser.New(cfg)
+	assert.Equal(t, gnp.Format(), gnfmt.CSV)
+	assert.True(t, gnp.WithDwC())
+	for _, v := range tests {
+		res := gnp.ParseName(v.in)
+		assert.Equal(t, res.ToDwC(), v.dwc, v.msg)
+	}
+
+	res := gnp.ParseName("Homo sapiens Linnaeus, 1758")
+	assert.Equal(t,
+		"Id,Verbatim,scientificName,genus,subgenus,specificEpithet,"+
+			"infraspecificEpithet,taxonRank,verbatimTaxonRank,"+
+			"scientificNameAuthorship,namePublishedInYear,cultivarEpithet",
+		gnp.HeaderCSV(),
+	)
+	assert.Equal(t,
+		`7db4f8a2-aafe-56b6-8838-89522c67d9f0,"Homo sapiens Linnaeus, 1758",`+
+			"Homo sapiens Linnaeus 1758,Homo,,sapiens,,species,,Linnaeus 1758,1758,",
+		gnp.Output(res),
+	)
+
+	gnp = gnp.ChangeConfig(gnparser.OptFormat("tsv"), gnparser.OptWithDwC(true))
+	assert.Equal(t,
+		"7db4f8a2-aafe-56b6-8838-89522c67d9f0\tHomo sapiens Linnaeus, 1758\t"+
+			"Homo sapiens Linnaeus 1758\tHomo\t\tsapiens\t\tspecies\t\t"+
+			"Linnaeus 1758\t1758\t",
+		gnp.Output(res),
+	)
+
+	gnp = gnp.ChangeConfig(gnparser.OptFormat("csv"))
+	assert.False(t, gnp.WithDwC())
+	assert.Equal(t, parsed.HeaderCSV(gnfmt.CSV), gnp.HeaderCSV())
+}
+
+func TestParsePhonetic(t *testing.T) {
+	tests := []struct {
+		msg, in, phonetic string
+	}{
+		{"binomial", "Pseudofillum aus", "Sidafilim ais"},
+		{"misspelling", "Pseudophyllum aus", "Sidafilim ais"},
+		{"virus binomial", "Betacoronavirus pandemicum", ""},
+		{"virus", "Tobacco mosaic virus", ""},
+	}
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptWithPhonetic(true)))
+	for _, v := range tests {
+		res := gnp.ParseName(v.in)
+		assert.Equal(t, v.phonetic, res.Canonical.Phonetic, v.msg)
+	}
+
+	gnp = gnparser.New(gnparser.NewConfig())
+	res := gnp.ParseName("Pseudofillum aus")
+	assert.Equal(t, "", res.Canonical.Phonetic)
+}
+
+func TestParseCode(t *testing.T) {
+	tests := []struct {
+		msg, in string
+		code    parsed.Code
+	}{
+		{"zoo year", "Homo sapiens Linnaeus, 1758", parsed.ICZN},
+		{"zoo year parens", "Aus bus (Zyxwer 1880)", parsed.ICZN},
+		{"year no comma", "Aus bus Zyxwer 1880", parsed.UnknownCode},
+		{"bot author year", "Stagonospora polyspora M.T. Lucas & Sousa da Câmara 1934",
+			parsed.ICN},
+		{"bot authors year",
+			"Cladoniicola staurospora Diederich, van den Boom & Aptroot 2001",
+			parsed.ICN},
+		{"zoo subgenus", "Aus (Bus) cus", parsed.ICZN},
+		{"zoo trinomial", "Aus bus cus", parsed.ICZN},
+		{"bot combination", "Aus bus (L.) Smith", parsed.ICN},
+		{"bot rank", "Aus bus var. cus", parsed.ICN},
+		{"bot ex", "Aus bus Smith ex Jones", parsed.ICN},
+		{"bact", "Escherichia coli", parsed.ICNP},
+		{"candidatus", "Candidatus Phytoplasma allocasuarinae", parsed.ICNP},
+		{"virus", "Cytospora ribis mitovirus 2", parsed.ICVCN},
+		{"unknown", "Bubo bubo", parsed.UnknownCode},
+		{"unparsed", "Not a name", parsed.UnknownCode},
+	}
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptWithCultivars(true)))
+	for _, v := range tests {
+		res := gnp.ParseName(v.in)
+		assert.Equal(t, v.code, res.Code, v.msg)
+	}
+
+	res := gnp.ParseName("Sarracenia flava 'Maxima'")
+	assert.Equal(t, parsed.ICNCP, res.Code)
+
+	// code hint overrides inference and steers parsing
+	res = gnp.ParseName("Aus (Bus) cus")
+	assert.Equal(t, "Aus (Bus) cus", res.Normalized)
+	gnp = gnparser.New(gnparser.NewConfig(gnparser.OptCode("bot")))
+	res = gnp.ParseName("Aus (Bus) cus")
+	assert.Equal(t, parsed.ICN, res.Code)
+	assert.Equal(t, "Aus cus", res.Normalized)
+
+	res = gnp.ParseName("Aus bus Smith ex Jones")
+	assert.Equal(t, 1, res.ParseQuality)
+
+	gnp = gnparser.New(gnparser.NewConfig(gnparser.OptCode("ICZN")))
+	res = gnp.ParseName("Betula Rupr.")
+	assert.Equal(t, parsed.ICZN, res.Code)
+	assert.Equal(t, 1, res.Cardinality)
+
+	// code hint overrides virus detection as well
+	res = gnp.ParseName("Cytospora ribis mitovirus 2")
+	assert.Equal(t, parsed.ICZN, res.Code)
+}
+
+func TestParseVirus(t *testing.T) {
+	tests := []struct {
+		msg, in string
+		card    int
+		stemmed string
+		virus   parsed.Virus
+	}{
+		{"ictv binomial", "Betacoronavirus pandemicum", 2,
+			"Betacoronavirus pandemic",
+			parsed.Virus{
+				Category: parsed.VirusCat,
+				Name:     "Betacoronavirus pandemicum",
+				Genus:    "Betacoronavirus",
+				Species:  "pandemicum",
+			}},
+		{"ncbi strain", "Influenza A virus (A/Puerto Rico/8/1934(H1N1))", 0,
+			"Influenza A virus",
+			parsed.Virus{
+				Category: parsed.VirusCat,
+				Name:     "Influenza A virus",
+				Strain:   "A/Puerto Rico/8/1934(H1N1)",
+				Serotype: "H1N1",
+			}},
+		{"slash strain", "Australian bat lyssavirus human/AUS/1998", 0,
+			"Australian bat lyssavirus",
+			parsed.Virus{
+				Category: parsed.VirusCat,
+				Name:     "Australian bat lyssavirus",
+				Strain:   "human/AUS/1998",
+			}},
+		{"markers", "Escherichia phage T4 strain K12 isolate X3", 0,
+			"Escherichia phage T4",
+			parsed.Virus{
+				Category: parsed.PhageCat,
+				Name:     "Escherichia phage T4",
+				Strain:   "K12",
+				Isolate:  "X3",
+			}},
+		{"serotype", "Dengue virus serotype 2", 0, "Dengue virus",
+			parsed.Virus{
+				Category: parsed.VirusCat,
+				Name:     "Dengue virus",
+				Serotype: "2",
+			}},
+		{"strain in parens", "Rachiplusia ou MNPV (strain R1)", 0,
+			"Rachiplusia ou MNPV",
+			parsed.Virus{
+				Category: parsed.VirusCat,
+				Name:     "Rachiplusia ou MNPV",
+				Strain:   "R1",
+			}},
+		{"satellite", "Tobacco necrosis satellite virus", 0,
+			"Tobacco necrosis satellite virus",
+			parsed.Virus{
+				Category: parsed.SatelliteCat,
+				Name:     "Tobacco necrosis satellite virus",
+			}},
+		{"plasmid", "Escherichia coli plasmid pUC19", 0,
+			"Escherichia coli plasmid pUC19",
+			parsed.Virus{
+				Category: parsed.PlasmidCat,
+				Name:     "Escherichia coli plasmid pUC19",
+			}},
+		{"prion", "Fungal prions", 0, "Fungal prions",
+			parsed.Virus{Category: parsed.PrionCat, Name: "Fungal prions"}},
+		{"viroid", "Apple scar skin viroid", 0, "Apple scar skin viroid",
+			parsed.Virus{Category: parsed.ViroidCat, Name: "Apple scar skin viroid"}},
+	}
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptWithDetails(true)))
+	for _, v := range tests {
+		res := gnp.ParseName(v.in)
+		assert.True(t, res.Parsed, v.msg)
+		assert.True(t, res.Virus, v.msg)
+		assert.Equal(t, parsed.ICVCN, res.Code, v.msg)
+		assert.Equal(t, v.card, res.Cardinality, v.msg)
+		assert.Equal(t, v.virus.Name, res.Canonical.Simple, v.msg)
+		assert.Equal(t, v.stemmed, res.Canonical.Stemmed, v.msg)
+		assert.Equal(t, parsed.DetailsVirus{Virus: v.virus}, res.Details, v.msg)
+		if v.virus.Strain == "" {
+			continue
+		}
+		var strains []string
+		for _, w := range res.Words {
+			if w.Type == parsed.StrainType {
+				strains = append(strains, w.Verbatim)
+			}
+		}
+		assert.Contains(t, strains, v.virus.Strain, v.msg)
+	}
+
+	res := gnp.ParseName("Acute bee paralysis virus [AF150629] Acute bee paralysis virus")
+	assert.Equal(t, "Acute bee paralysis virus", res.Canonical.Simple)
+	assert.Equal(t, " [AF150629] Acute bee paralysis virus", res.Tail)
+	assert.Equal(t, 4, res.ParseQuality)
+
+	// empty designations are ignored
+	res = gnp.ParseName("Dengue virus 1 isolate ( )")
+	assert.Equal(t, parsed.DetailsVirus{Virus: parsed.Virus{
+		Category: parsed.VirusCat,
+		Name:     "Dengue virus 1",
+	}}, res.Details)
+	for _, w := range res.Words {
+		assert.NotEqual(t, parsed.StrainType, w.Type)
+	}
+	assert.Equal(t, 1, res.ParseQuality)
+
+	res = gnp.ParseName("Aus virus (")
+	assert.Equal(t, "Aus virus", res.Canonical.Simple)
+	assert.Equal(t, 3, res.ParseQuality)
+	assert.Equal(t, parsed.VirusParensUnbalancedWarn, res.QualityWarnings[0].Warning)
+}
+
+func TestParseStrains(t *testing.T) {
+	tests := []struct {
+		msg, in, norm string
+		strains       []parsed.Strain
+	}{
+		{"strain", "Escherichia coli strain K-12", "Escherichia coli",
+			[]parsed.Strain{{Verbatim: "strain K-12", Number: "K-12"}}},
+		{"collection", "Escherichia coli ATCC 25922", "Escherichia coli",
+			[]parsed.Strain{
+				{Verbatim: "ATCC 25922", Collection: "ATCC", Number: "25922"},
+			}},
+		{"type", "Lactobacillus delbrueckii DSM 20231T",
+			"Lactobacillus delbrueckii",
+			[]parsed.Strain{
+				{Verbatim: "DSM 20231T", Collection: "DSM", Number: "20231",
+					IsType: true},
+			}},
+		{"several", "Escherichia coli (ATCC 11775T = DSM 30083T)",
+			"Escherichia coli",
+			[]parsed.Strain{
+				{Verbatim: "ATCC 11775T", Collection: "ATCC", Number: "11775",
+					IsType: true},
+				{Verbatim: "DSM 30083T", Collection: "DSM", Number: "30083",
+					IsType: true},
+			}},
+		{"substrain", "Escherichia coli str. K-12 substr. MG1655",
+			"Escherichia coli",
+			[]parsed.Strain{
+				{Verbatim: "str. K-12", Number: "K-12"},
+				{Verbatim: "substr. MG1655", Number: "MG1655"},
+			}},
+		{"authors", "Streptomyces griseus (Krainsky 1914) Waksman and Henrici 1948 ATCC 23345T",
+			"Streptomyces griseus (Krainsky 1914) Waksman & Henrici 1948",
+			[]parsed.Strain{
+				{Verbatim: "ATCC 23345T", Collection: "ATCC", Number: "23345",
+					IsType: true},
+			}},
+		{"type strain", "Escherichia coli type strain ATCC 11775",
+			"Escherichia coli",
+			[]parsed.Strain{
+				{Verbatim: "type strain ATCC 11775", Collection: "ATCC",
+					Number: "11775", IsType: true},
+			}},
+	}
+	gnp := gnparser.New(gnparser.NewConfig())
+	for _, v := range tests {
+		res := gnp.ParseName(v.in)
+		assert.Equal(t, 1, res.ParseQuality, v.msg)
+		assert.Equal(t, "", res.Tail, v.msg)
+		assert.Equal(t, v.norm, res.Normalized, v.msg)
+		assert.Equal(t, v.strains, res.Strains, v.msg)
+	}
+
+	// strains are not detected for non-bacterial names
+	res := gnp.ParseName("Homo sapiens ATCC 123")
+	assert.Nil(t, res.Strains)
+	assert.Equal(t, 4, res.ParseQuality)
+}
+
+func TestParseNomStatus(t *testing.T) {
+	tests := []struct {
+		msg, in, norm string
+		status        *parsed.NomenclaturalStatus
+	}{
+		{"no status", "Aus bus Smith", "Aus bus Smith", nil},
+		{"nom. illeg.", "Abutilon avicennae Gaertn., nom. illeg.",
+			"Abutilon avicennae Gaertn.",
+			&parsed.NomenclaturalStatus{
+				Verbatim: "nom. illeg.", Normalized: "nom. illeg.",
+			}},
+		{"nomen nudum", "Akeratidae Nomen Nudum", "Akeratidae",
+			&parsed.NomenclaturalStatus{
+				Verbatim: "Nomen Nudum", Normalized: "nom. nud.",
+			}},
+		{"comb. nov.", "Arthopyrenia hyalospora (Nyl.) R.C. Harris comb. nov.",
+			"Arthopyrenia hyalospora (Nyl.) R. C. Harris",
+			&parsed.NomenclaturalStatus{
+				Verbatim: "comb. nov.", Normalized: "comb. nov.",
+			}},
+		{"nov spec", "Eunotia genuflexa Norpel-Schempp nov spec",
+			"Eunotia genuflexa Norpel-Schempp",
+			&parsed.NomenclaturalStatus{
+				Verbatim: "nov spec", Normalized: "sp. nov.",
+			}},
+	}
+	gnp := gnparser.New(gnparser.NewConfig())
+	for _, v := range tests {
+		res := gnp.ParseName(v.in)
+		assert.Equal(t, 1, res.ParseQuality, v.msg)
+		assert.Equal(t, v.norm, res.Normalized, v.msg)
+		assert.Equal(t, v.status, res.NomenclaturalStatus, v.msg)
+	}
+}
+
+func TestParseTaxonConcept(t *testing.T) {
+	tests := []struct {
+		msg, in, norm string
+		concept       *parsed.TaxonConcept
+	}{
+		{"no concept", "Aus bus Smith", "Aus bus Smith", nil},
+		{"sensu lato", "Arenaria serpyllifolia L. s.lat.",
+			"Arenaria serpyllifolia L.",
+			&parsed.TaxonConcept{
+				Verbatim: "s.lat.", Qualifier: parsed.SensuLatoConcept,
+			}},
+		{"sensu stricto", "Aus bus sensu stricto", "Aus bus",
+			&parsed.TaxonConcept{
+				Verbatim: "sensu stricto", Qualifier: parsed.SensuStrictoConcept,
+			}},
+		{"sensu", "Velutina haliotoides (Linnaeus, 1758), sensu Fabricius, 1780",
+			"Velutina haliotoides (Linnaeus 1758)",
+			&parsed.TaxonConcept{
+				Verbatim:  "sensu Fabricius, 1780",
+				Qualifier: parsed.SensuConcept,
+				Authors:   []string{"Fabricius"},
+				Year:      "1780",
+			}},
+		{"misapplied", "Senecio legionensis sensu Samp., non Lange",
+			"Senecio legionensis",
+			&parsed.TaxonConcept{
+				Verbatim:   "sensu Samp., non Lange",
+				Qualifier:  parsed.SensuConcept,
+				Authors:    []string{"Samp."},
+				NonAuthors: []string{"Lange"},
+				Misapplied: true,
+			}},
+		{"auct.", "Puya acris auct. non L.", "Puya acris",
+			&parsed.TaxonConcept{
+				Verbatim:   "auct. non L.",
+				Qualifier:  parsed.AuctorumConcept,
+				NonAuthors: []string{"L."},
+				Misapplied: true,
+			}},
+		{"sec.", "Abramis Cuvier 1816 sec. Dybowski 1862", "Abramis Cuvier 1816",
+			&parsed.TaxonConcept{
+				Verbatim:  "sec. Dybowski 1862",
+				Qualifier: parsed.SecundumConcept,
+				Authors:   []string{"Dybowski"},
+				Year:      "1862",
+			}},
+		{"pro parte", "Galium tricorne Stokes, pro parte", "Galium tricorne Stokes",
+			&parsed.TaxonConcept{
+				Verbatim:  "pro parte",
+				Qualifier: parsed.ProParteConcept,
+				ProParte:  true,
+			}},
+		{"sensu pro parte", "Aus bus L. sensu Smith & Jones p.p.", "Aus bus L.",
+			&parsed.TaxonConcept{
+				Verbatim:  "sensu Smith & Jones p.p.",
+				Qualifier: parsed.SensuConcept,
+				Authors:   []string{"Smith", "Jones"},
+				ProParte:  true,
+			}},
+		{"s.l. before authors", "Acantholimon ulicinum s.l. (Schultes) Boiss.",
+			"Acantholimon ulicinum (Schultes) Boiss.",
+			&parsed.TaxonConcept{
+				Verbatim: "s.l.", Qualifier: parsed.SensuLatoConcept,
+			}},
+		{"after status", "Aus bus stat. nov. sensu Smith", "Aus bus",
+			&parsed.TaxonConcept{
+				Verbatim:  "sensu Smith",
+				Qualifier: parsed.SensuConcept,
+				Authors:   []string{"Smith"},
+			}},
+	}
+	gnp := gnparser.New(gnparser.NewConfig())
+	for _, v := range tests {
+		res := gnp.ParseName(v.in)
+		assert.Equal(t, 1, res.ParseQuality, v.msg)
+		assert.Equal(t, v.norm, res.Normalized, v.msg)
+		assert.Equal(t, v.concept, res.TaxonConcept, v.msg)
+	}
+}
+
+func TestParsePublishedIn(t *testing.T) {
+	tests := []struct {
+		msg, in, norm string
+		quality       int
+		pub           *parsed.PublishedIn
+		warns         []parsed.Warning
+	}{
+		{"no ref", "Aus bus Smith, 1990", "Aus bus Smith 1990", 1, nil, nil},
+		{"work at the end", "Aus bus Smith, Jones", "Aus bus Smith & Jones", 1,
+			nil, nil},
+		{"full", "Pinus strobus L., Sp. Pl. 2: 1001. 1753", "Pinus strobus L.",
+			1, &parsed.PublishedIn{
+				Verbatim: "Sp. Pl. 2: 1001. 1753",
+				Work:     "Sp. Pl.",
+				Volume:   "2",
+				Pages:    "1001",
+				Year:     "1753",
+			}, nil},
+		{"in", "Aus bus Smith in Fl. Bras. 5(1): 123 (1840)", "Aus bus Smith",
+			1, &parsed.PublishedIn{
+				Verbatim: "in Fl. Bras. 5(1): 123 (1840)",
+				Work:     "Fl. Bras.",
+				Volume:   "5(1)",
+				Pages:    "123",
+				Year:     "1840",
+			}, nil},
+		{"pages only", "Carex L., Sp. Pl. 972. 1753", "Carex L.",
+			1, &parsed.PublishedIn{
+				Verbatim: "Sp. Pl. 972. 1753",
+				Work:     "Sp. Pl.",
+				Pages:    "972",
+				Year:     "1753",
+			}, nil},
+		{"no volume", "Rosa canina L., Sp. Pl.: 491 (1753)", "Rosa canina L.",
+			1, &parsed.PublishedIn{
+				Verbatim: "Sp. Pl.: 491 (1753)",
+				Work:     "Sp. Pl.",
+				Pages:    "491",
+				Year:     "1753",
+			}, nil},
+		{"no year", "Aus bus Smith, Prodr. 1: 234", "Aus bus Smith", 2,
+			&parsed.PublishedIn{
+				Verbatim: "Prodr. 1: 234",
+				Work:     "Prodr.",
+				Volume:   "1",
+				Pages:    "234",
+			}, []parsed.Warning{parsed.PublishedInNoYearWarn}},
+		{"year mismatch", "Aus bus Smith 1880, Fl. Bras. 1881",
+			"Aus bus Smith 1880", 2,
+			&parsed.PublishedIn{
+				Verbatim: "Fl. Bras. 1881",
+				Work:     "Fl. Bras.",
+				Year:     "1881",
+			}, []parsed.Warning{
+				parsed.PublishedInNoPagesWarn,
+				parsed.PublishedInYearMismatchWarn,
+			}},
+	}
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptWithPublishedIn(true)))
+	for _, v := range tests {
+		res := gnp.ParseName(v.in)
+		assert.Equal(t, v.quality, res.ParseQuality, v.msg)
+		assert.Equal(t, v.norm, res.Normalized, v.msg)
+		assert.Equal(t, v.pub, res.PublishedIn, v.msg)
+		var warns []parsed.Warning
+		for _, w := range res.QualityWarnings {
+			warns = append(warns, w.Warning)
+		}
+		assert.ElementsMatch(t, v.warns, warns, v.msg)
+	}
+
+	gnp = gnparser.New(gnparser.NewConfig())
+	res := gnp.ParseName("Pinus strobus L., Sp. Pl. 2: 1001. 1753")
+	assert.Nil(t, res.PublishedIn)
+	assert.Equal(t, 4, res.ParseQuality)
+}
+
+func TestParseAuthorship(t *testing.T) {
+	tests := []struct {
+		msg, in, norm, year string
+		authors             []string
+	}{
+		{"empty", "", "", "", nil},
+		{"not authorship", "sp.", "", "", nil},
+		{"one author", "L.", "L.", "", []string{"L."}},
+		{"year", "Linnaeus, 1758", "Linnaeus 1758", "1758",
+			[]string{"Linnaeus"}},
+		{"combination", "(L.) Mill. 1768", "(L.) Mill. 1768", "",
+			[]string{"L.", "Mill."}},
+		{"prefix", "de Candolle", "de Candolle", "", []string{"de Candolle"}},
+		{"ex", "Hook.f. ex Benth.", "Hook. fil. ex Benth.", "",
+			[]string{"Hook. fil.", "Benth."}},
+		{"html", "<i>Smith</i> 1990", "Smith 1990", "1990", []string{"Smith"}},
+	}
+	gnp := gnparser.New(gnparser.NewConfig())
+	for _, v := range tests {
+		res := gnp.ParseAuthorship(v.in)
+		assert.Equal(t, v.in, res.Verbatim, v.msg)
+		assert.Equal(t, v.norm, res.Normalized, v.msg)
+		assert.Equal(t, v.year, res.Year, v.msg)
+		assert.Equal(t, v.authors, res.Authors, v.msg)
+	}
+
+	res := gnp.ParseAuthorship("(Smith, 1880) Jones & Brown, 1900")
+	assert.Equal(t, []string{"Smith"}, res.Original.Authors)
+	assert.Equal(t, "1880", res.Original.Year.Value)
+	assert.Equal(t, []string{"Jones", "Brown"}, res.Combination.Authors)
+	assert.Equal(t, "1900", res.Combination.Year.Value)
+	assert.Equal(t, 1, res.ParseQuality)
+	assert.Empty(t, res.QualityWarnings)
+	assert.Empty(t, res.Tail)
+
+	res = gnp.ParseAuthorship("Mill. 1768 [nom. illeg.] 234")
+	assert.Equal(t, "Mill. 1768", res.Normalized)
+	assert.Equal(t, " [nom. illeg.] 234", res.Tail)
+	assert.Equal(t, 4, res.ParseQuality)
+	assert.Equal(t, parsed.TailWarn, res.QualityWarnings[0].Warning)
+
+	res = gnp.ParseAuthorship("<i>Smith</i> 1990")
+	assert.Equal(t, 3, res.ParseQuality)
+	assert.Equal(t, parsed.HTMLTagsEntitiesWarn, res.QualityWarnings[0].Warning)
+
+	res = gnp.ParseAuthorship("sp.")
+	assert.Equal(t, 0, res.ParseQuality)
+}
+
+func TestParseAuthorPersons(t *testing.T) {
+	tests := []struct {
+		msg, in string
+		persons []parsed.AuthorPerson
+	}{
+		{"surname", "Aus bus Linnaeus", []parsed.AuthorPerson{
+			{Verbatim: "Linnaeus", Surname: "Linnaeus"}}},
+		{"abbr", "Aus bus L.", []parsed.AuthorPerson{
+			{Verbatim: "L.", Surname: "L."}}},
+		{"initials", "Aus bus A.H.Sm.", []parsed.AuthorPerson{
+			{Verbatim: "A.H.Sm.", Surname: "Sm.", Initials: "A. H."}}},
+		{"particle", "Aus bus R.C. van der Smith f.", []parsed.AuthorPerson{
+			{Verbatim: "R.C. van der Smith f.", Surname: "Smith",
+				Initials: "R. C.", Particle: "van der", Suffix: "fil."}}},
+		{"inner particle", "Aus bus A.F. Sousa da Câmara", []parsed.AuthorPerson{
+			{Verbatim: "A.F. Sousa da Câmara", Surname: "Sousa da Câmara",
+				Initials: "A. F."}}},
+		{"team", "Aus bus Hook.f. & DC. bis", []parsed.AuthorPerson{
+			{Verbatim: "Hook.f.", Surname: "Hook.", Suffix: "fil."},
+			{Verbatim: "DC. bis", Surname: "DC.", Suffix: "bis"}}},
+		{"et al", "Aus bus Smith et al.", []parsed.AuthorPerson{
+			{Verbatim: "Smith", Surname: "Smith"}}},
+	}
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptWithDetails(true)))
+	for _, v := range tests {
+		res := gnp.ParseName(v.in)
+		sp, ok := res.Details.(parsed.DetailsSpecies)
+		assert.True(t, ok, v.msg)
+		assert.Equal(t, v.persons, sp.Species.Authorship.Original.Persons, v.msg)
+	}
+
+	au := gnp.ParseAuthorship("(L.) de Candolle ex Mill.")
+	assert.Equal(t, "L.", au.Original.Persons[0].Surname)
+	assert.Equal(t, "de", au.Combination.Persons[0].Particle)
+	assert.Equal(t, "Mill.", au.Combination.ExAuthors.Persons[0].Surname)
+}
+
+func TestCustomDictionary(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"bacteria_genera.txt":      "Gnparseria\n",
+		"virus_exceptions.txt":     "Homo\tvirus\n",
+		"ambiguous_exceptions.txt": "Aus\tvon\n",
+	}
+	for k, v := range files {
+		err := os.WriteFile(filepath.Join(dir, k), []byte(v), 0644)
+		assert.Nil(t, err)
+	}
+	names := []string{"Gnparseria coli", "Homo virus", "Aus von Smith"}
+
+	gnp := gnparser.New(gnparser.NewConfig())
+	res := gnp.ParseNames(names)
+	assert.Nil(t, res[0].Bacteria)
+	assert.True(t, res[1].Virus)
+	assert.Equal(t, "Aus", res[2].Canonical.Simple)
+
+	d, err := dict.Load(dir, false)
+	assert.Nil(t, err)
+	custom := gnparser.New(gnparser.NewConfig(gnparser.OptDictionary(d)))
+	res = custom.ParseNames(names)
+	assert.Equal(t, "yes", res[0].Bacteria.String())
+	assert.False(t, res[1].Virus)
+	assert.Equal(t, "Homo virus", res[1].Canonical.Simple)
+	assert.Equal(t, "Aus von", res[2].Canonical.Simple)
+	assert.Equal(t, "Smith", res[2].Authorship.Normalized)
+
+	// the dictionary is scoped to the instance, and survives config changes.
+	assert.True(t, gnp.ParseName("Homo virus").Virus)
+	custom = custom.ChangeConfig(gnparser.OptWithDetails(true))
+	assert.False(t, custom.ParseName("Homo virus").Virus)
+
+	custom = gnparser.New(gnparser.NewConfig(
+		gnparser.OptDictionaryDir(dir, true),
+	))
+	assert.Nil(t, custom.ParseName("Escherichia coli").Bacteria)
+	assert.Equal(t, "yes", custom.ParseName("Gnparseria coli").Bacteria.String())
+}
+
+func TestChangeConfigConcurrency(t *testing.T) {
+	names := []string{
+		"Aus bus Linnaeus 1758", "Abies alba Mill.", "Homo sapiens L.",
+		"Pomatomus saltatrix (Linnaeus, 1766)",
+	}
+	gnp := gnparser.New(gnparser.NewConfig())
+	changed := gnp.ChangeConfig(gnparser.OptWithDetails(true))
+
+	// each instance is used by its own goroutine, they must not share
+	// the parsing engine.
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 20; i++ {
+			for _, v := range names {
+				res := gnp.ParseName(v)
+				assert.Nil(t, res.Details, v)
+				assert.Equal(t, 1, res.ParseQuality, v)
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 20; i++ {
+			for _, v := range names {
+				res := changed.ParseName(v)
+				assert.NotNil(t, res.Details, v)
+				assert.Equal(t, 1, res.ParseQuality, v)
+			}
+		}
+	}()
+	wg.Wait()
+}
+
+func TestHomotypyKey(t *testing.T) {
+	tests := []struct {
+		msg, in, key string
+	}{
+		{"basionym", "Cus bus L.", "bus|l|"},
+		{"combination", "Aus bus (L.) Smith", "bus|l|"},
+		{"gender", "Aus alba (Müll. Arg., 1880) Sm.", "alb|muellarg|1880"},
+		{"infrasp", "Aus bus var. alba (Müll.Arg. 1880) Sm.", "alb|muellarg|1880"},
+		{"team", "Aus bus Smith & Jones, 1850", "bus|smith,jones|1850"},
+		{"no authors", "Aus bus", ""},
+		{"uninomial", "Aus L.", ""},
+	}
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptWithDetails(true)))
+	for _, v := range tests {
+		assert.Equal(t, v.key, gnp.ParseName(v.in).HomotypyKey(), v.msg)
+	}
+
+	gnp = gnparser.New(gnparser.NewConfig())
+	assert.Equal(t, "", gnp.ParseName("Cus bus L.").HomotypyKey(), "no details")
+}
+
+func TestGroupHomotypic(t *testing.T) {
+	names := []string{"Aus bus (L.) Smith", "Aus cus", "Cus ba L.",
+		"Cus bus L.", "Dus ba L."}
+	gnp := gnparser.New(gnparser.NewConfig(gnparser.OptWithDetails(true)))
+	res := parsed.GroupHomotypic(gnp.ParseNames(names), false)
+	assert.Equal(t, []parsed.HomotypyGroup{
+		{Key: "bus|l|", Names: []string{"Aus bus (L.) Smith", "Cus bus L."}},
+		{Key: "ba|l|", Names: []string{"Cus ba L.", "Dus ba L."}},
+	}, res)
+
+	res = parsed.GroupHomotypic(gnp.ParseNames(names[:3]), true)
+	assert.Equal(t, 2, len(res))
+	assert.Equal(t, "bus|l|,Aus bus (L.) Smith", res[0].Output(gnfmt.CSV))
+}
+
+func TestClustering(t *testing.T) {
+	names := []string{"Aus bus L.", "Aus bus Linn.", "Aus bus L.",
+		"Aus ba", "Bus cus", "Aus bus L. foo", "Aus bus"}
+	gnp := gnparser.New(gnparser.NewConfig())
+	cl := parsed.NewClustering(false)
+	cl.Add(gnp.ParseNames(names)...)
+	res := cl.Clusters()
+	assert.Equal(t, 4, len(res))
+	assert.Equal(t, parsed.Cluster{
+		Canonical: "Aus bus", Count: 4, VariantsNum: 3,
+		MostCommon: "Aus bus L.", QualityDistribution: map[int]int{1: 4},
+	}, res[0])
+	assert.Equal(t, "Aus bus,4,3,Aus bus L.,4,0,0,0", res[0].Output(gnfmt.CSV))
+
+	cl = parsed.NewClustering(true)
+	cl.Add(gnp.ParseNames([]string{"Aus alba", "Aus albus L.", "Aus album"})...)
+	res = cl.Clusters()
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, "Aus alb", res[0].Canonical)
+	assert.Equal(t, 3, res[0].VariantsNum)
+	assert.Equal(t, "Aus alba", res[0].MostCommon)
+}
+
+func TestParseComponents(t *testing.T) {
+	tests := []struct {
+		msg     string
+		comp    parsed.Components
+		verb    string
+		quality int
+		warns   []parsed.Warning
+	}{
+		{"binomial",
+			parsed.Components{
+				Genus: "Pinus", SpecificEpithet: "strobus", Authorship: "L.",
+			},
+			"Pinus strobus L.", 1, nil},
+		{"variety",
+			parsed.Components{
+				Genus: "Aus", SpecificEpithet: "bus", InfraspecificEpithet: "cus",
+				TaxonRank: "variety", Authorship: "Smith",
+			},
+			"Aus bus var. cus Smith", 1, nil},
+		{"subgenus",
+			parsed.Components{
+				Genus: "Aus", Subgenus: "(Bus)", SpecificEpithet: "cus",
+				Authorship: "(Smith, 1900)",
+			},
+			"Aus (Bus) cus (Smith, 1900)", 1, nil},
+		{"no rank",
+			parsed.Components{
+				Genus: "Aus", SpecificEpithet: "bus", InfraspecificEpithet: "cus",
+			},
+			"Aus bus cus", 2,
+			[]parsed.Warning{parsed.ComponentsRankMissingWarn}},
+		{"case",
+			parsed.Components{Genus: "aus", SpecificEpithet: "Bus"},
+			"Aus bus", 3, []parsed.Warning{parsed.ComponentsCaseWarn}},
+		{"rank mismatch",
+			parsed.Components{
+				Genus: "Aus", SpecificEpithet: "bus", TaxonRank: "var.",
+			},
+			"Aus bus", 3, []parsed.Warning{parsed.ComponentsRankMismatchWarn}},
+		{"words mismatch",
+			parsed.Components{Genus: "Aus bus", SpecificEpithet: "cus"},
+			"Aus bus cus", 3, []parsed.Warning{parsed.ComponentsMismatchWarn}},
+		{"no genus",
+			parsed.Components{SpecificEpithet: "bus"},
+			"bus", 0, []parsed.Warning{parsed.ComponentsMissingWarn}},
+	}
+	gnp := gnparser.New(gnparser.NewConfig())
+	for _, v := range tests {
+		res := gnp.ParseComponents(v.comp)
+		assert.Equal(t, v.verb, res.Verbatim, v.msg)
+		assert.Equal(t, v.quality, res.ParseQuality, v.msg)
+		var warns []parsed.Warning
+		for _, w := range res.QualityWarnings {
+			warns = append(warns, w.Warning)
+		}
+		assert.Equal(t, v.warns, warns, v.msg)
+		assert.Nil(t, res.Details, v.msg)
+	}
+
+	gnp = gnparser.New(gnparser.NewConfig(gnparser.OptWithDetails(true)))
+	res := gnp.ParseComponents(parsed.Components{
+		Genus: "Aus", SpecificEpithet: "bus", InfraspecificEpithet: "cus",
+		TaxonRank: "subspecies",
+	})
+	assert.Equal(t, "Aus bus subsp. cus", res.Normalized)
+	d := res.ToDwC()
+	assert.Equal(t, "cus", d.InfraspecificEpithet)
+	assert.Equal(t, "subspecies", d.TaxonRank)
+}
+
 func TestWordNormalizeByType(t *testing.T) {
 	tests := []struct {
 		msg, word, norm string
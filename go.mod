@@ -11,6 +11,7 @@ require (
 	github.com/gnames/organizer v0.1.1
 	github.com/gnames/tribool v0.1.1
 	github.com/labstack/echo/v4 v4.6.1
+	github.com/pmezard/go-difflib v1.0.0
 	github.com/pointlander/peg v1.0.1
 	github.com/rendon/testcli v1.0.0
 	github.com/spf13/cobra v1.2.1
@@ -36,7 +37,6 @@ require (
 	github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd // indirect
 	github.com/modern-go/reflect2 v1.0.2 // indirect
 	github.com/pelletier/go-toml v1.9.4 // indirect
-	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/pointlander/compress v1.1.1-0.20190518213731-ff44bd196cc3 // indirect
 	github.com/pointlander/jetset v1.0.1-0.20190518214125-eee7eff80bd4 // indirect
 	github.com/spf13/afero v1.6.0 // indirect
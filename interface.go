@@ -5,6 +5,7 @@ import (
 
 	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnlib/ent/gnvers"
+	"github.com/gnames/gnparser/ent/compare"
 	"github.com/gnames/gnparser/ent/nameidx"
 	"github.com/gnames/gnparser/ent/parsed"
 )
@@ -18,6 +19,21 @@ type GNparser interface {
 	// ParseName takes a name-string, and returns parsed results for the name.
 	ParseName(string) parsed.Parsed
 
+	// ParseAuthorship takes an authorship string that is not a part of
+	// a name-string, for example a value of Darwin Core
+	// scientificNameAuthorship field, and returns parsed authors and years.
+	ParseAuthorship(string) parsed.Authorship
+
+	// ParseComponents takes elements of a name provided separately (genus,
+	// subgenus, epithets, rank, authorship), assembles them into
+	// a name-string and parses it. Inconsistent components generate
+	// warnings.
+	ParseComponents(parsed.Components) parsed.Parsed
+
+	// CompareNames parses two name-strings and reports how they relate
+	// to each other.
+	CompareNames(string, string) compare.Match
+
 	// ParseNames takes a slice of name-strings, and returns a slice of
 	// parsed results in the same order as the input.
 	ParseNames([]string) []parsed.Parsed
@@ -31,6 +47,18 @@ type GNparser interface {
 	// CSV output.
 	Format() gnfmt.Format
 
+	// WithDwC returns true if CSV and TSV outputs contain Darwin Core
+	// terms instead of the default fields.
+	WithDwC() bool
+
+	// HeaderCSV returns a header for CSV or TSV output of parsed names
+	// according to the configuration. For JSON formats it is empty.
+	HeaderCSV() string
+
+	// Output formats parsed results according to the configured output
+	// format and Darwin Core setting.
+	Output(parsed.Parsed) string
+
 	// ChangeConfig allows to modify settings of GNparser. Changing settings
 	// might modify parsing process, and the final output of results.
 	ChangeConfig(opts ...Option) GNparser
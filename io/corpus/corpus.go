@@ -0,0 +1,354 @@
+// Package corpus reads user-maintained corpora of name-strings with their
+// expected parsing results, verifies them against the current parser and
+// regenerates expected results.
+//
+// Two formats of a corpus are supported. A markdown corpus follows the
+// format of testdata/test_data.md: every example starts with a
+// "Name: name-string" line, and can contain "Canonical: " and
+// "Authorship: " lines as well as fenced ```json, ```csv or ```tsv blocks
+// with expected output. All other lines are kept intact. A JSONL corpus
+// contains one expected JSON output per line.
+package corpus
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/parsed"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// Kind is a type of expected result.
+type Kind int
+
+const (
+	// JSON is a JSON output, compact for one-line blocks, pretty otherwise.
+	JSON Kind = iota
+	// CSV is a comma-separated output, optionally with a header.
+	CSV
+	// TSV is a tab-separated output, optionally with a header.
+	TSV
+	// Canonical is a full canonical form.
+	Canonical
+	// Authorship is a normalized authorship.
+	Authorship
+)
+
+var kindMap = map[Kind]string{
+	JSON:       "json",
+	CSV:        "csv",
+	TSV:        "tsv",
+	Canonical:  "Canonical",
+	Authorship: "Authorship",
+}
+
+// String is an implementation of fmt.Stringer interface.
+func (k Kind) String() string {
+	return kindMap[k]
+}
+
+// Block is an expected result of parsing.
+type Block struct {
+	// Kind is the type of the expected result.
+	Kind Kind
+	// Expected is the expected result.
+	Expected string
+	// start and end are indices of the first and the last lines of
+	// the expected result in the corpus.
+	start, end int
+}
+
+// Example is a name-string with its expected results.
+type Example struct {
+	// Name is the name-string to parse.
+	Name string
+	// Line is the number of the line with the name-string in the corpus.
+	Line int
+	// Blocks are expected results of parsing.
+	Blocks []Block
+}
+
+// Corpus is a parsed file with examples.
+type Corpus struct {
+	// Examples are name-strings with expected results.
+	Examples []Example
+	// lines are all lines of the corpus.
+	lines []string
+}
+
+// Result is an outcome of verification of an example.
+type Result struct {
+	// Name is the name-string of the example.
+	Name string
+	// Line is the number of the line with the name-string in the corpus.
+	Line int
+	// Passed is true if all expected results match the current parser.
+	Passed bool
+	// Diff is a unified diff between expected and actual results.
+	Diff string
+}
+
+// Report is an outcome of verification of a corpus.
+type Report struct {
+	// Results contain outcomes for every example.
+	Results []Result
+	// Passed is the number of examples that passed verification.
+	Passed int
+	// Failed is the number of examples that failed verification.
+	Failed int
+}
+
+var (
+	mdBlockRe = regexp.MustCompile("^```(json|csv|tsv)\\s*$")
+	versionRe = regexp.MustCompile(`"parserVersion":\s*"[^"]*"`)
+)
+
+// Read parses a corpus. If jsonl is true, every non-empty line of
+// the corpus is an expected compact JSON output, otherwise the corpus
+// is in markdown format.
+func Read(r io.Reader, jsonl bool) (*Corpus, error) {
+	var res Corpus
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for sc.Scan() {
+		res.lines = append(res.lines, sc.Text())
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if jsonl {
+		err = res.readJSONL()
+	} else {
+		err = res.readMarkdown()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Corpus) readJSONL() error {
+	for i, line := range c.lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		var p struct {
+			Verbatim string `json:"verbatim"`
+		}
+		if err := json.Unmarshal([]byte(line), &p); err != nil {
+			return fmt.Errorf("cannot decode line %d: %w", i+1, err)
+		}
+		c.Examples = append(c.Examples, Example{
+			Name:   p.Verbatim,
+			Line:   i + 1,
+			Blocks: []Block{{Kind: JSON, Expected: line, start: i, end: i}},
+		})
+	}
+	return nil
+}
+
+func (c *Corpus) readMarkdown() error {
+	var ex *Example
+	for i := 0; i < len(c.lines); i++ {
+		line := c.lines[i]
+		switch {
+		case strings.HasPrefix(line, "Name: "):
+			c.addExample(ex)
+			ex = &Example{Name: line[6:], Line: i + 1}
+		case ex == nil:
+			continue
+		case strings.HasPrefix(line, "#"):
+			c.addExample(ex)
+			ex = nil
+		case strings.HasPrefix(line, "Canonical:"):
+			ex.Blocks = append(ex.Blocks, Block{
+				Kind:     Canonical,
+				Expected: strings.TrimSpace(line[10:]),
+				start:    i,
+				end:      i,
+			})
+		case strings.HasPrefix(line, "Authorship:"):
+			ex.Blocks = append(ex.Blocks, Block{
+				Kind:     Authorship,
+				Expected: strings.TrimSpace(line[11:]),
+				start:    i,
+				end:      i,
+			})
+		case mdBlockRe.MatchString(line):
+			kind := JSON
+			switch mdBlockRe.FindStringSubmatch(line)[1] {
+			case "csv":
+				kind = CSV
+			case "tsv":
+				kind = TSV
+			}
+			start := i + 1
+			for i++; i < len(c.lines) && c.lines[i] != "```"; i++ {
+			}
+			if i == len(c.lines) {
+				return fmt.Errorf("block at line %d is not closed", start)
+			}
+			ex.Blocks = append(ex.Blocks, Block{
+				Kind:     kind,
+				Expected: strings.TrimSpace(strings.Join(c.lines[start:i], "\n")),
+				start:    start,
+				end:      i - 1,
+			})
+		}
+	}
+	c.addExample(ex)
+	return nil
+}
+
+func (c *Corpus) addExample(ex *Example) {
+	if ex != nil && len(ex.Blocks) > 0 {
+		c.Examples = append(c.Examples, *ex)
+	}
+}
+
+// Verify parses name-strings of the corpus and compares results with
+// expected ones. Versions of the parser are ignored during comparison.
+func (c *Corpus) Verify(gnp gnparser.GNparser) Report {
+	var res Report
+	for i, p := range c.parse(gnp) {
+		ex := c.Examples[i]
+		r := Result{Name: ex.Name, Line: ex.Line, Passed: true}
+		var diffs []string
+		for _, b := range ex.Blocks {
+			exp, act := comparable(b, b.Expected), comparable(b, actual(b, p))
+			if exp == act {
+				continue
+			}
+			r.Passed = false
+			diffs = append(diffs, unifiedDiff(b.Kind, exp, act))
+		}
+		r.Diff = strings.Join(diffs, "")
+		if r.Passed {
+			res.Passed++
+		} else {
+			res.Failed++
+		}
+		res.Results = append(res.Results, r)
+	}
+	return res
+}
+
+// Regenerate writes the corpus with expected results replaced by
+// the output of the current parser.
+func (c *Corpus) Regenerate(gnp gnparser.GNparser, w io.Writer) error {
+	type replacement struct {
+		end   int
+		lines []string
+	}
+	repl := make(map[int]replacement)
+	for i, p := range c.parse(gnp) {
+		for _, b := range c.Examples[i].Blocks {
+			var lines []string
+			switch b.Kind {
+			case Canonical:
+				lines = []string{strings.TrimRight("Canonical: "+actual(b, p), " ")}
+			case Authorship:
+				lines = []string{strings.TrimRight("Authorship: "+actual(b, p), " ")}
+			default:
+				lines = strings.Split(actual(b, p), "\n")
+			}
+			repl[b.start] = replacement{end: b.end, lines: lines}
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	for i := 0; i < len(c.lines); i++ {
+		lines := []string{c.lines[i]}
+		if r, ok := repl[i]; ok {
+			lines = r.lines
+			// an empty block has no lines to replace, so the current line
+			// closes the block.
+			if r.end < i {
+				lines = append(lines, c.lines[i])
+			} else {
+				i = r.end
+			}
+		}
+		for _, v := range lines {
+			if _, err := bw.WriteString(v + "\n"); err != nil {
+				return err
+			}
+		}
+	}
+	return bw.Flush()
+}
+
+func (c *Corpus) parse(gnp gnparser.GNparser) []parsed.Parsed {
+	names := make([]string, len(c.Examples))
+	for i, v := range c.Examples {
+		names[i] = v.Name
+	}
+	return gnp.ParseNames(names)
+}
+
+// actual returns the output of the current parser that corresponds to
+// the expected result.
+func actual(b Block, p parsed.Parsed) string {
+	switch b.Kind {
+	case Canonical:
+		if p.Canonical == nil {
+			return ""
+		}
+		return p.Canonical.Full
+	case Authorship:
+		if p.Authorship == nil {
+			return ""
+		}
+		return p.Authorship.Normalized
+	case JSON:
+		if strings.Contains(b.Expected, "\n") {
+			return p.Output(gnfmt.PrettyJSON)
+		}
+		return p.Output(gnfmt.CompactJSON)
+	default:
+		f := gnfmt.CSV
+		if b.Kind == TSV {
+			f = gnfmt.TSV
+		}
+		res := p.Output(f)
+		header := parsed.HeaderCSV(f)
+		if strings.HasPrefix(b.Expected, header+"\n") {
+			res = header + "\n" + res
+		}
+		return res
+	}
+}
+
+// comparable prepares results for comparison. JSON is indented to make
+// a readable diff, and the version of the parser is removed.
+func comparable(b Block, s string) string {
+	if b.Kind != JSON {
+		return s
+	}
+	s = versionRe.ReplaceAllString(s, `"parserVersion":""`)
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(s), "", "  "); err != nil {
+		return s
+	}
+	return buf.String()
+}
+
+func unifiedDiff(k Kind, expected, actual string) string {
+	res, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(expected + "\n"),
+		B:        difflib.SplitLines(actual + "\n"),
+		FromFile: "expected " + k.String(),
+		ToFile:   "actual " + k.String(),
+		Context:  2,
+	})
+	return res
+}
@@ -0,0 +1,80 @@
+package corpus_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gnames/gnfmt"
+	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/io/corpus"
+	"github.com/stretchr/testify/assert"
+)
+
+const markdown = "# My corpus\n\n" +
+	"Name: Aus bus L.\n\n" +
+	"Canonical: Aus bus\n\n" +
+	"Authorship: L.\n\n" +
+	"```csv\n" +
+	"Id,Verbatim,Cardinality,CanonicalStem,CanonicalSimple,CanonicalFull,Authorship,Year,Quality\n" +
+	"a9b4ad4f-7b0a-5fc6-b0dc-5e0a1ecf2c5c,Aus bus L.,2,Aus bus,Aus bus,Aus bus,L.,,1\n" +
+	"```\n\n" +
+	"Name: Aus cus var. dus\n\n" +
+	"Canonical: Aus cus dus\n\n" +
+	"```json\n" +
+	"```\n\n" +
+	"Name: Name without expectations\n"
+
+func TestVerify(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig())
+	c, err := corpus.Read(strings.NewReader(markdown), false)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(c.Examples))
+	assert.Equal(t, "Aus cus var. dus", c.Examples[1].Name)
+	assert.Equal(t, 14, c.Examples[1].Line)
+
+	res := c.Verify(gnp)
+	assert.Equal(t, 0, res.Passed)
+	assert.Equal(t, 2, res.Failed)
+	assert.Contains(t, res.Results[0].Diff, "--- expected csv\n+++ actual csv\n")
+	assert.Contains(t, res.Results[0].Diff,
+		"-a9b4ad4f-7b0a-5fc6-b0dc-5e0a1ecf2c5c,Aus bus L.,")
+	assert.Contains(t, res.Results[0].Diff,
+		"+62aff03b-57ef-55b3-be57-c874e315371c,Aus bus L.,")
+	assert.Contains(t, res.Results[1].Diff, "-Aus cus dus\n+Aus cus var. dus")
+
+	var buf bytes.Buffer
+	err = c.Regenerate(gnp, &buf)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(buf.String(), "# My corpus\n\nName: Aus bus L."))
+	assert.True(t, strings.HasSuffix(buf.String(),
+		"```\n\nName: Name without expectations\n"))
+
+	c, err = corpus.Read(&buf, false)
+	assert.Nil(t, err)
+	res = c.Verify(gnp)
+	assert.Equal(t, 2, res.Passed)
+	assert.Equal(t, 0, res.Failed)
+}
+
+func TestVerifyJSONL(t *testing.T) {
+	gnp := gnparser.New(gnparser.NewConfig())
+	old := gnp.ParseName("Aus bus")
+	old.ParserVersion = "v0.0.1"
+	names := old.Output(gnfmt.CompactJSON) + "\n\n" +
+		gnp.ParseName("Bus cus").Output(gnfmt.CompactJSON) + "\n"
+	names = strings.Replace(names, `"cardinality":2`, `"cardinality":3`, 1)
+
+	c, err := corpus.Read(strings.NewReader(names), true)
+	assert.Nil(t, err)
+	res := c.Verify(gnp)
+	assert.Equal(t, 1, res.Passed)
+	assert.Equal(t, 1, res.Failed)
+	assert.Equal(t, 1, res.Results[0].Line)
+	assert.Contains(t, res.Results[0].Diff, "-  \"cardinality\": 3,\n+  \"cardinality\": 2,")
+	assert.NotContains(t, res.Results[0].Diff, "v0.0.1")
+	assert.Equal(t, 3, res.Results[1].Line)
+
+	_, err = corpus.Read(strings.NewReader("not json\n"), true)
+	assert.NotNil(t, err)
+}
@@ -4,8 +4,14 @@ package dict
 import (
 	"bufio"
 	"embed"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"log"
+	"os"
+	"path/filepath"
+	"strings"
 )
 
 //go:embed data
@@ -14,6 +20,27 @@ var data embed.FS
 // Dict contains loaded dictionaries
 var Dict *Dictionary = LoadDictionary()
 
+// Names of dictionary files. The same names are used for custom
+// dictionaries loaded at runtime by Load function.
+const (
+	// BacteriaFile contains bacterial genera, one genus per line.
+	BacteriaFile = "bacteria_genera.txt"
+	// BacteriaHomonymsFile contains bacterial genera that have homonyms
+	// in other nomenclatural codes, one genus per line.
+	BacteriaHomonymsFile = "bacteria_genera_homonyms.txt"
+	// AuthorICNFile contains family names of ICN authors, one per line.
+	AuthorICNFile = "genera_auth_icn.txt"
+	// VirusExceptionsFile contains genera with epithets that look like
+	// virus names, in the format of ReadExceptions.
+	VirusExceptionsFile = "virus_exceptions.txt"
+	// AmbiguousExceptionsFile contains genera with epithets that look like
+	// authors' prefixes, in the format of ReadExceptions.
+	AmbiguousExceptionsFile = "ambiguous_exceptions.txt"
+	// NoParseExceptionsFile contains genera with epithets that look like
+	// markers of unparseable names, in the format of ReadExceptions.
+	NoParseExceptionsFile = "no_parse_exceptions.txt"
+)
+
 // Dictionary contains dictionaries used for detecting information
 // about scientific names
 type Dictionary struct {
@@ -24,30 +51,255 @@ type Dictionary struct {
 	// This list is used to detect ICN name-strings so we can parse a word in
 	// parenthesis after genus word as an author instead of subgenus.
 	AuthorICN map[string]struct{}
+	// AuthorForms contains standard forms of authors' names (for example
+	// "L.", "DC.") as keys, and their known variants as values.
+	AuthorForms map[string][]string
+	// VirusException contains genera with epithets that look like names
+	// of viruses ("Ophion virus"), such names are parsed as usual.
+	VirusException map[string][]string
+	// AmbiguousException contains genera with epithets that look like
+	// prefixes of authors ("Serina ser"), such epithets are not parsed as
+	// parts of authorship.
+	AmbiguousException map[string][]string
+	// NoParseException contains genera with epithets that make a name
+	// unparseable ("Navicula bacterium"), such names are parsed as usual.
+	NoParseException map[string][]string
 }
 
 // LoadDictionary creates dictionary from text files.
 func LoadDictionary() *Dictionary {
 	d := Dictionary{
-		Bacteria:  readBacterialData(),
-		AuthorICN: readAuthorICNData(),
+		Bacteria:           readBacterialData(),
+		AuthorICN:          readAuthorICNData(),
+		AuthorForms:        readAuthorFormsData(),
+		VirusException:     readExceptionsData(VirusExceptionsFile),
+		AmbiguousException: readExceptionsData(AmbiguousExceptionsFile),
+		NoParseException:   readExceptionsData(NoParseExceptionsFile),
 	}
 	return &d
 }
 
+// Load creates a dictionary out of embedded dictionaries and custom
+// dictionary files from a directory. Custom files have the same names as
+// embedded ones (see BacteriaFile and other constants), files that are not
+// found in the directory are ignored. If replace is true, data from
+// a custom file replaces the corresponding embedded dictionary,
+// otherwise it is added to the embedded dictionary.
+//
+// The default dictionary Dict is not modified.
+func Load(dir string, replace bool) (*Dictionary, error) {
+	fi, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+	res := Dict.clone()
+
+	bacFiles := []string{BacteriaFile, BacteriaHomonymsFile}
+	if replace && anyExists(dir, bacFiles...) {
+		res.Bacteria = make(map[string]bool)
+	}
+	for i, v := range bacFiles {
+		err = loadFile(dir, v, func(r io.Reader) error {
+			ls, err := readLines(r)
+			for _, l := range ls {
+				res.Bacteria[l] = i == 1
+			}
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if replace && anyExists(dir, AuthorICNFile) {
+		res.AuthorICN = make(map[string]struct{})
+	}
+	err = loadFile(dir, AuthorICNFile, func(r io.Reader) error {
+		ls, err := readLines(r)
+		for _, l := range ls {
+			res.AuthorICN[l] = struct{}{}
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exceptions := []struct {
+		file string
+		m    *map[string][]string
+	}{
+		{VirusExceptionsFile, &res.VirusException},
+		{AmbiguousExceptionsFile, &res.AmbiguousException},
+		{NoParseExceptionsFile, &res.NoParseException},
+	}
+	for _, v := range exceptions {
+		m := v.m
+		if replace && anyExists(dir, v.file) {
+			*m = make(map[string][]string)
+		}
+		err = loadFile(dir, v.file, func(r io.Reader) error {
+			exc, err := ReadExceptions(r)
+			for k, eps := range exc {
+				(*m)[k] = append((*m)[k], eps...)
+			}
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// clone makes a copy of a dictionary, so the copy can be modified
+// without changes in the original.
+func (d *Dictionary) clone() *Dictionary {
+	res := Dictionary{
+		Bacteria:           make(map[string]bool, len(d.Bacteria)),
+		AuthorICN:          make(map[string]struct{}, len(d.AuthorICN)),
+		AuthorForms:        d.AuthorForms,
+		VirusException:     cloneExceptions(d.VirusException),
+		AmbiguousException: cloneExceptions(d.AmbiguousException),
+		NoParseException:   cloneExceptions(d.NoParseException),
+	}
+	for k, v := range d.Bacteria {
+		res.Bacteria[k] = v
+	}
+	for k := range d.AuthorICN {
+		res.AuthorICN[k] = struct{}{}
+	}
+	return &res
+}
+
+func cloneExceptions(m map[string][]string) map[string][]string {
+	res := make(map[string][]string, len(m))
+	for k, v := range m {
+		res[k] = append([]string(nil), v...)
+	}
+	return res
+}
+
+func anyExists(dir string, files ...string) bool {
+	for _, v := range files {
+		if _, err := os.Stat(filepath.Join(dir, v)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// loadFile reads a custom dictionary file, if it exists.
+func loadFile(dir, file string, read func(io.Reader) error) error {
+	path := filepath.Join(dir, file)
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err = read(f); err != nil {
+		return fmt.Errorf("cannot read %s: %w", path, err)
+	}
+	return nil
+}
+
 func readBacterialData() map[string]bool {
 	m := make(map[string]bool)
-	scanBacterialFile("bacteria_genera.txt", false, m)
-	scanBacterialFile("bacteria_genera_homonyms.txt", true, m)
+	scanBacterialFile(BacteriaFile, false, m)
+	scanBacterialFile(BacteriaHomonymsFile, true, m)
 	return m
 }
 
 func readAuthorICNData() map[string]struct{} {
 	m := make(map[string]struct{})
-	scanAuthorICNFIle("genera_auth_icn.txt", m)
+	scanAuthorICNFIle(AuthorICNFile, m)
+	return m
+}
+
+func readAuthorFormsData() map[string][]string {
+	f, err := data.Open("data/author_standard_forms.txt")
+	if err != nil {
+		log.Fatal(err)
+	}
+	m, err := ReadAuthorForms(f)
+	if err != nil {
+		log.Fatal(err)
+	}
 	return m
 }
 
+func readExceptionsData(file string) map[string][]string {
+	f, err := data.Open("data/" + file)
+	if err != nil {
+		log.Fatal(err)
+	}
+	m, err := ReadExceptions(f)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return m
+}
+
+// ReadAuthorForms reads standard forms of authors' names. Every line
+// contains tab-separated values, where the first value is a standard form
+// and the rest are its variants, for example:
+//
+//	L.	Linnaeus	Linn.	Carl Linnaeus
+//
+// Empty lines and lines starting with '#' are ignored.
+func ReadAuthorForms(r io.Reader) (map[string][]string, error) {
+	return readTabSeparated(r)
+}
+
+// ReadExceptions reads genera with epithets that require an exceptional
+// treatment during parsing. Every line contains tab-separated values,
+// where the first value is a genus and the rest are epithets, for example:
+//
+//	Serina	ser	subser
+//
+// Empty lines and lines starting with '#' are ignored.
+func ReadExceptions(r io.Reader) (map[string][]string, error) {
+	return readTabSeparated(r)
+}
+
+func readTabSeparated(r io.Reader) (map[string][]string, error) {
+	m := make(map[string][]string)
+	ls, err := readLines(r)
+	for _, line := range ls {
+		fs := strings.Split(line, "\t")
+		key := strings.TrimSpace(fs[0])
+		for _, v := range fs[1:] {
+			if v = strings.TrimSpace(v); v != "" {
+				m[key] = append(m[key], v)
+			}
+		}
+		if _, ok := m[key]; !ok {
+			m[key] = nil
+		}
+	}
+	return m, err
+}
+
+// readLines returns trimmed lines, ignoring empty lines and comments.
+func readLines(r io.Reader) ([]string, error) {
+	var res []string
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		res = append(res, line)
+	}
+	return res, sc.Err()
+}
+
 func scanAuthorICNFIle(path string, m map[string]struct{}) {
 	path = fmt.Sprintf("data/%s", path)
 	f, err := data.Open(path)
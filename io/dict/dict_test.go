@@ -1,6 +1,9 @@
 package dict_test
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/gnames/gnparser/io/dict"
@@ -35,4 +38,65 @@ func TestLoadDictionary(t *testing.T) {
 		_, ok := d.AuthorICN["Abramov"]
 		assert.True(t, ok)
 	})
+	t.Run("finds exceptions", func(t *testing.T) {
+		assert.Equal(t, []string{"virus"}, d.VirusException["Ophion"])
+		assert.Equal(t, []string{"ser", "subser"}, d.AmbiguousException["Serina"])
+		assert.Equal(t, []string{"bacterium"}, d.NoParseException["Navicula"])
+	})
+	t.Run("finds author standard form", func(t *testing.T) {
+		vs, ok := d.AuthorForms["L."]
+		assert.True(t, ok)
+		assert.Contains(t, vs, "Linnaeus")
+	})
+}
+
+func TestReadAuthorForms(t *testing.T) {
+	in := "# comment\nDC.\tde Candolle\tCandolle\n\nKunth\n"
+	m, err := dict.ReadAuthorForms(strings.NewReader(in))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string][]string{
+		"DC.":   {"de Candolle", "Candolle"},
+		"Kunth": nil,
+	}, m)
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		dict.BacteriaFile:        "# local genera\nGnparseria\n\n",
+		dict.AuthorICNFile:       "Smithson\n",
+		dict.VirusExceptionsFile: "Ophion\tvector\nHomo\tvirus\n",
+	}
+	for k, v := range files {
+		err := os.WriteFile(filepath.Join(dir, k), []byte(v), 0644)
+		assert.Nil(t, err)
+	}
+
+	d, err := dict.Load(dir, false)
+	assert.Nil(t, err)
+	hom, ok := d.Bacteria["Gnparseria"]
+	assert.True(t, ok)
+	assert.False(t, hom)
+	assert.True(t, d.Bacteria["Arizona"])
+	assert.Contains(t, d.AuthorICN, "Smithson")
+	assert.Contains(t, d.AuthorICN, "Abramov")
+	assert.Equal(t, []string{"virus", "vector"}, d.VirusException["Ophion"])
+	assert.Equal(t, []string{"virus"}, d.VirusException["Homo"])
+	assert.Equal(t, dict.Dict.NoParseException, d.NoParseException)
+
+	// the default dictionary stays intact.
+	assert.NotContains(t, dict.Dict.Bacteria, "Gnparseria")
+	assert.Equal(t, []string{"virus"}, dict.Dict.VirusException["Ophion"])
+
+	d, err = dict.Load(dir, true)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]bool{"Gnparseria": false}, d.Bacteria)
+	assert.Equal(t, map[string]struct{}{"Smithson": {}}, d.AuthorICN)
+	assert.Equal(t, []string{"vector"}, d.VirusException["Ophion"])
+	assert.Equal(t, dict.Dict.AmbiguousException, d.AmbiguousException)
+
+	_, err = dict.Load(filepath.Join(dir, "none"), false)
+	assert.NotNil(t, err)
+	_, err = dict.Load(filepath.Join(dir, dict.BacteriaFile), false)
+	assert.NotNil(t, err)
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnparser"
+	"github.com/gnames/gnparser/ent/compare"
 	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -23,11 +24,23 @@ var static embed.FS
 type inputREST struct {
 	Names             []string `json:"names"`
 	CSV               bool     `json:"csv"`
+	Format            string   `json:"format"`
+	Code              string   `json:"code"`
 	WithDetails       bool     `json:"withDetails"`
 	WithCultivars     bool     `json:"withCultivars"`
 	PreserveDiaereses bool     `json:"preserveDiaereses"`
 }
 
+type inputAuthorshipREST struct {
+	Authorships []string `json:"authorships"`
+	CSV         bool     `json:"csv"`
+}
+
+type inputCompareREST struct {
+	Pairs [][2]string `json:"pairs"`
+	CSV   bool        `json:"csv"`
+}
+
 // Run starts the GNparser web service and servies both RESTful API and
 // a website.
 func Run(gnps GNparserService) {
@@ -51,6 +64,10 @@ func Run(gnps GNparserService) {
 	e.GET("/api/v1", info())
 	e.GET("/api/v1/ping", ping(gnps))
 	e.GET("/api/v1/version", ver(gnps))
+	e.GET("/api/v1/authorship/:authorships", parseAuthorshipsGET(gnps))
+	e.POST("/api/v1/authorship", parseAuthorshipsPOST(gnps))
+	e.GET("/api/v1/compare", compareGET(gnps))
+	e.POST("/api/v1/compare", comparePOST(gnps))
 	e.GET("/api/v1/:names", parseNamesGET(gnps))
 	e.GET("/api/:names", parseNamesGET(gnps))
 	e.POST("/api/v1/", parseNamesPOST(gnps))
@@ -97,13 +114,17 @@ func parseNamesGET(gnps GNparserService) func(echo.Context) error {
 	return func(c echo.Context) error {
 		nameStr, _ := url.QueryUnescape(c.Param("names"))
 		csv := c.QueryParam("csv") == "true"
+		format := c.QueryParam("format")
+		code := c.QueryParam("code")
 		det := c.QueryParam("with_details") == "true"
 		cultivars := c.QueryParam("cultivars") == "true"
 		diaereses := c.QueryParam("diaereses") == "true"
-		gnp := gnps.ChangeConfig(opts(c, csv, det, cultivars, diaereses)...)
+		gnp := gnps.ChangeConfig(
+			opts(c, format, code, csv, det, cultivars, diaereses)...,
+		)
 		names := strings.Split(nameStr, "|")
 		res := gnp.ParseNames(names)
-		return formatNames(c, res, gnp.Format())
+		return formatNames(c, gnp, res)
 	}
 }
 
@@ -113,24 +134,110 @@ func parseNamesPOST(gnps GNparserService) func(echo.Context) error {
 		if err := c.Bind(&input); err != nil {
 			return err
 		}
-		gnp := gnps.ChangeConfig(opts(c, input.CSV, input.WithDetails, input.WithCultivars, input.PreserveDiaereses)...)
+		gnp := gnps.ChangeConfig(
+			opts(c, input.Format, input.Code, input.CSV, input.WithDetails,
+				input.WithCultivars, input.PreserveDiaereses)...,
+		)
 		res := gnp.ParseNames(input.Names)
-		return formatNames(c, res, gnp.Format())
+		return formatNames(c, gnp, res)
 	}
 }
 
+func parseAuthorshipsGET(gnps GNparserService) func(echo.Context) error {
+	return func(c echo.Context) error {
+		auStr, _ := url.QueryUnescape(c.Param("authorships"))
+		csv := c.QueryParam("csv") == "true"
+		aus := strings.Split(auStr, "|")
+		return formatAuthorships(c, gnps, aus, csv)
+	}
+}
+
+func parseAuthorshipsPOST(gnps GNparserService) func(echo.Context) error {
+	return func(c echo.Context) error {
+		var input inputAuthorshipREST
+		if err := c.Bind(&input); err != nil {
+			return err
+		}
+		return formatAuthorships(c, gnps, input.Authorships, input.CSV)
+	}
+}
+
+func formatAuthorships(
+	c echo.Context,
+	gnps GNparserService,
+	aus []string,
+	csv bool,
+) error {
+	gnp := gnps.ChangeConfig()
+	res := make([]parsed.Authorship, len(aus))
+	for i := range aus {
+		res[i] = gnp.ParseAuthorship(aus[i])
+	}
+
+	if !csv {
+		return c.JSON(http.StatusOK, res)
+	}
+	resCSV := make([]string, 0, len(res)+1)
+	resCSV = append(resCSV, parsed.HeaderAuthorshipCSV(gnfmt.CSV))
+	for i := range res {
+		resCSV = append(resCSV, res[i].Output(gnfmt.CSV))
+	}
+	return c.String(http.StatusOK, strings.Join(resCSV, "\n"))
+}
+
+func compareGET(gnps GNparserService) func(echo.Context) error {
+	return func(c echo.Context) error {
+		pair := [2]string{c.QueryParam("name1"), c.QueryParam("name2")}
+		csv := c.QueryParam("csv") == "true"
+		return formatCompare(c, gnps, [][2]string{pair}, csv)
+	}
+}
+
+func comparePOST(gnps GNparserService) func(echo.Context) error {
+	return func(c echo.Context) error {
+		var input inputCompareREST
+		if err := c.Bind(&input); err != nil {
+			return err
+		}
+		return formatCompare(c, gnps, input.Pairs, input.CSV)
+	}
+}
+
+func formatCompare(
+	c echo.Context,
+	gnps GNparserService,
+	pairs [][2]string,
+	csv bool,
+) error {
+	gnp := gnps.ChangeConfig()
+	res := make([]compare.Match, len(pairs))
+	for i, v := range pairs {
+		res[i] = gnp.CompareNames(v[0], v[1])
+	}
+
+	if !csv {
+		return c.JSON(http.StatusOK, res)
+	}
+	resCSV := make([]string, 0, len(res)+1)
+	resCSV = append(resCSV, compare.HeaderCSV(gnfmt.CSV))
+	for i := range res {
+		resCSV = append(resCSV, res[i].Output(gnfmt.CSV))
+	}
+	return c.String(http.StatusOK, strings.Join(resCSV, "\n"))
+}
+
 func formatNames(
 	c echo.Context,
+	gnp gnparser.GNparser,
 	res []parsed.Parsed,
-	f gnfmt.Format,
 ) error {
 
-	switch f {
+	switch gnp.Format() {
 	case gnfmt.CSV, gnfmt.TSV:
 		resCSV := make([]string, 0, len(res)+1)
-		resCSV = append(resCSV, parsed.HeaderCSV(f))
+		resCSV = append(resCSV, gnp.HeaderCSV())
 		for i := range res {
-			resCSV = append(resCSV, res[i].Output(f))
+			resCSV = append(resCSV, gnp.Output(res[i]))
 		}
 		return c.String(http.StatusOK, strings.Join(resCSV, "\n"))
 	default:
@@ -138,13 +245,21 @@ func formatNames(
 	}
 }
 
-func opts(c echo.Context, csv, details, cultivars bool, diaereses bool) []gnparser.Option {
+func opts(
+	c echo.Context,
+	format, code string,
+	csv, details, cultivars bool,
+	diaereses bool,
+) []gnparser.Option {
 	res := []gnparser.Option{
 		gnparser.OptWithDetails(details),
 		gnparser.OptWithCultivars(cultivars),
 		gnparser.OptWithPreserveDiaereses(diaereses),
+		gnparser.OptCode(code),
 	}
-	if csv {
+	if format == "dwc" {
+		res = append(res, gnparser.OptFormat("dwc"))
+	} else if csv {
 		res = append(res, gnparser.OptFormat("csv"))
 	} else {
 		res = append(res, gnparser.OptFormat("compact"))
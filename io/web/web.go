@@ -7,7 +7,6 @@ import (
 	"net/url"
 	"strings"
 
-	"github.com/gnames/gnfmt"
 	"github.com/gnames/gnparser"
 	"github.com/gnames/gnparser/ent/parsed"
 	"github.com/labstack/echo/v4"
@@ -20,6 +19,7 @@ type inputFORM struct {
 	WithDetails       string `query:"with_details" form:"with_details"`
 	WithCultivars     string `query:"cultivars" form:"cultivars"`
 	PreserveDiaereses string `query:"diaereses" form:"diaereses"`
+	Code              string `query:"code" form:"code"`
 }
 
 // Data contains information required to render web-pages.
@@ -32,6 +32,7 @@ type Data struct {
 	WithDetails       bool
 	WithCultivars     bool
 	PreserveDiaereses bool
+	Code              string
 }
 
 // NewData creates new Data for web-page templates.
@@ -77,6 +78,9 @@ func redirectToHomeGET(c echo.Context, inp *inputFORM) error {
 	if preserveDiaereses {
 		q.Set("diaereses", inp.PreserveDiaereses)
 	}
+	if inp.Code != "" {
+		q.Set("code", inp.Code)
+	}
 
 	url := fmt.Sprintf("/?%s", q.Encode())
 	return c.Redirect(http.StatusFound, url)
@@ -110,9 +114,11 @@ func parsingResults(
 	data.WithDetails = inp.WithDetails == "on"
 	data.WithCultivars = inp.WithCultivars == "on"
 	data.PreserveDiaereses = inp.PreserveDiaereses == "on"
+	data.Code = inp.Code
 
 	format := inp.Format
-	if format == "csv" || format == "tsv" || format == "json" {
+	if format == "csv" || format == "tsv" || format == "json" ||
+		format == "dwc" {
 		data.Format = format
 	}
 
@@ -132,6 +138,12 @@ func parsingResults(
 		gnparser.OptWithDetails(data.WithDetails),
 		gnparser.OptWithCultivars(data.WithCultivars),
 		gnparser.OptWithPreserveDiaereses(data.PreserveDiaereses),
+		gnparser.OptCode(data.Code),
+	}
+
+	switch data.Format {
+	case "csv", "tsv", "dwc":
+		opts = append(opts, gnparser.OptFormat(data.Format))
 	}
 
 	gnp := gnps.ChangeConfig(opts...)
@@ -140,16 +152,11 @@ func parsingResults(
 	switch data.Format {
 	case "json":
 		return c.JSON(http.StatusOK, data.Parsed)
-	case "csv", "tsv":
-		f := gnfmt.CSV
-		if data.Format == "tsv" {
-			f = gnfmt.TSV
-		}
-
+	case "csv", "tsv", "dwc":
 		res := make([]string, len(data.Parsed)+1)
-		res[0] = parsed.HeaderCSV(f)
+		res[0] = gnp.HeaderCSV()
 		for i := range data.Parsed {
-			res[i+1] = data.Parsed[i].Output(f)
+			res[i+1] = gnp.Output(data.Parsed[i])
 		}
 		return c.String(http.StatusOK, strings.Join(res, "\n"))
 	default:
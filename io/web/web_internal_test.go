@@ -11,6 +11,7 @@ import (
   "github.com/gnames/gnfmt"
   "github.com/gnames/gnlib/ent/gnvers"
   "github.com/gnames/gnparser"
+  "github.com/gnames/gnparser/ent/compare"
   "github.com/gnames/gnparser/ent/parsed"
   "github.com/labstack/echo/v4"
   "github.com/stretchr/testify/assert"
@@ -238,3 +239,119 @@ func TestParsePOST(t *testing.T) {
   assert.Nil(t, parseNamesPOST(gnps)(c))
   assert.True(t, strings.HasPrefix(rec.Body.String(), "Id"))
 }
+
+func TestParseDwCGET(t *testing.T) {
+  cfg := gnparser.NewConfig(gnparser.OptFormat("compact"))
+  gnp := gnparser.New(cfg)
+  gnps := NewGNparserService(gnp, 0)
+
+  name := url.QueryEscape("Bubo bubo (Linnaeus, 1758)")
+  e := echo.New()
+  q := make(url.Values)
+  q.Set("format", "dwc")
+  req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+  rec := httptest.NewRecorder()
+  c := e.NewContext(req, rec)
+  c.SetPath("/:names")
+  c.SetParamNames("names")
+  c.SetParamValues(name)
+
+  assert.Nil(t, parseNamesGET(gnps)(c))
+  lines := strings.Split(rec.Body.String(), "\n")
+  assert.Equal(t, len(lines), 2)
+  assert.True(t, strings.HasPrefix(lines[0], "Id,Verbatim,scientificName,genus"))
+  assert.Contains(t, lines[1], ",Bubo bubo (Linnaeus 1758),Bubo,,bubo,,species,,(Linnaeus 1758),1758,")
+}
+
+func TestParseAuthorshipGET(t *testing.T) {
+  var response []parsed.Authorship
+  cfg := gnparser.NewConfig()
+  gnp := gnparser.New(cfg)
+  gnps := NewGNparserService(gnp, 0)
+
+  aus := url.QueryEscape("(L.) Mill. 1768|Linnaeus, 1758")
+  c, rec := handlerGET("/api/v1/authorship/")
+  c.SetPath("/api/v1/authorship/:authorships")
+  c.SetParamNames("authorships")
+  c.SetParamValues(aus)
+
+  assert.Nil(t, parseAuthorshipsGET(gnps)(c))
+  enc := gnfmt.GNjson{}
+  err := enc.Decode(rec.Body.Bytes(), &response)
+  assert.Nil(t, err)
+  assert.Equal(t, 2, len(response))
+  assert.Equal(t, "(L.) Mill. 1768", response[0].Normalized)
+  assert.Equal(t, []string{"L.", "Mill."}, response[0].Authors)
+  assert.Equal(t, "1758", response[1].Year)
+}
+
+func TestParseAuthorshipPOST(t *testing.T) {
+  cfg := gnparser.NewConfig()
+  gnp := gnparser.New(cfg)
+  gnps := NewGNparserService(gnp, 0)
+  params := inputAuthorshipREST{
+    Authorships: []string{"Smith & Jones, 1990"},
+    CSV:         true,
+  }
+  reqBody, err := gnfmt.GNjson{}.Encode(params)
+  assert.Nil(t, err)
+  r := bytes.NewReader(reqBody)
+  req := httptest.NewRequest(http.MethodPost, "/api/v1/authorship", r)
+  req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+  rec := httptest.NewRecorder()
+  e := echo.New()
+  c := e.NewContext(req, rec)
+
+  assert.Nil(t, parseAuthorshipsPOST(gnps)(c))
+  lines := strings.Split(rec.Body.String(), "\n")
+  assert.Equal(t, 2, len(lines))
+  assert.Equal(t, "Verbatim,Authorship,Year,Authors", lines[0])
+  assert.Equal(t, "\"Smith & Jones, 1990\",Smith & Jones 1990,1990,Smith|Jones",
+    lines[1])
+}
+
+func TestCompareGET(t *testing.T) {
+  var response []compare.Match
+  cfg := gnparser.NewConfig()
+  gnp := gnparser.New(cfg)
+  gnps := NewGNparserService(gnp, 0)
+
+  q := url.Values{}
+  q.Set("name1", "Aus bus var. cus L.")
+  q.Set("name2", "Aus bus f. cus Linnaeus")
+  c, rec := handlerGET("/api/v1/compare?" + q.Encode())
+
+  assert.Nil(t, compareGET(gnps)(c))
+  enc := gnfmt.GNjson{}
+  err := enc.Decode(rec.Body.Bytes(), &response)
+  assert.Nil(t, err)
+  assert.Equal(t, 1, len(response))
+  assert.Equal(t, compare.RankMarkerMatch, response[0].Level)
+  assert.Equal(t, compare.CompatibleAuthorship, response[0].Authorship)
+}
+
+func TestComparePOST(t *testing.T) {
+  cfg := gnparser.NewConfig()
+  gnp := gnparser.New(cfg)
+  gnps := NewGNparserService(gnp, 0)
+  params := inputCompareREST{
+    Pairs: [][2]string{{"Aus bus", "Aus bus"}, {"Aus alba", "Aus albus"}},
+    CSV:   true,
+  }
+  reqBody, err := gnfmt.GNjson{}.Encode(params)
+  assert.Nil(t, err)
+  r := bytes.NewReader(reqBody)
+  req := httptest.NewRequest(http.MethodPost, "/api/v1/compare", r)
+  req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+  rec := httptest.NewRecorder()
+  e := echo.New()
+  c := e.NewContext(req, rec)
+
+  assert.Nil(t, comparePOST(gnps)(c))
+  lines := strings.Split(rec.Body.String(), "\n")
+  assert.Equal(t, 3, len(lines))
+  assert.Equal(t, "VerbatimA,VerbatimB,Level,Authorship,Differences", lines[0])
+  assert.Equal(t, "Aus bus,Aus bus,IdenticalVerbatim,unknown,", lines[1])
+  assert.Equal(t, "Aus alba,Aus albus,StemmedCanonical,unknown,SPECIES",
+    lines[2])
+}